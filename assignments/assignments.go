@@ -26,7 +26,7 @@ const MaxWait = 5 * time.Minute
 // caller for an extended period, since it may involve cloning the tests repository,
 // scanning the repository for assignments, building the Docker image, updating the
 // database and synchronizing tasks to issues on the students' group repositories.
-func UpdateFromTestsRepo(logger *zap.SugaredLogger, runner ci.Runner, db database.Database, sc scm.SCM, course *qf.Course) {
+func UpdateFromTestsRepo(logger *zap.SugaredLogger, runner ci.Runner, db database.Database, sc scm.SCM, course *qf.Course) error {
 	unlock := course.Lock()
 	defer unlock()
 
@@ -40,28 +40,24 @@ func UpdateFromTestsRepo(logger *zap.SugaredLogger, runner ci.Runner, db databas
 		DestDir:      course.CloneDir(),
 	})
 	if err != nil {
-		logger.Errorf("Failed to clone '%s' repository: %v", qf.TestsRepo, err)
-		return
+		return fmt.Errorf("failed to clone '%s' repository: %w", qf.TestsRepo, err)
 	}
 	logger.Debugf("Successfully cloned tests repository to: %s", clonedTestsRepo)
 
 	// walk the cloned tests repository and extract the assignments and the course's Dockerfile
 	assignments, buildContext, err := readTestsRepositoryContent(clonedTestsRepo, course.GetID())
 	if err != nil {
-		logger.Errorf("Failed to parse assignments from '%s' repository: %v", qf.TestsRepo, err)
-		return
+		return fmt.Errorf("failed to parse assignments from '%s' repository: %w", qf.TestsRepo, err)
 	}
 
 	if course.UpdateDockerfile(buildContext[ci.Dockerfile]) {
 		// Rebuild the Docker image for the course tagged with the course code
 		if err = buildDockerImage(ctx, logger, runner, course, buildContext); err != nil {
-			logger.Error(err)
-			return
+			return err
 		}
 		// Update the course's DockerfileDigest in the database
 		if err := db.UpdateCourse(course); err != nil {
-			logger.Errorf("Failed to update Dockerfile for course %s: %v", course.GetCode(), err)
-			return
+			return fmt.Errorf("failed to update Dockerfile for course %s: %w", course.GetCode(), err)
 		}
 	}
 
@@ -70,15 +66,14 @@ func UpdateFromTestsRepo(logger *zap.SugaredLogger, runner ci.Runner, db databas
 		for _, assignment := range assignments {
 			logger.Debugf("Failed to update database for: %v", assignment)
 		}
-		logger.Errorf("Failed to update assignments in database: %v", err)
-		return
+		return fmt.Errorf("failed to update assignments in database: %w", err)
 	}
 	logger.Debugf("Assignments for %s successfully updated from '%s' repo", course.GetCode(), qf.TestsRepo)
 
 	if err = synchronizeTasksWithIssues(ctx, db, sc, course, assignments); err != nil {
-		logger.Errorf("Failed to create tasks on '%s' repository: %v", qf.TestsRepo, err)
-		return
+		return fmt.Errorf("failed to create tasks on '%s' repository: %w", qf.TestsRepo, err)
 	}
+	return nil
 }
 
 // buildDockerImage builds the Docker image for the given course.
@@ -3,26 +3,44 @@ package assignments
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/quickfeed/quickfeed/qf"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const defaultAutoApproveScoreLimit = 80
+const (
+	defaultAutoApproveScoreLimit = 80
+	// maxTestRetries is the maximum number of times failed tests can be rerun in a test run.
+	maxTestRetries = 5
+	// maxCoverageThreshold is the maximum code coverage percentage required for the full coverage score.
+	maxCoverageThreshold = 100
+	// maxLintPenalty is the maximum number of percentage points deducted for lint findings.
+	maxLintPenalty = 100
+)
 
 // assignmentData holds information about a single assignment.
 // This is only used for parsing the 'assignment.json' file.
 // Note that the struct can be private, but the fields must be
 // public to allow parsing.
 type assignmentData struct {
-	Order            uint32 `json:"order"`
-	Deadline         string `json:"deadline"`
-	IsGroupLab       bool   `json:"isgrouplab"`
-	AutoApprove      bool   `json:"autoapprove"`
-	ScoreLimit       uint32 `json:"scorelimit"`
-	Reviewers        uint32 `json:"reviewers"`
-	ContainerTimeout uint32 `json:"containertimeout"`
+	Order             uint32  `json:"order"`
+	Deadline          string  `json:"deadline"`
+	IsGroupLab        bool    `json:"isgrouplab"`
+	AutoApprove       bool    `json:"autoapprove"`
+	ScoreLimit        uint32  `json:"scorelimit"`
+	Reviewers         uint32  `json:"reviewers"`
+	ContainerTimeout  uint32  `json:"containertimeout"`
+	MemoryLimit       uint32  `json:"memorylimit"`       // megabytes
+	CPULimit          float64 `json:"cpulimit"`          // number of CPUs, e.g., 1.5
+	PidsLimit         uint32  `json:"pidslimit"`         // number of processes
+	FileSizeLimit     uint32  `json:"filesizelimit"`     // megabytes per file
+	TestRetries       uint32  `json:"testretries"`       // number of times failed tests are rerun
+	CoverageThreshold uint32  `json:"coveragethreshold"` // coverage percentage for the full coverage score
+	CoverageWeight    uint32  `json:"coverageweight"`    // weight of the coverage score
+	LintPenalty       uint32  `json:"lintpenalty"`       // percentage points deducted per lint finding
+	MaxLintPenalty    uint32  `json:"maxlintpenalty"`    // maximum percentage points deducted for lint findings
 }
 
 func newAssignmentFromFile(contents []byte, assignmentName string, courseID uint64) (*qf.Assignment, error) {
@@ -38,6 +56,18 @@ func newAssignmentFromFile(contents []byte, assignmentName string, courseID uint
 	if newAssignment.ScoreLimit < 1 {
 		newAssignment.ScoreLimit = defaultAutoApproveScoreLimit
 	}
+	if newAssignment.CPULimit < 0 {
+		return nil, fmt.Errorf("assignment cpu limit must not be negative")
+	}
+	if newAssignment.TestRetries > maxTestRetries {
+		return nil, fmt.Errorf("assignment test retries must not exceed %d", maxTestRetries)
+	}
+	if newAssignment.CoverageThreshold > maxCoverageThreshold {
+		return nil, fmt.Errorf("assignment coverage threshold must not exceed %d", maxCoverageThreshold)
+	}
+	if newAssignment.LintPenalty > maxLintPenalty || newAssignment.MaxLintPenalty > maxLintPenalty {
+		return nil, fmt.Errorf("assignment lint penalties must not exceed %d", maxLintPenalty)
+	}
 	deadline, err := FixDeadline(newAssignment.Deadline)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing deadline: %w", err)
@@ -46,15 +76,24 @@ func newAssignmentFromFile(contents []byte, assignmentName string, courseID uint
 	// or it will cause a database constraint violation (IDs must be unique)
 	// The Name field below is the folder name of the assignment.
 	assignment := &qf.Assignment{
-		CourseID:         courseID,
-		Deadline:         deadline,
-		Name:             assignmentName,
-		Order:            newAssignment.Order,
-		IsGroupLab:       newAssignment.IsGroupLab,
-		AutoApprove:      newAssignment.AutoApprove,
-		ScoreLimit:       newAssignment.ScoreLimit,
-		Reviewers:        newAssignment.Reviewers,
-		ContainerTimeout: newAssignment.ContainerTimeout,
+		CourseID:          courseID,
+		Deadline:          deadline,
+		Name:              assignmentName,
+		Order:             newAssignment.Order,
+		IsGroupLab:        newAssignment.IsGroupLab,
+		AutoApprove:       newAssignment.AutoApprove,
+		ScoreLimit:        newAssignment.ScoreLimit,
+		Reviewers:         newAssignment.Reviewers,
+		ContainerTimeout:  newAssignment.ContainerTimeout,
+		MemoryLimit:       newAssignment.MemoryLimit,
+		CpuLimit:          uint32(math.Round(newAssignment.CPULimit * 1000)),
+		PidsLimit:         newAssignment.PidsLimit,
+		FileSizeLimit:     newAssignment.FileSizeLimit,
+		TestRetries:       newAssignment.TestRetries,
+		CoverageThreshold: newAssignment.CoverageThreshold,
+		CoverageWeight:    newAssignment.CoverageWeight,
+		LintPenalty:       newAssignment.LintPenalty,
+		MaxLintPenalty:    newAssignment.MaxLintPenalty,
 	}
 	return assignment, nil
 }
@@ -53,6 +53,54 @@ const (
 "grading": "Pass/Fail",
 "expected_effort": "10 hours",
 "autoapprove": false
+}`
+	jResourceLimits = `{
+"order": 1,
+"name": "Fork bombs",
+"deadline": "27-08-2017 12:00",
+"containertimeout": 5,
+"memorylimit": 512,
+"cpulimit": 1.5,
+"pidslimit": 100,
+"filesizelimit": 64
+}`
+	jTestRetries = `{
+"order": 1,
+"name": "Network sockets",
+"deadline": "27-08-2017 12:00",
+"testretries": 2
+}`
+	jTooManyTestRetries = `{
+"order": 1,
+"name": "Network sockets",
+"deadline": "27-08-2017 12:00",
+"testretries": 10
+}`
+	jCoverage = `{
+"order": 1,
+"name": "Network sockets",
+"deadline": "27-08-2017 12:00",
+"coveragethreshold": 80,
+"coverageweight": 2
+}`
+	jTooHighCoverageThreshold = `{
+"order": 1,
+"name": "Network sockets",
+"deadline": "27-08-2017 12:00",
+"coveragethreshold": 120
+}`
+	jLintPenalty = `{
+"order": 1,
+"name": "Network sockets",
+"deadline": "27-08-2017 12:00",
+"lintpenalty": 2,
+"maxlintpenalty": 10
+}`
+	jTooHighLintPenalty = `{
+"order": 1,
+"name": "Network sockets",
+"deadline": "27-08-2017 12:00",
+"lintpenalty": 101
 }`
 
 	script   = `Default script`
@@ -250,6 +298,126 @@ func TestParseUnknownFields(t *testing.T) {
 	}
 }
 
+func TestParseResourceLimits(t *testing.T) {
+	testsDir := t.TempDir()
+	writeFile(t, testsDir, "lab1", "assignment.json", jResourceLimits)
+
+	wantAssignment1 := &qf.Assignment{
+		Name:             "lab1",
+		Deadline:         qtest.Timestamp(t, "2017-08-27T12:00:00"),
+		Order:            1,
+		ScoreLimit:       80,
+		ContainerTimeout: 5,
+		MemoryLimit:      512,
+		CpuLimit:         1500,
+		PidsLimit:        100,
+		FileSizeLimit:    64,
+	}
+
+	assignments, _, err := readTestsRepositoryContent(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 1 {
+		t.Fatalf("len(assignments) = %d, want %d", len(assignments), 1)
+	}
+	if diff := cmp.Diff(assignments[0], wantAssignment1, protocmp.Transform()); diff != "" {
+		t.Errorf("readTestsRepositoryContent() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseTestRetries(t *testing.T) {
+	testsDir := t.TempDir()
+	writeFile(t, testsDir, "lab1", "assignment.json", jTestRetries)
+
+	wantAssignment1 := &qf.Assignment{
+		Name:        "lab1",
+		Deadline:    qtest.Timestamp(t, "2017-08-27T12:00:00"),
+		Order:       1,
+		ScoreLimit:  80,
+		TestRetries: 2,
+	}
+
+	assignments, _, err := readTestsRepositoryContent(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 1 {
+		t.Fatalf("len(assignments) = %d, want %d", len(assignments), 1)
+	}
+	if diff := cmp.Diff(assignments[0], wantAssignment1, protocmp.Transform()); diff != "" {
+		t.Errorf("readTestsRepositoryContent() mismatch (-want +got):\n%s", diff)
+	}
+
+	testsDir = t.TempDir()
+	writeFile(t, testsDir, "lab1", "assignment.json", jTooManyTestRetries)
+	if _, _, err := readTestsRepositoryContent(testsDir, 0); err == nil {
+		t.Error("readTestsRepositoryContent() succeeded with too many test retries, want error")
+	}
+}
+
+func TestParseCoverage(t *testing.T) {
+	testsDir := t.TempDir()
+	writeFile(t, testsDir, "lab1", "assignment.json", jCoverage)
+
+	wantAssignment1 := &qf.Assignment{
+		Name:              "lab1",
+		Deadline:          qtest.Timestamp(t, "2017-08-27T12:00:00"),
+		Order:             1,
+		ScoreLimit:        80,
+		CoverageThreshold: 80,
+		CoverageWeight:    2,
+	}
+
+	assignments, _, err := readTestsRepositoryContent(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 1 {
+		t.Fatalf("len(assignments) = %d, want %d", len(assignments), 1)
+	}
+	if diff := cmp.Diff(assignments[0], wantAssignment1, protocmp.Transform()); diff != "" {
+		t.Errorf("readTestsRepositoryContent() mismatch (-want +got):\n%s", diff)
+	}
+
+	testsDir = t.TempDir()
+	writeFile(t, testsDir, "lab1", "assignment.json", jTooHighCoverageThreshold)
+	if _, _, err := readTestsRepositoryContent(testsDir, 0); err == nil {
+		t.Error("readTestsRepositoryContent() succeeded with too high coverage threshold, want error")
+	}
+}
+
+func TestParseLintPenalty(t *testing.T) {
+	testsDir := t.TempDir()
+	writeFile(t, testsDir, "lab1", "assignment.json", jLintPenalty)
+
+	wantAssignment1 := &qf.Assignment{
+		Name:           "lab1",
+		Deadline:       qtest.Timestamp(t, "2017-08-27T12:00:00"),
+		Order:          1,
+		ScoreLimit:     80,
+		LintPenalty:    2,
+		MaxLintPenalty: 10,
+	}
+
+	assignments, _, err := readTestsRepositoryContent(testsDir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 1 {
+		t.Fatalf("len(assignments) = %d, want %d", len(assignments), 1)
+	}
+	if diff := cmp.Diff(assignments[0], wantAssignment1, protocmp.Transform()); diff != "" {
+		t.Errorf("readTestsRepositoryContent() mismatch (-want +got):\n%s", diff)
+	}
+
+	testsDir = t.TempDir()
+	writeFile(t, testsDir, "lab1", "assignment.json", jTooHighLintPenalty)
+	if _, _, err := readTestsRepositoryContent(testsDir, 0); err == nil {
+		t.Error("readTestsRepositoryContent() succeeded with too high lint penalty, want error")
+	}
+}
+
 func TestParseAndSaveAssignment(t *testing.T) {
 	testsDir := t.TempDir()
 
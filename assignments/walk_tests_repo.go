@@ -32,6 +32,7 @@ var patterns = []string{
 	criteriaFile,
 	testsFile,
 	ci.Dockerfile,
+	ci.LanguagesFile,
 	taskFilePattern,
 }
 
@@ -139,6 +140,16 @@ func readTestsRepositoryContent(dir string, courseID uint64) ([]*qf.Assignment,
 			continue
 		}
 
+		// The course's language profiles are loaded when running tests; only validate them here
+		if filename == ci.LanguagesFile {
+			if filepath.Dir(path) == filepath.Clean(dir) {
+				if err := ci.ValidateLanguages(contents); err != nil {
+					return nil, nil, err
+				}
+			}
+			continue
+		}
+
 		assignmentName := filepath.Base(filepath.Dir(path))
 		assignment, exists := assignmentsMap[assignmentName]
 		if !exists {
@@ -216,6 +216,7 @@ func TestReadTestsRepositoryContentBadContent(t *testing.T) {
 		{name: "NegativeInteger", folder: "testdata/invalid-tests/negative-integer", chkUnmarshal: true},
 		{name: "MissingAssignment1", folder: "testdata/invalid-tests/missing-assignment-json1", chkUnmarshal: false},
 		{name: "MissingAssignment2", folder: "testdata/invalid-tests/missing-assignment-json2", chkUnmarshal: false},
+		{name: "InvalidLanguages", folder: "testdata/invalid-tests/invalid-languages", chkUnmarshal: false},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
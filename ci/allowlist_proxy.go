@@ -0,0 +1,152 @@
+package ci
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/quickfeed/quickfeed/internal/rand"
+	"go.uber.org/zap"
+)
+
+// proxyUser is the user name in the proxy URL given to containers; the password identifies the job.
+const proxyUser = "quickfeed"
+
+// allowlistProxy is an HTTP proxy forwarding requests from containers with the
+// NetworkAllow policy to the hosts allowed by each job's policy. The containers
+// are attached to an internal network, leaving the proxy as their only way out.
+type allowlistProxy struct {
+	logger   *zap.SugaredLogger
+	listener net.Listener
+	server   *http.Server
+	mu       sync.Mutex
+	policies map[string]NetworkPolicy // map: job token -> network policy
+}
+
+// newAllowlistProxy starts a proxy listening on the given address.
+func newAllowlistProxy(logger *zap.SugaredLogger, addr string) (*allowlistProxy, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	p := &allowlistProxy{
+		logger:   logger,
+		listener: listener,
+		policies: make(map[string]NetworkPolicy),
+	}
+	p.server = &http.Server{Handler: p, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := p.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf("Allowlist proxy stopped: %v", err)
+		}
+	}()
+	return p, nil
+}
+
+// register registers the job's network policy, and returns the proxy URL for the
+// job's container and a function to unregister the policy when the job is done.
+func (p *allowlistProxy) register(policy NetworkPolicy) (proxyURL string, unregister func()) {
+	token := rand.String()
+	p.mu.Lock()
+	p.policies[token] = policy
+	p.mu.Unlock()
+	proxyURL = "http://" + proxyUser + ":" + token + "@" + p.listener.Addr().String()
+	return proxyURL, func() {
+		p.mu.Lock()
+		delete(p.policies, token)
+		p.mu.Unlock()
+	}
+}
+
+// policy returns the network policy for the job identified by the request's proxy credentials.
+func (p *allowlistProxy) policy(r *http.Request) (NetworkPolicy, bool) {
+	auth, ok := strings.CutPrefix(r.Header.Get("Proxy-Authorization"), "Basic ")
+	if !ok {
+		return NetworkPolicy{}, false
+	}
+	credentials, err := base64.StdEncoding.DecodeString(auth)
+	if err != nil {
+		return NetworkPolicy{}, false
+	}
+	_, token, _ := strings.Cut(string(credentials), ":")
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	policy, ok := p.policies[token]
+	return policy, ok
+}
+
+func (p *allowlistProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	policy, ok := p.policy(r)
+	if !ok {
+		w.Header().Set("Proxy-Authenticate", `Basic realm="quickfeed"`)
+		http.Error(w, "unknown job", http.StatusProxyAuthRequired)
+		return
+	}
+	if !policy.allows(r.URL.Hostname()) {
+		p.logger.Debugf("Allowlist proxy denied access to %s", r.URL.Host)
+		http.Error(w, "host not allowed by network policy: "+r.URL.Hostname(), http.StatusForbidden)
+		return
+	}
+	if r.Method == http.MethodConnect {
+		p.tunnel(w, r)
+		return
+	}
+	p.forward(w, r)
+}
+
+// tunnel connects the client to the requested host, e.g., for HTTPS requests.
+func (p *allowlistProxy) tunnel(w http.ResponseWriter, r *http.Request) {
+	dst, err := net.DialTimeout("tcp", r.URL.Host, 10*time.Second)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer dst.Close()
+	src, _, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer src.Close()
+	if _, err := io.WriteString(src, "HTTP/1.1 200 Connection Established\r\n\r\n"); err != nil {
+		return
+	}
+	done := make(chan struct{}, 2)
+	go func() { _, _ = io.Copy(dst, src); done <- struct{}{} }()
+	go func() { _, _ = io.Copy(src, dst); done <- struct{}{} }()
+	<-done
+}
+
+// forward forwards a plain HTTP request to the requested host.
+func (*allowlistProxy) forward(w http.ResponseWriter, r *http.Request) {
+	req := r.Clone(r.Context())
+	req.RequestURI = ""
+	req.Header.Del("Proxy-Authorization")
+	req.Header.Del("Proxy-Connection")
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for name, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+// Close stops the proxy.
+func (p *allowlistProxy) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return p.server.Shutdown(ctx)
+}
@@ -0,0 +1,87 @@
+package ci
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/quickfeed/quickfeed/internal/qtest"
+)
+
+func TestAllowlistProxy(t *testing.T) {
+	proxy, err := newAllowlistProxy(qtest.Logger(t), "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer proxy.Close()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, "hello")
+	})
+	httpServer := httptest.NewServer(handler)
+	defer httpServer.Close()
+	tlsServer := httptest.NewTLSServer(handler)
+	defer tlsServer.Close()
+
+	allowedURL, unregister := proxy.register(NetworkPolicy{Mode: NetworkAllow, Allow: []string{"127.0.0.1"}})
+	defer unregister()
+	deniedURL, unregisterDenied := proxy.register(NetworkPolicy{Mode: NetworkAllow, Allow: []string{"example.com"}})
+	defer unregisterDenied()
+	unknownURL := "http://" + proxyUser + ":unknown@" + proxy.listener.Addr().String()
+
+	tests := []struct {
+		name       string
+		proxyURL   string
+		server     *httptest.Server
+		wantStatus int
+		wantErr    bool
+	}{
+		{name: "AllowedHTTP", proxyURL: allowedURL, server: httpServer, wantStatus: http.StatusOK},
+		{name: "AllowedHTTPS", proxyURL: allowedURL, server: tlsServer, wantStatus: http.StatusOK},
+		{name: "DeniedHTTP", proxyURL: deniedURL, server: httpServer, wantStatus: http.StatusForbidden},
+		// the proxy refuses to establish the tunnel; hence, the client fails
+		{name: "DeniedHTTPS", proxyURL: deniedURL, server: tlsServer, wantErr: true},
+		{name: "UnknownJob", proxyURL: unknownURL, server: httpServer, wantStatus: http.StatusProxyAuthRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxyURL, err := url.Parse(tt.proxyURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			transport := tt.server.Client().Transport.(*http.Transport).Clone()
+			transport.Proxy = http.ProxyURL(proxyURL)
+			client := &http.Client{Transport: transport}
+			resp, err := client.Get(tt.server.URL)
+			if tt.wantErr {
+				if err == nil {
+					resp.Body.Close()
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+
+	// requests are denied once the job's policy is unregistered
+	unregister()
+	proxyURL, _ := url.Parse(allowedURL)
+	client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+	resp, err := client.Get(httpServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusProxyAuthRequired {
+		t.Errorf("status after unregister = %d, want %d", resp.StatusCode, http.StatusProxyAuthRequired)
+	}
+}
@@ -2,6 +2,7 @@ package ci
 
 import (
 	"context"
+	"io"
 )
 
 // Job describes how to execute a CI job.
@@ -13,6 +14,10 @@ type Job struct {
 	// Language specifies the programming language for the job.
 	// Parsed from the #language/ directive in the run script.
 	Language string
+	// CacheDirs maps container paths to host cache directories, relative to the home directory
+	// of the user running the job. The cache directories persist across jobs.
+	// Obtained from the language profile given by Language.
+	CacheDirs map[string]string
 	// BuildContext is a list of files to include in the Docker build context.
 	// These files are available to the Dockerfile (e.g. via COPY/ADD) and can be
 	// copied into the image, such as into the /quickfeed directory, if desired.
@@ -21,6 +26,10 @@ type Job struct {
 	BuildContext map[string]string
 	// BindDir is the directory to bind to the container's /quickfeed directory.
 	BindDir string
+	// ReportsDir is the directory to bind to the container's /quickfeed-reports directory, in which
+	// the job's tests write their results, coverage and lint files. The directory is separate from
+	// BindDir, which holds the student's code, and the job's files are only read from ReportsDir.
+	ReportsDir string
 	// ReadOnlyMounts maps host source paths to container target paths for read-only bind mounts.
 	// These are mounted in addition to BindDir and are not affected by changes in the container.
 	ReadOnlyMounts map[string]string
@@ -28,6 +37,35 @@ type Job struct {
 	Env []string
 	// Commands is a list of shell commands to run as part of the job.
 	Commands []string
+	// Limits specifies the resource limits for the job.
+	Limits Limits
+	// Network specifies the network access for the job.
+	// Parsed from the #network/ directive in the run script.
+	Network NetworkPolicy
+	// Services lists the sidecar services to start for the job.
+	// Parsed from the #service/ directives in the run script.
+	Services []Service
+	// ResultsFile is the slash-separated path, relative to ReportsDir, of the file in which
+	// the job's tests write their results, if any. The runner must make the file available
+	// below ReportsDir when the job is done. Parsed from the #results/ directive in the run script.
+	ResultsFile string
+	// resultsFormat is the format of the results file; see parseResultsFile.
+	resultsFormat string
+	// CoverageFile is the slash-separated path, relative to ReportsDir, of the file in which
+	// the job's tests write their code coverage, if any. The runner must make the file available
+	// below ReportsDir when the job is done. Parsed from the #coverage/ directive in the run script.
+	CoverageFile string
+	// coverageFormat is the format of the coverage file; see parseCoverageFile.
+	coverageFormat string
+	// LintFile is the slash-separated path, relative to ReportsDir, of the file in which
+	// the job's linters write their findings, if any. The runner must make the file available
+	// below ReportsDir when the job is done. Parsed from the #lint/ directive in the run script.
+	LintFile string
+	// lintFormat is the format of the lint file; see parseLintFile.
+	lintFormat string
+	// Output, if set, receives the job's output while the job is running.
+	// The job's complete output is still returned by the runner when the job is done.
+	Output io.Writer
 }
 
 // Runner contains methods for running user provided code in isolation.
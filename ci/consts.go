@@ -6,4 +6,16 @@ import "fmt"
 // the session secret from QuickFeed to the test code.
 const secretEnvName = "QUICKFEED_SESSION_SECRET"
 
+// Environment variable used by the CI system to tell the test code
+// that it is running against the course's reference solution.
+const solutionEnvName = "QUICKFEED_SOLUTION"
+
+// Environment variable used by the CI system to tell the run script
+// which tests failed when the tests are rerun.
+const failedTestsEnvName = "QUICKFEED_FAILED_TESTS"
+
+// solutionEnvVars are added to the environment of test runs against the reference solution.
+// The GOFLAGS variable enables the solution build tag; see kit/sh.RunningWithSolution.
+var solutionEnvVars = []string{solutionEnvName + "=true", "GOFLAGS=-tags=solution"}
+
 var ErrConflict = fmt.Errorf("submission is already being built, please wait")
@@ -0,0 +1,178 @@
+package ci
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+)
+
+// Supported coverage file formats for the #coverage/ directive.
+const (
+	formatGoCover = "go"
+	formatLCOV    = "lcov"
+)
+
+// parseCoverageFile returns the coverage file given by the #coverage/ directive,
+// e.g., #coverage/go coverage.out.
+func parseCoverageFile(directive string) (*jobFile, error) {
+	return parseJobFile("coverage", directive, formatGoCover, formatLCOV)
+}
+
+// ReadCoverageFile returns the content of the coverage file written by the job's tests.
+func (j *Job) ReadCoverageFile() ([]byte, error) {
+	return j.readFile("coverage", j.CoverageFile)
+}
+
+// WriteCoverageFile writes the given content to the job's coverage file below the job's reports directory.
+// Runners that do not run the job on this host use this method to make the coverage file available.
+func (j *Job) WriteCoverageFile(content []byte) error {
+	return j.writeFile("coverage", j.CoverageFile, content)
+}
+
+// applyCoverageFile records the code coverage in the job's coverage file in the build info of the given results.
+func applyCoverageFile(job *Job, results *score.Results) error {
+	content, err := job.ReadCoverageFile()
+	if err != nil {
+		return err
+	}
+	coverage, err := parseCoverage(job.coverageFormat, content)
+	if err != nil {
+		return err
+	}
+	if results.BuildInfo == nil {
+		results.BuildInfo = &score.BuildInfo{}
+	}
+	results.BuildInfo.Coverage = coverage
+	return nil
+}
+
+// parseCoverage returns the code coverage found in the given coverage file content.
+func parseCoverage(format string, content []byte) (*score.Coverage, error) {
+	switch format {
+	case formatGoCover:
+		return parseGoCoverProfile(content)
+	case formatLCOV:
+		return parseLCOV(content)
+	}
+	return nil, fmt.Errorf("unknown coverage format: %q", format)
+}
+
+// packageCounts holds the number of covered and total statements or lines of a package.
+type packageCounts struct {
+	covered, total uint64
+}
+
+// newCoverage returns the coverage of the given packages, sorted by package name.
+func newCoverage(packages map[string]*packageCounts) *score.Coverage {
+	coverage := &score.Coverage{}
+	for _, pkg := range slices.Sorted(maps.Keys(packages)) {
+		counts := packages[pkg]
+		coverage.Packages = append(coverage.Packages, &score.PackageCoverage{
+			Package: pkg,
+			Covered: counts.covered,
+			Total:   counts.total,
+		})
+		coverage.Covered += counts.covered
+		coverage.Total += counts.total
+	}
+	return coverage
+}
+
+// parseGoCoverProfile returns the statement coverage in the given profile written by go test -coverprofile.
+// Each line after the mode line describes a block of a file, e.g., "example.com/lab1/add.go:3.24,5.2 1 1",
+// giving the block's number of statements and the number of times the block was executed.
+// A block that appears several times, e.g., when using -coverpkg, is covered if any of its counts is non-zero.
+func parseGoCoverProfile(content []byte) (*score.Coverage, error) {
+	type block struct {
+		pkg     string
+		stmts   uint64
+		covered bool
+	}
+	blocks := make(map[string]*block)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, maxJobFileSize)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid coverage profile line %d: %q", n, line)
+		}
+		file, _, found := strings.Cut(fields[0], ":")
+		stmts, err1 := strconv.ParseUint(fields[1], 10, 64)
+		count, err2 := strconv.ParseUint(fields[2], 10, 64)
+		if !found || err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("invalid coverage profile line %d: %q", n, line)
+		}
+		b, ok := blocks[fields[0]]
+		if !ok {
+			b = &block{pkg: path.Dir(file), stmts: stmts}
+			blocks[fields[0]] = b
+		}
+		b.covered = b.covered || count > 0
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse coverage profile: %w", err)
+	}
+	packages := make(map[string]*packageCounts)
+	for _, b := range blocks {
+		counts, ok := packages[b.pkg]
+		if !ok {
+			counts = &packageCounts{}
+			packages[b.pkg] = counts
+		}
+		counts.total += b.stmts
+		if b.covered {
+			counts.covered += b.stmts
+		}
+	}
+	return newCoverage(packages), nil
+}
+
+// parseLCOV returns the line coverage in the given LCOV tracefile, using the directory
+// of each source file (SF) as its package, and the file's lines found (LF) and hit (LH).
+func parseLCOV(content []byte) (*score.Coverage, error) {
+	packages := make(map[string]*packageCounts)
+	var counts *packageCounts
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, maxJobFileSize)
+	for n := 1; scanner.Scan(); n++ {
+		key, value, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		switch key {
+		case "SF":
+			pkg := path.Dir(strings.ReplaceAll(value, `\`, "/"))
+			if counts = packages[pkg]; counts == nil {
+				counts = &packageCounts{}
+				packages[pkg] = counts
+			}
+		case "LF", "LH":
+			if counts == nil {
+				return nil, fmt.Errorf("invalid LCOV line %d: %s outside a source file record", n, key)
+			}
+			lines, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid LCOV line %d: %w", n, err)
+			}
+			if key == "LF" {
+				counts.total += lines
+			} else {
+				counts.covered += lines
+			}
+		case "end_of_record":
+			counts = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse LCOV tracefile: %w", err)
+	}
+	return newCoverage(packages), nil
+}
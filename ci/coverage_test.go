@@ -0,0 +1,164 @@
+package ci
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseRunScriptCoverage(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		want    *jobFile
+		wantErr bool
+	}{
+		{name: "NoCoverage", script: "#image/quickfeed:go\necho hello\necho world"},
+		{
+			name:   "Go",
+			script: "#image/quickfeed:go\n#coverage/go coverage.out\ngo test -coverprofile=coverage.out ./...",
+			want:   &jobFile{kind: "coverage", format: formatGoCover, path: "coverage.out"},
+		},
+		{
+			name:   "LCOV",
+			script: "#image/quickfeed:python\n#results/junit report.xml\n#coverage/LCOV out/lcov.info\npytest",
+			want:   &jobFile{kind: "coverage", format: formatLCOV, path: "out/lcov.info"},
+		},
+		{name: "UnknownFormat", script: "#image/quickfeed:python\n#coverage/cobertura coverage.xml\npytest", wantErr: true},
+		{name: "OutsideHome", script: "#image/quickfeed:go\n#coverage/go ../coverage.out\ngo test", wantErr: true},
+		{name: "Duplicate", script: "#image/quickfeed:go\n#coverage/go a.out\n#coverage/go b.out\ngo test", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script, err := parseRunScript(tt.script)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRunScript() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, script.coverage, cmp.AllowUnexported(jobFile{})); diff != "" {
+				t.Errorf("parseRunScript() coverage mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseGoCoverProfile(t *testing.T) {
+	const profile = `mode: set
+example.com/lab1/add.go:3.24,5.2 1 1
+example.com/lab1/add.go:7.24,9.2 2 0
+example.com/lab1/util/max.go:3.24,4.12 1 0
+example.com/lab1/util/max.go:4.12,6.3 3 1
+example.com/lab1/util/max.go:3.24,4.12 1 1
+`
+	got, err := parseCoverage(formatGoCover, []byte(profile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &score.Coverage{
+		Packages: []*score.PackageCoverage{
+			{Package: "example.com/lab1", Covered: 1, Total: 3},
+			{Package: "example.com/lab1/util", Covered: 4, Total: 4},
+		},
+		Covered: 5,
+		Total:   7,
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("parseCoverage() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := parseCoverage(formatGoCover, []byte("mode: set\nexample.com/lab1/add.go:3.24,5.2 x 1\n")); err == nil {
+		t.Error("parseCoverage() succeeded with invalid profile, want error")
+	}
+}
+
+func TestParseLCOV(t *testing.T) {
+	const tracefile = `TN:
+SF:src/calc/add.js
+DA:1,1
+DA:2,0
+LF:2
+LH:1
+end_of_record
+SF:src/calc/sub.js
+LF:4
+LH:4
+end_of_record
+SF:src/main.js
+LF:10
+LH:0
+end_of_record
+`
+	got, err := parseCoverage(formatLCOV, []byte(tracefile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &score.Coverage{
+		Packages: []*score.PackageCoverage{
+			{Package: "src", Covered: 0, Total: 10},
+			{Package: "src/calc", Covered: 5, Total: 6},
+		},
+		Covered: 5,
+		Total:   16,
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("parseCoverage() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := parseCoverage(formatLCOV, []byte("LF:2\n")); err == nil {
+		t.Error("parseCoverage() succeeded with lines outside a source file record, want error")
+	}
+}
+
+func TestApplyCoverageFile(t *testing.T) {
+	const profile = "mode: set\nexample.com/lab1/add.go:3.24,5.2 1 1\n"
+	bindDir, reportsDir := t.TempDir(), t.TempDir()
+	for _, dir := range []string{bindDir, reportsDir} {
+		if err := os.WriteFile(filepath.Join(dir, "cover.out"), []byte(profile), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// a coverage file planted by the student's code, linking to a file outside the reports directory
+	if err := os.Symlink(filepath.Join(bindDir, "cover.out"), filepath.Join(reportsDir, "forged.out")); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		job     *Job
+		want    *score.Coverage
+		wantErr bool
+	}{
+		{
+			name: "ReportsDir",
+			job:  &Job{BindDir: bindDir, ReportsDir: reportsDir, CoverageFile: "cover.out", coverageFormat: formatGoCover},
+			want: &score.Coverage{Packages: []*score.PackageCoverage{{Package: "example.com/lab1", Covered: 1, Total: 1}}, Covered: 1, Total: 1},
+		},
+		{
+			name:    "BindDirOnly",
+			job:     &Job{BindDir: bindDir, ReportsDir: t.TempDir(), CoverageFile: "cover.out", coverageFormat: formatGoCover},
+			wantErr: true,
+		},
+		{
+			name:    "Symlink",
+			job:     &Job{BindDir: bindDir, ReportsDir: reportsDir, CoverageFile: "forged.out", coverageFormat: formatGoCover},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := &score.Results{}
+			err := applyCoverageFile(tt.job, results)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyCoverageFile() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, results.GetBuildInfo().GetCoverage(), protocmp.Transform()); diff != "" {
+				t.Errorf("applyCoverageFile() coverage mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
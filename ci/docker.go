@@ -13,6 +13,7 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/containerd/errdefs"
@@ -29,15 +30,21 @@ var DefaultContainerTimeout = time.Duration(10 * time.Minute)
 const (
 	Dockerfile      = "Dockerfile"
 	QuickFeedPath   = "/quickfeed"
+	ReportsPath     = "/quickfeed-reports"
 	maxToScan       = 1_000_000 // bytes
 	maxLogSize      = 30_000    // bytes
 	lastSegmentSize = 1_000     // bytes
 )
 
-// Docker is an implementation of the CI interface using Docker.
+// Docker is an implementation of the CI interface using Docker,
+// or a Docker-compatible API such as the one provided by Podman.
 type Docker struct {
 	client *client.Client
 	logger *zap.SugaredLogger
+	// userns is the user namespace mode for containers; empty for the engine's default.
+	userns container.UsernsMode
+	mu     sync.Mutex
+	proxy  *allowlistProxy // started on first use by a job with the NetworkAllow policy
 }
 
 // NewDockerCI returns a runner to run CI tests.
@@ -58,8 +65,14 @@ func (d *Docker) Close() error {
 	if d.logger != nil {
 		syncErr = d.logger.Sync()
 	}
+	var proxyErr error
+	d.mu.Lock()
+	if d.proxy != nil {
+		proxyErr = d.proxy.Close()
+	}
+	d.mu.Unlock()
 	closeErr := d.client.Close()
-	return errors.Join(syncErr, closeErr)
+	return errors.Join(syncErr, proxyErr, closeErr)
 }
 
 // Run implements the CI interface. This method blocks until the job has been
@@ -69,23 +82,42 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 		return "", fmt.Errorf("cannot run job: %s; docker client not initialized", job.Name)
 	}
 
-	resp, err := d.createImage(ctx, job)
+	network, err := d.networkConfig(ctx, job)
+	if err != nil {
+		return "", err
+	}
+	defer network.release()
+
+	resp, err := d.createImage(ctx, job, network)
 	if err != nil {
 		return "", err
 	}
+	for _, networkName := range network.connect {
+		if _, err := d.client.NetworkConnect(ctx, networkName, client.NetworkConnectOptions{Container: resp.ID}); err != nil {
+			return "", err
+		}
+	}
 	d.logger.Infof("Created container image '%s' for %s", job.Image, job.Name)
 	if _, err = d.client.ContainerStart(ctx, resp.ID, client.ContainerStartOptions{}); err != nil {
 		return "", err
 	}
+	stopFollow := d.followLogs(ctx, job, resp.ID)
+	stopPids := d.samplePids(ctx, job, resp.ID)
 
 	d.logger.Infof("Waiting for container image '%s' for %s", job.Image, job.Name)
 	msg, err := d.waitForContainer(ctx, job, resp.ID)
+	stopFollow()
+	peakPids := stopPids()
 	if err != nil {
 		return msg, err
 	}
 
 	d.logger.Infof("Done waiting for container image '%s' for %s", job.Image, job.Name)
-	// extract the logs before removing the container below
+	// inspect the container's final state and extract the logs before removing the container below
+	inspect, err := d.client.ContainerInspect(ctx, resp.ID, client.ContainerInspectOptions{})
+	if err != nil {
+		return "", err
+	}
 	logReader, err := d.client.ContainerLogs(ctx, resp.ID, client.ContainerLogsOptions{
 		ShowStdout: true,
 	})
@@ -104,14 +136,83 @@ func (d *Docker) Run(ctx context.Context, job *Job) (string, error) {
 	if _, err := stdcopy.StdCopy(&stdout, io.Discard, logReader); err != nil {
 		return "", err
 	}
-	if stdout.Len() > maxLogSize+lastSegmentSize {
-		return truncateLog(&stdout, maxLogSize, lastSegmentSize, maxToScan), nil
+	out := truncatedLog(&stdout)
+	if exceeded := job.Limits.exceeded(inspect.Container.State, peakPids); len(exceeded) > 0 {
+		d.logger.Infof("Container '%s' for %s exceeded resource limits: %v", job.Image, job.Name, exceeded)
+		out += job.Limits.report(exceeded)
+	}
+	return out, nil
+}
+
+// followLogs copies the container's output to the job's Output writer, if any, while the container is running.
+// The returned function waits for the remaining output to be copied after the container has stopped.
+func (d *Docker) followLogs(ctx context.Context, job *Job, containerID string) func() {
+	if job.Output == nil {
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		logReader, err := d.client.ContainerLogs(ctx, containerID, client.ContainerLogsOptions{
+			ShowStdout: true,
+			Follow:     true,
+		})
+		if err != nil {
+			d.logger.Errorf("Failed to follow logs for %s: %v", job.Name, err)
+			return
+		}
+		defer logReader.Close()
+		if _, err := stdcopy.StdCopy(job.Output, io.Discard, logReader); err != nil && ctx.Err() == nil {
+			d.logger.Errorf("Failed to follow logs for %s: %v", job.Name, err)
+		}
+	}()
+	return func() {
+		// the log stream ends when the container stops; stop waiting if it lingers
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+		}
+		cancel()
+		<-done
+	}
+}
+
+// samplePids samples the container's number of processes while it is running, if the job has a process limit.
+// The returned function stops sampling and returns the peak number of processes observed.
+func (d *Docker) samplePids(ctx context.Context, job *Job, containerID string) func() uint64 {
+	if job.Limits.Pids <= 0 {
+		return func() uint64 { return 0 }
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	var peak uint64
+	go func() {
+		defer close(done)
+		stats, err := d.client.ContainerStats(ctx, containerID, client.ContainerStatsOptions{Stream: true})
+		if err != nil {
+			d.logger.Errorf("Failed to sample processes for %s: %v", job.Name, err)
+			return
+		}
+		defer stats.Body.Close()
+		dec := json.NewDecoder(stats.Body)
+		for {
+			var sample container.StatsResponse
+			if err := dec.Decode(&sample); err != nil {
+				return
+			}
+			peak = max(peak, sample.PidsStats.Current)
+		}
+	}()
+	return func() uint64 {
+		cancel()
+		<-done
+		return peak
 	}
-	return stdout.String(), nil
 }
 
-// createImage creates an image for the given job.
-func (d *Docker) createImage(ctx context.Context, job *Job) (*client.ContainerCreateResult, error) {
+// createImage creates an image for the given job, attached to the given network.
+func (d *Docker) createImage(ctx context.Context, job *Job, network *containerNetwork) (*client.ContainerCreateResult, error) {
 	if job.Image == "" {
 		// image name should be specified in a run.sh file in the tests repository
 		return nil, fmt.Errorf("no image name specified for '%s'", job.Name)
@@ -136,7 +237,10 @@ func (d *Docker) createImage(ctx context.Context, job *Job) (*client.ContainerCr
 		}
 	}
 
-	var hostConfig *container.HostConfig
+	hostConfig := &container.HostConfig{
+		Resources:   job.Limits.resources(),
+		NetworkMode: network.mode,
+	}
 	if job.BindDir != "" {
 		mounts := []mount.Mount{
 			{
@@ -145,18 +249,16 @@ func (d *Docker) createImage(ctx context.Context, job *Job) (*client.ContainerCr
 				Target: QuickFeedPath,
 			},
 		}
-		if cfg, ok := languages[job.Language]; ok {
-			for target, pathFn := range cfg.cacheDirs {
-				src, err := pathFn()
-				if err != nil {
-					return nil, err
-				}
-				mounts = append(mounts, mount.Mount{
-					Type:   mount.TypeBind,
-					Source: src,
-					Target: target,
-				})
+		for _, target := range slices.Sorted(maps.Keys(job.CacheDirs)) {
+			src, err := hostCacheDir(job.CacheDirs[target])
+			if err != nil {
+				return nil, err
 			}
+			mounts = append(mounts, mount.Mount{
+				Type:   mount.TypeBind,
+				Source: src,
+				Target: target,
+			})
 		}
 		for _, src := range slices.Sorted(maps.Keys(job.ReadOnlyMounts)) {
 			mounts = append(mounts, mount.Mount{
@@ -166,17 +268,24 @@ func (d *Docker) createImage(ctx context.Context, job *Job) (*client.ContainerCr
 				ReadOnly: true,
 			})
 		}
-		hostConfig = &container.HostConfig{
-			Mounts: mounts,
-		}
+		hostConfig.Mounts = mounts
+	}
+	if job.ReportsDir != "" {
+		// the reports are mounted outside the bind directory, which holds the student's code
+		hostConfig.Mounts = append(hostConfig.Mounts, mount.Mount{
+			Type:   mount.TypeBind,
+			Source: job.ReportsDir,
+			Target: ReportsPath,
+		})
 	}
+	hostConfig.UsernsMode = d.userns
 
 	create := func() (client.ContainerCreateResult, error) {
 		return d.client.ContainerCreate(ctx, client.ContainerCreateOptions{
 			Config: &container.Config{
 				Image: job.Image,
 				User:  fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid()), // Run the image as the current user, e.g., quickfeed
-				Env:   job.Env,                                        // Set default environment variables
+				Env:   slices.Concat(job.Env, network.env),            // Set default environment variables
 				Cmd:   []string{"/bin/bash", "-c", strings.Join(job.Commands, "\n")},
 			},
 			HostConfig: hostConfig,
@@ -0,0 +1,132 @@
+package ci
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/containerd/errdefs"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/client"
+)
+
+// allowlistNetwork is the internal network for containers with the NetworkAllow policy.
+// Containers on the network can only reach the allowlist proxy on the network's gateway.
+const allowlistNetwork = "quickfeed-allowlist"
+
+// containerNetwork describes the network configuration of a job's container.
+type containerNetwork struct {
+	mode container.NetworkMode
+	// connect lists additional networks to connect the container to, before it starts.
+	connect []string
+	// env holds the proxy and service environment variables for the container.
+	env []string
+	// releaseFns release the resources held for the job's network, once the container exits.
+	releaseFns []func()
+}
+
+// release releases the resources held for the job's network, in reverse order of acquisition.
+func (n *containerNetwork) release() {
+	for _, fn := range slices.Backward(n.releaseFns) {
+		fn()
+	}
+}
+
+// networkConfig returns the network configuration for the job's network policy,
+// and starts the job's services, if any, on a private network shared with the job.
+// The caller must call the configuration's release method once the job's container exits.
+func (d *Docker) networkConfig(ctx context.Context, job *Job) (*containerNetwork, error) {
+	cn := &containerNetwork{}
+	switch job.Network.Mode {
+	case "":
+	case NetworkNone, NetworkLoopback:
+		// Docker's none network mode provides only a loopback interface
+		cn.mode = network.NetworkNone
+	case NetworkAllow:
+		proxy, err := d.allowlistProxy(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start allowlist proxy for %s: %w", job.Name, err)
+		}
+		proxyURL, unregister := proxy.register(job.Network)
+		cn.mode = allowlistNetwork
+		cn.env = []string{
+			"HTTP_PROXY=" + proxyURL, "HTTPS_PROXY=" + proxyURL,
+			"http_proxy=" + proxyURL, "https_proxy=" + proxyURL,
+			"NO_PROXY=localhost,127.0.0.1", "no_proxy=localhost,127.0.0.1",
+		}
+		cn.releaseFns = append(cn.releaseFns, unregister)
+	default:
+		return nil, fmt.Errorf("unknown network policy for %s: %s", job.Name, job.Network.Mode)
+	}
+	if len(job.Services) == 0 {
+		return cn, nil
+	}
+
+	// With a network policy, the private network is internal; it only gives access to the services.
+	networkName, err := d.createJobNetwork(ctx, job, job.Network.Mode != "")
+	if err != nil {
+		cn.release()
+		return nil, err
+	}
+	cn.releaseFns = append(cn.releaseFns, func() { d.removeJobNetwork(networkName) })
+	removeServices, err := d.startServices(ctx, job, networkName)
+	if err != nil {
+		cn.release()
+		return nil, err
+	}
+	cn.releaseFns = append(cn.releaseFns, removeServices)
+	if cn.mode == allowlistNetwork {
+		cn.connect = append(cn.connect, allowlistNetwork)
+	}
+	cn.mode = container.NetworkMode(networkName)
+	for _, service := range job.Services {
+		cn.env = append(cn.env, service.HostEnv())
+	}
+	return cn, nil
+}
+
+// allowlistProxy returns the allowlist proxy, starting it on the allowlist network's gateway if necessary.
+func (d *Docker) allowlistProxy(ctx context.Context) (*allowlistProxy, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.proxy != nil {
+		return d.proxy, nil
+	}
+	gateway, err := d.allowlistGateway(ctx)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := newAllowlistProxy(d.logger, net.JoinHostPort(gateway, "0"))
+	if err != nil {
+		return nil, err
+	}
+	d.logger.Infof("Started allowlist proxy on %s", proxy.listener.Addr())
+	d.proxy = proxy
+	return proxy, nil
+}
+
+// allowlistGateway returns the gateway address of the allowlist network, creating the network if necessary.
+func (d *Docker) allowlistGateway(ctx context.Context) (string, error) {
+	res, err := d.client.NetworkInspect(ctx, allowlistNetwork, client.NetworkInspectOptions{})
+	if errdefs.IsNotFound(err) {
+		_, err = d.client.NetworkCreate(ctx, allowlistNetwork, client.NetworkCreateOptions{
+			Driver:   "bridge",
+			Internal: true,
+		})
+		if err != nil && !errdefs.IsConflict(err) {
+			return "", err
+		}
+		res, err = d.client.NetworkInspect(ctx, allowlistNetwork, client.NetworkInspectOptions{})
+	}
+	if err != nil {
+		return "", err
+	}
+	for _, cfg := range res.Network.IPAM.Config {
+		if cfg.Gateway.IsValid() {
+			return cfg.Gateway.String(), nil
+		}
+	}
+	return "", fmt.Errorf("network %s has no gateway", allowlistNetwork)
+}
@@ -0,0 +1,168 @@
+package ci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/containerd/errdefs"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/client"
+)
+
+// jobNetworkPrefix prefixes the names of the private networks shared by jobs and their services.
+const jobNetworkPrefix = "quickfeed-"
+
+// createJobNetwork creates a private network for the job and its services.
+// An internal network prevents access to hosts outside the network.
+func (d *Docker) createJobNetwork(ctx context.Context, job *Job, internal bool) (string, error) {
+	name := jobNetworkPrefix + job.Name
+	_, err := d.client.NetworkCreate(ctx, name, client.NetworkCreateOptions{
+		Driver:   "bridge",
+		Internal: internal,
+	})
+	if errdefs.IsConflict(err) {
+		d.logger.Errorf("Network '%s' already exists for '%s': %v", name, job.Name, err)
+		return "", ErrConflict
+	}
+	return name, err
+}
+
+// removeJobNetwork removes the job's private network.
+func (d *Docker) removeJobNetwork(name string) {
+	if _, err := d.client.NetworkRemove(context.Background(), name, client.NetworkRemoveOptions{}); err != nil {
+		d.logger.Errorf("Failed to remove network '%s': %v", name, err)
+	}
+}
+
+// startServices starts the job's services on the given network and waits until they are ready.
+// The returned function removes the service containers.
+func (d *Docker) startServices(ctx context.Context, job *Job, networkName string) (func(), error) {
+	var ids []string
+	remove := func() {
+		for _, id := range ids {
+			_, err := d.client.ContainerRemove(context.Background(), id, client.ContainerRemoveOptions{Force: true, RemoveVolumes: true})
+			if err != nil {
+				d.logger.Errorf("Failed to remove service container %s for %s: %v", id, job.Name, err)
+			}
+		}
+	}
+	for _, service := range job.Services {
+		id, err := d.startService(ctx, job, service, networkName)
+		if id != "" {
+			ids = append(ids, id)
+		}
+		if err != nil {
+			remove()
+			return nil, fmt.Errorf("failed to start service %s for %s: %w", service.Name, job.Name, err)
+		}
+	}
+	readyCtx, cancel := context.WithTimeout(ctx, serviceReadyTimeout)
+	defer cancel()
+	for i, service := range job.Services {
+		if err := d.waitForService(readyCtx, ids[i], networkName); err != nil {
+			remove()
+			return nil, fmt.Errorf("service %s for %s not ready: %w", service.Name, job.Name, err)
+		}
+		d.logger.Infof("Service '%s' ready for %s", service.Image, job.Name)
+	}
+	return remove, nil
+}
+
+// startService creates and starts the service's container, pulling the service's image if necessary.
+func (d *Docker) startService(ctx context.Context, job *Job, service Service, networkName string) (string, error) {
+	create := func() (client.ContainerCreateResult, error) {
+		return d.client.ContainerCreate(ctx, client.ContainerCreateOptions{
+			Config: &container.Config{
+				Image: service.Image,
+				Env:   service.Env,
+			},
+			HostConfig: &container.HostConfig{
+				NetworkMode: container.NetworkMode(networkName),
+			},
+			NetworkingConfig: &network.NetworkingConfig{
+				EndpointsConfig: map[string]*network.EndpointSettings{
+					networkName: {Aliases: []string{service.Name}},
+				},
+			},
+			Name: job.Name + "-" + service.Name,
+		})
+	}
+	resp, err := create()
+	switch {
+	case errdefs.IsConflict(err):
+		return "", ErrConflict
+	case errdefs.IsNotFound(err):
+		d.logger.Infof("Trying to pull service image: '%s' from remote repository", service.Image)
+		if err := d.pullImage(ctx, service.Image); err != nil {
+			return "", err
+		}
+		resp, err = create()
+	}
+	if err != nil {
+		return "", err
+	}
+	_, err = d.client.ContainerStart(ctx, resp.ID, client.ContainerStartOptions{})
+	return resp.ID, err
+}
+
+// waitForService waits until the service container is ready or the context is done.
+// The service is ready when its health check reports healthy or, if the service's
+// image has no health check, when its exposed TCP ports accept connections.
+func (d *Docker) waitForService(ctx context.Context, id, networkName string) error {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		res, err := d.client.ContainerInspect(ctx, id, client.ContainerInspectOptions{})
+		if err != nil {
+			return err
+		}
+		ready, err := serviceReady(res.Container, networkName)
+		if ready || err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// serviceReady returns true if the inspected service container is ready.
+func serviceReady(c container.InspectResponse, networkName string) (bool, error) {
+	if c.State == nil {
+		return false, nil
+	}
+	if !c.State.Running {
+		if c.State.Status == container.StateExited || c.State.Status == container.StateDead {
+			return false, fmt.Errorf("service exited with status %d", c.State.ExitCode)
+		}
+		return false, nil
+	}
+	if health := c.State.Health; health != nil && health.Status != container.NoHealthcheck {
+		if health.Status == container.Unhealthy {
+			return false, errors.New("service is unhealthy")
+		}
+		return health.Status == container.Healthy, nil
+	}
+	if c.Config == nil || c.NetworkSettings == nil || c.NetworkSettings.Networks[networkName] == nil {
+		return false, nil
+	}
+	ip := c.NetworkSettings.Networks[networkName].IPAddress
+	for port := range c.Config.ExposedPorts {
+		if port.Proto() != network.TCP {
+			continue
+		}
+		conn, err := net.DialTimeout("tcp", net.JoinHostPort(ip.String(), strconv.Itoa(int(port.Num()))), time.Second)
+		if err != nil {
+			return false, nil
+		}
+		conn.Close()
+	}
+	return true, nil
+}
@@ -589,3 +589,97 @@ func countOpenFiles(t *testing.T) int {
 	}
 	return bytes.Count(out, []byte("\n"))
 }
+
+func TestDockerMemoryLimit(t *testing.T) {
+	if !docker {
+		t.SkipNow()
+	}
+
+	const (
+		// tail buffers its input since it contains no newlines, exceeding the memory limit
+		script  = `head -c 500m /dev/zero | tail`
+		wantOut = "Container exceeded the memory limit of 64 MB."
+		image   = "golang:latest"
+	)
+	docker, closeFn := dockerClient(t)
+	defer closeFn()
+
+	out, err := docker.Run(context.Background(), &ci.Job{
+		Name:     t.Name() + "-" + qtest.RandomString(t),
+		Image:    image,
+		Commands: []string{script},
+		Limits:   ci.Limits{Memory: 64 << 20},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, wantOut) {
+		t.Errorf("docker.Run(%#v) = %#v, want %#v", script, out, wantOut)
+	}
+}
+
+func TestDockerNetworkNone(t *testing.T) {
+	if !docker {
+		t.SkipNow()
+	}
+
+	const (
+		script  = `curl -sS --max-time 5 https://proxy.golang.org > /dev/null 2>&1 && echo -n "online" || echo -n "offline"`
+		wantOut = "offline"
+		image   = "golang:latest"
+	)
+	docker, closeFn := dockerClient(t)
+	defer closeFn()
+
+	out, err := docker.Run(context.Background(), &ci.Job{
+		Name:     t.Name() + "-" + qtest.RandomString(t),
+		Image:    image,
+		Commands: []string{script},
+		Network:  ci.NetworkPolicy{Mode: ci.NetworkNone},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != wantOut {
+		t.Errorf("docker.Run(%#v) = %#v, want %#v", script, out, wantOut)
+	}
+}
+
+func TestDockerServices(t *testing.T) {
+	if !docker {
+		t.SkipNow()
+	}
+
+	const (
+		script  = `PGPASSWORD=secret psql -h "$SERVICE_POSTGRES_HOST" -U postgres -tAc "SELECT 40 + 2"`
+		wantOut = "42\n"
+		image   = "postgres:16"
+	)
+	docker, closeFn := dockerClient(t)
+	defer closeFn()
+
+	tests := []struct {
+		name    string
+		network ci.NetworkPolicy
+	}{
+		{name: "Default"},
+		{name: "None", network: ci.NetworkPolicy{Mode: ci.NetworkNone}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := docker.Run(context.Background(), &ci.Job{
+				Name:     strings.ReplaceAll(t.Name(), "/", "-") + "-" + qtest.RandomString(t),
+				Image:    image,
+				Commands: []string{script},
+				Network:  tt.network,
+				Services: []ci.Service{{Name: "postgres", Image: image, Env: []string{"POSTGRES_PASSWORD=secret"}}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != wantOut {
+				t.Errorf("docker.Run(%#v) = %#v, want %#v", script, out, wantOut)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package ci
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// reportDryRun records on the given dry-run job how the results differ from the results
+// recorded for the job's submission: the new score and the tests whose status flipped.
+// The results are not recorded.
+func (q *Queue) reportDryRun(job *qf.Job, results *score.Results) error {
+	submission, err := q.db.GetSubmission(&qf.Submission{ID: job.GetSubmissionID()})
+	if err != nil {
+		return fmt.Errorf("failed to get submission %d: %w", job.GetSubmissionID(), err)
+	}
+	job.Score = results.Sum()
+	job.NowPassing, job.NowFailing = flippedTests(submission.GetScores(), results.Scores)
+	return nil
+}
+
+// flippedTests returns the names of the tests that pass in the current scores but failed in the
+// previous scores, and the names of the tests that fail in the current scores but passed in the
+// previous scores. Tests without previous scores are compared with failing tests.
+func flippedTests(previous, current []*score.Score) (nowPassing, nowFailing []string) {
+	passed := make(map[string]bool)
+	for _, sc := range previous {
+		passed[sc.GetTestName()] = testPassed(sc)
+	}
+	for _, sc := range current {
+		switch wasPassed := passed[sc.GetTestName()]; {
+		case testPassed(sc) && !wasPassed:
+			nowPassing = append(nowPassing, sc.GetTestName())
+		case !testPassed(sc) && wasPassed:
+			nowFailing = append(nowFailing, sc.GetTestName())
+		}
+	}
+	slices.Sort(nowPassing)
+	slices.Sort(nowFailing)
+	return nowPassing, nowFailing
+}
+
+// testPassed returns true if the test obtained its max score.
+func testPassed(sc *score.Score) bool {
+	return sc.GetScore() >= sc.GetMaxScore()
+}
@@ -0,0 +1,33 @@
+package ci
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/kit/score"
+)
+
+func TestFlippedTests(t *testing.T) {
+	previous := []*score.Score{
+		{TestName: "TestFixed", Score: 0, MaxScore: 10},
+		{TestName: "TestBroken", Score: 10, MaxScore: 10},
+		{TestName: "TestPassing", Score: 10, MaxScore: 10},
+		{TestName: "TestFailing", Score: 5, MaxScore: 10},
+		{TestName: "TestRemoved", Score: 10, MaxScore: 10},
+	}
+	current := []*score.Score{
+		{TestName: "TestPassing", Score: 10, MaxScore: 10},
+		{TestName: "TestFailing", Score: 2, MaxScore: 10},
+		{TestName: "TestFixed", Score: 10, MaxScore: 10},
+		{TestName: "TestBroken", Score: 9, MaxScore: 10},
+		{TestName: "TestNewPassing", Score: 1, MaxScore: 1},
+		{TestName: "TestNewFailing", Score: 0, MaxScore: 1},
+	}
+	nowPassing, nowFailing := flippedTests(previous, current)
+	if diff := cmp.Diff([]string{"TestFixed", "TestNewPassing"}, nowPassing); diff != "" {
+		t.Errorf("flippedTests() now passing mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"TestBroken"}, nowFailing); diff != "" {
+		t.Errorf("flippedTests() now failing mismatch (-want +got):\n%s", diff)
+	}
+}
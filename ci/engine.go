@@ -0,0 +1,21 @@
+package ci
+
+import (
+	"fmt"
+
+	"github.com/quickfeed/quickfeed/internal/env"
+	"go.uber.org/zap"
+)
+
+// NewContainerCI returns a runner using the container engine selected by
+// the QUICKFEED_RUNNER environment variable; either docker (default) or podman.
+func NewContainerCI(logger *zap.SugaredLogger) (*Docker, error) {
+	switch engine := env.RunnerEngine(); engine {
+	case env.DockerEngine:
+		return NewDockerCI(logger)
+	case env.PodmanEngine:
+		return NewPodmanCI(logger, env.PodmanHost())
+	default:
+		return nil, fmt.Errorf("unsupported container engine: %s", engine)
+	}
+}
@@ -0,0 +1,88 @@
+package ci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/quickfeed/quickfeed/internal/rand"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"go.uber.org/zap"
+)
+
+// rerunFailedTests reruns the tests up to the assignment's number of test retries while some
+// expected tests fail. Failed tests that pass when rerun obtain the score of the rerun and are
+// marked as flaky. The tests are rerun in the directories of the first run, and the names of the
+// failed tests are passed to the run script in the QUICKFEED_FAILED_TESTS environment variable,
+// such that the script may rerun only those tests.
+func (r *RunData) rerunFailedTests(ctx context.Context, logger *zap.SugaredLogger, runner Runner, dstDir, reportsDir string, results *score.Results) {
+	retries := int(r.Assignment.GetTestRetries())
+	failed := failedTests(results)
+	for attempt := 1; attempt <= retries && len(failed) > 0; attempt++ {
+		logger.Debugf("Rerunning %d failed tests for %s (attempt %d of %d)", len(failed), r, attempt, retries)
+		secret := rand.String()
+		job, err := r.parseTestRunnerScript(secret, dstDir, reportsDir)
+		if err != nil {
+			logger.Errorf("Failed to parse run script to rerun tests for %s: %v", r, err)
+			return
+		}
+		job.Env = append(job.Env, failedTestsEnvName+"="+strings.Join(failed, ","))
+		start := time.Now()
+		out, err := runner.Run(ctx, job)
+		if err != nil && out == "" {
+			logger.Errorf("Failed to rerun tests for %s: %v", r, err)
+			return
+		}
+		rerun, err := score.ExtractResults(out, secret, time.Since(start), r.Assignment.ZeroScoreTests())
+		if err != nil {
+			logger.Errorf("Failed to extract (some) results of rerun for %s: %v", r, err)
+		}
+		if job.ResultsFile != "" {
+			if err := applyResultsFile(job, rerun); err != nil {
+				logger.Errorf("Failed to read results file of rerun for %s: %v", r, err)
+			}
+		}
+		passed := recordRerun(results, rerun)
+		results.BuildInfo.ExecTime += rerun.GetBuildInfo().GetExecTime()
+		results.BuildInfo.BuildLog += fmt.Sprintf("\nRerun %d: %d of %d failed tests passed", attempt, passed, len(failed))
+		failed = failedTests(results)
+	}
+}
+
+// failedTests returns the names of the expected tests that did not obtain their max score,
+// including the expected tests that did not report a score.
+func failedTests(results *score.Results) []string {
+	var failed []string
+	for _, sc := range results.Scores {
+		if sc.GetStatus() != score.Score_UNEXPECTED && !testPassed(sc) {
+			failed = append(failed, sc.GetTestName())
+		}
+	}
+	return failed
+}
+
+// recordRerun replaces the scores of the failed tests that passed in the rerun with the scores
+// of the rerun, marking them as flaky, and returns the number of tests that passed.
+func recordRerun(results, rerun *score.Results) int {
+	rerunScores := make(map[string]*score.Score)
+	for _, sc := range rerun.Scores {
+		if sc.GetStatus() == score.Score_REPORTED {
+			rerunScores[sc.GetTestName()] = sc
+		}
+	}
+	passed := 0
+	for i, sc := range results.Scores {
+		if sc.GetStatus() == score.Score_UNEXPECTED || testPassed(sc) {
+			continue
+		}
+		rerunScore, ok := rerunScores[sc.GetTestName()]
+		if !ok || !testPassed(rerunScore) {
+			continue
+		}
+		rerunScore.Flaky = true
+		results.Scores[i] = rerunScore
+		passed++
+	}
+	return passed
+}
@@ -0,0 +1,52 @@
+package ci
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/kit/score"
+)
+
+func TestRecordRerun(t *testing.T) {
+	results := &score.Results{
+		Scores: []*score.Score{
+			{TestName: "TestPassing", Score: 10, MaxScore: 10, Weight: 1},
+			{TestName: "TestFlaky", Score: 0, MaxScore: 10, Weight: 1},
+			{TestName: "TestFailing", Score: 5, MaxScore: 10, Weight: 1},
+			{TestName: "TestMissing", Score: 0, MaxScore: 10, Weight: 1, Status: score.Score_MISSING},
+			{TestName: "TestUnexpected", Score: 0, MaxScore: 10, Weight: 1, Status: score.Score_UNEXPECTED},
+		},
+	}
+	if diff := cmp.Diff([]string{"TestFlaky", "TestFailing", "TestMissing"}, failedTests(results)); diff != "" {
+		t.Errorf("failedTests() mismatch (-want +got):\n%s", diff)
+	}
+
+	rerun := &score.Results{
+		Scores: []*score.Score{
+			{TestName: "TestPassing", Score: 0, MaxScore: 10, Weight: 1},
+			{TestName: "TestFlaky", Score: 10, MaxScore: 10, Weight: 1},
+			{TestName: "TestFailing", Score: 6, MaxScore: 10, Weight: 1},
+			{TestName: "TestMissing", Score: 10, MaxScore: 10, Weight: 1},
+			{TestName: "TestUnexpected", Score: 10, MaxScore: 10, Weight: 1, Status: score.Score_UNEXPECTED},
+		},
+	}
+	if passed := recordRerun(results, rerun); passed != 2 {
+		t.Errorf("recordRerun() = %d, want 2", passed)
+	}
+	var flaky []string
+	for _, sc := range results.Scores {
+		if sc.GetFlaky() {
+			flaky = append(flaky, sc.GetTestName())
+		}
+	}
+	if diff := cmp.Diff([]string{"TestFlaky", "TestMissing"}, flaky); diff != "" {
+		t.Errorf("recordRerun() flaky tests mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"TestFailing"}, failedTests(results)); diff != "" {
+		t.Errorf("failedTests() after rerun mismatch (-want +got):\n%s", diff)
+	}
+	// a passing test keeps its score, even if it fails when rerun
+	if results.Scores[0].GetScore() != 10 {
+		t.Errorf("recordRerun() TestPassing score = %d, want 10", results.Scores[0].GetScore())
+	}
+}
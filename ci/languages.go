@@ -1,5 +1,20 @@
 package ci
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/quickfeed/quickfeed/qf"
+)
+
 // Language constants for supported languages.
 // These are used in run scripts via the #language/ directive.
 const (
@@ -15,36 +30,157 @@ const (
 	NuGetCache        = "/quickfeed-nuget-cache"
 )
 
-// languageConfig defines language-specific cache mounts and environment variables.
-type languageConfig struct {
-	// cacheDirs maps container target paths to host cache directory resolver functions.
-	cacheDirs map[string]func() (string, error)
-	// envVars lists additional environment variables to set in the container.
-	envVars []string
+// LanguagesFile is the name of the file in the root of a course's tests repository
+// that declares the course's language profiles, in addition to the built-in profiles.
+const LanguagesFile = "languages.json"
+
+// courseCacheDir is the host directory, relative to the home directory, below which
+// the cache directories of course-defined language profiles are created.
+const courseCacheDir = "quickfeed-course-cache"
+
+// languageProfile defines a language's default image, cache mounts and environment variables.
+type languageProfile struct {
+	// Image is the image used for run scripts without an #image/ directive.
+	Image string `json:"image"`
+	// CacheDirs lists the container paths of the cache directories, which are
+	// bind mounted from host directories that persist across test runs.
+	CacheDirs []string `json:"cacheDirs"`
+	// Env lists additional environment variables to set in the container.
+	Env []string `json:"env"`
+	// hostDir is the host directory, relative to the home directory, below which
+	// the cache directories are created; the cache directories of built-in
+	// profiles are created directly in the home directory.
+	hostDir string
 }
 
-// languages maps language identifiers to their cache configurations.
-// To add support for a new language, add an entry here with the relevant
-// cache directories and environment variables.
-var languages = map[string]languageConfig{
+// languages maps language identifiers to the built-in language profiles.
+// Courses may add or replace language profiles in their tests repository's languages.json file.
+var languages = map[string]languageProfile{
 	languageGo: {
-		cacheDirs: map[string]func() (string, error){
-			GoModCache:        moduleCachePath,
-			GoCache:           goCachePath,
-			GolangciLintCache: golangciLintCachePath,
-		},
-		envVars: []string{
+		CacheDirs: []string{GoModCache, GoCache, GolangciLintCache},
+		Env: []string{
 			"GOMODCACHE=" + GoModCache,
 			"GOCACHE=" + GoCache,
 			"GOLANGCI_LINT_CACHE=" + GolangciLintCache,
 		},
 	},
 	languageDotNet: {
-		cacheDirs: map[string]func() (string, error){
-			NuGetCache: nugetCachePath,
-		},
-		envVars: []string{
+		CacheDirs: []string{NuGetCache},
+		Env: []string{
 			"NUGET_PACKAGES=" + NuGetCache,
 		},
 	},
 }
+
+var (
+	languageNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9+._-]*$`)
+	unsafeCharsRegexp  = regexp.MustCompile(`[^a-z0-9._-]`)
+	envNameRegexp      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// reservedEnvVars are set by QuickFeed and cannot be replaced by language profiles.
+	reservedEnvVars = []string{"HOME", "TESTS", "ASSIGNMENTS", "SUBMITTED", "CURRENT", secretEnvName, solutionEnvName, failedTestsEnvName}
+)
+
+// cacheDirs returns the profile's cache directories, mapping container paths to host
+// directories relative to the home directory.
+func (p languageProfile) cacheDirs() map[string]string {
+	if len(p.CacheDirs) == 0 {
+		return nil
+	}
+	dirs := make(map[string]string, len(p.CacheDirs))
+	for _, target := range p.CacheDirs {
+		dirs[target] = path.Join(p.hostDir, cacheName(target))
+	}
+	return dirs
+}
+
+// cacheName returns the host directory name for the given container cache path,
+// e.g., /root/.cache/pip -> root_.cache_pip.
+func cacheName(target string) string {
+	return strings.ReplaceAll(strings.TrimPrefix(target, "/"), "/", "_")
+}
+
+// ValidateLanguages returns an error if the content of a languages.json file is invalid.
+func ValidateLanguages(content []byte) error {
+	_, err := parseLanguages(content)
+	return err
+}
+
+// parseLanguages returns the language profiles declared in the content of a languages.json file.
+// The file holds a JSON object mapping language identifiers to profiles, e.g.:
+//
+//	{"python": {"image": "python:3.12", "cacheDirs": ["/root/.cache/pip"], "env": ["PIP_CACHE_DIR=/root/.cache/pip"]}}
+func parseLanguages(content []byte) (map[string]languageProfile, error) {
+	var profiles map[string]languageProfile
+	if err := json.Unmarshal(content, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %q: %w", LanguagesFile, err)
+	}
+	var errs []error
+	for name, profile := range profiles {
+		if err := profile.validate(name); err != nil {
+			errs = append(errs, err)
+		}
+		profile.Image = strings.ToLower(strings.TrimSpace(profile.Image))
+		profiles[name] = profile
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid %q: %w", LanguagesFile, err)
+	}
+	return profiles, nil
+}
+
+// validate returns an error if the profile for the given language is invalid.
+func (p languageProfile) validate(name string) error {
+	if !languageNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid language name %q: must be lowercase letters, digits, or +._-", name)
+	}
+	seen := make(map[string]bool)
+	for _, target := range p.CacheDirs {
+		switch {
+		case !path.IsAbs(target) || path.Clean(target) != target || target == "/":
+			return fmt.Errorf("language %s: cache directory %q must be a clean absolute path", name, target)
+		case target == QuickFeedPath || strings.HasPrefix(target, QuickFeedPath+"/"):
+			return fmt.Errorf("language %s: cache directory %q must not be in %s", name, target, QuickFeedPath)
+		case seen[cacheName(target)]:
+			return fmt.Errorf("language %s: duplicate cache directory %q", name, target)
+		}
+		seen[cacheName(target)] = true
+	}
+	for _, kv := range p.Env {
+		envName, _, found := strings.Cut(kv, "=")
+		if !found || !envNameRegexp.MatchString(envName) {
+			return fmt.Errorf("language %s: invalid environment variable %q; must be NAME=value", name, kv)
+		}
+		if slices.Contains(reservedEnvVars, envName) {
+			return fmt.Errorf("language %s: environment variable %s is reserved", name, envName)
+		}
+	}
+	return nil
+}
+
+// loadLanguages returns the built-in language profiles merged with the course's language profiles,
+// if the given tests directory has a languages.json file. Course profiles replace built-in
+// profiles with the same name, and their cache directories are specific to the course.
+func loadLanguages(course *qf.Course, testsDir string) (map[string]languageProfile, error) {
+	content, err := os.ReadFile(filepath.Join(testsDir, LanguagesFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return languages, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := parseLanguages(content)
+	if err != nil {
+		return nil, err
+	}
+	merged := maps.Clone(languages)
+	for name, profile := range profiles {
+		profile.hostDir = path.Join(courseCacheDir, cacheDirName(course.GetCode()), name)
+		merged[name] = profile
+	}
+	return merged, nil
+}
+
+// cacheDirName returns the given course code as a directory name.
+func cacheDirName(courseCode string) string {
+	return unsafeCharsRegexp.ReplaceAllString(strings.ToLower(courseCode), "_")
+}
@@ -0,0 +1,104 @@
+package ci
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+func TestParseLanguages(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{name: "Valid", content: `{"python": {"image": "Python:3.12", "cacheDirs": ["/root/.cache/pip"], "env": ["PIP_CACHE_DIR=/root/.cache/pip"]}}`},
+		{name: "ImageOnly", content: `{"java": {"image": "eclipse-temurin:21"}}`},
+		{name: "InvalidJSON", content: `{"python": `, wantErr: true},
+		{name: "InvalidName", content: `{"Python": {}}`, wantErr: true},
+		{name: "RelativeCacheDir", content: `{"python": {"cacheDirs": ["root/.cache"]}}`, wantErr: true},
+		{name: "RootCacheDir", content: `{"python": {"cacheDirs": ["/"]}}`, wantErr: true},
+		{name: "UncleanCacheDir", content: `{"python": {"cacheDirs": ["/root/../etc"]}}`, wantErr: true},
+		{name: "QuickFeedCacheDir", content: `{"python": {"cacheDirs": ["/quickfeed/cache"]}}`, wantErr: true},
+		{name: "DuplicateCacheDir", content: `{"python": {"cacheDirs": ["/a/b", "/a_b"]}}`, wantErr: true},
+		{name: "InvalidEnv", content: `{"python": {"env": ["PIP_CACHE_DIR"]}}`, wantErr: true},
+		{name: "ReservedEnv", content: `{"python": {"env": ["HOME=/tmp"]}}`, wantErr: true},
+		{name: "SecretEnv", content: `{"python": {"env": ["` + secretEnvName + `=x"]}}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateLanguages([]byte(tt.content)); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLanguages() error = %v, wantErr %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadLanguages(t *testing.T) {
+	t.Setenv("QUICKFEED_REPOSITORY_PATH", t.TempDir())
+	course := &qf.Course{Code: "DAT 320", ScmOrganizationName: "dat320-2025"}
+	testsDir := filepath.Join(course.CloneDir(), qf.TestsRepo)
+
+	// without a languages.json file, only the built-in profiles are available
+	got, err := loadLanguages(course, testsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(languages, got, cmp.AllowUnexported(languageProfile{})); diff != "" {
+		t.Errorf("loadLanguages() mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := os.MkdirAll(testsDir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	const content = `{
+  "python": {"image": "python:3.12", "cacheDirs": ["/root/.cache/pip"], "env": ["PIP_CACHE_DIR=/root/.cache/pip"]},
+  "dotnet": {"image": "mcr.microsoft.com/dotnet/sdk:9.0"}
+}`
+	if err := os.WriteFile(filepath.Join(testsDir, LanguagesFile), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	got, err = loadLanguages(course, testsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]languageProfile{
+		languageGo: languages[languageGo],
+		languageDotNet: {
+			Image:   "mcr.microsoft.com/dotnet/sdk:9.0",
+			hostDir: "quickfeed-course-cache/dat_320/dotnet",
+		},
+		"python": {
+			Image:     "python:3.12",
+			CacheDirs: []string{"/root/.cache/pip"},
+			Env:       []string{"PIP_CACHE_DIR=/root/.cache/pip"},
+			hostDir:   "quickfeed-course-cache/dat_320/python",
+		},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(languageProfile{})); diff != "" {
+		t.Errorf("loadLanguages() mismatch (-want +got):\n%s", diff)
+	}
+	if len(languages[languageDotNet].CacheDirs) == 0 {
+		t.Error("loadLanguages() modified the built-in profiles")
+	}
+
+	wantDirs := map[string]string{"/root/.cache/pip": "quickfeed-course-cache/dat_320/python/root_.cache_pip"}
+	if diff := cmp.Diff(wantDirs, got["python"].cacheDirs()); diff != "" {
+		t.Errorf("cacheDirs() mismatch (-want +got):\n%s", diff)
+	}
+	wantDirs = map[string]string{GoModCache: "quickfeed-go-mod-cache", GoCache: "quickfeed-go-cache", GolangciLintCache: "quickfeed-golangci-lint-cache"}
+	if diff := cmp.Diff(wantDirs, got[languageGo].cacheDirs()); diff != "" {
+		t.Errorf("cacheDirs() mismatch (-want +got):\n%s", diff)
+	}
+
+	// an invalid languages.json file is an error
+	if err := os.WriteFile(filepath.Join(testsDir, LanguagesFile), []byte(`{"python": {"env": ["HOME=/"]}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadLanguages(course, testsDir); err == nil {
+		t.Error("loadLanguages() succeeded for invalid languages.json")
+	}
+}
@@ -0,0 +1,98 @@
+package ci
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+const (
+	megabyte = 1 << 20 // bytes
+	// sigxfszExitCode is the exit code of a process killed by SIGXFSZ for exceeding the file size limit.
+	sigxfszExitCode = 128 + 25
+)
+
+// Resource limits reported by limitExceededCounter.
+const (
+	memoryLimit   = "memory"
+	pidsLimit     = "pids"
+	fileSizeLimit = "filesize"
+)
+
+// Limits specifies the resource limits for a job. Zero values mean no limit.
+type Limits struct {
+	// Memory is the maximum memory usage in bytes.
+	Memory int64
+	// NanoCPUs is the CPU quota in units of 1e-9 CPUs.
+	NanoCPUs int64
+	// Pids is the maximum number of processes.
+	Pids int64
+	// FileSize is the maximum size of each file written in bytes; it does not limit the total bytes written.
+	FileSize int64
+}
+
+// assignmentLimits returns the resource limits specified for the assignment.
+func assignmentLimits(assignment *qf.Assignment) Limits {
+	return Limits{
+		Memory:   int64(assignment.GetMemoryLimit()) * megabyte,
+		NanoCPUs: int64(assignment.GetCpuLimit()) * 1_000_000,
+		Pids:     int64(assignment.GetPidsLimit()),
+		FileSize: int64(assignment.GetFileSizeLimit()) * megabyte,
+	}
+}
+
+// resources returns the container resources enforcing the limits.
+func (l Limits) resources() container.Resources {
+	var resources container.Resources
+	if l.Memory > 0 {
+		resources.Memory = l.Memory
+		// prevent the container from using swap beyond the memory limit
+		resources.MemorySwap = l.Memory
+	}
+	resources.NanoCPUs = l.NanoCPUs
+	if l.Pids > 0 {
+		resources.PidsLimit = &l.Pids
+	}
+	if l.FileSize > 0 {
+		resources.Ulimits = []*container.Ulimit{{Name: "fsize", Soft: l.FileSize, Hard: l.FileSize}}
+	}
+	return resources
+}
+
+// exceeded returns the limits exceeded by the container with the given final state and peak number of processes.
+// The CPU quota is not reported, since it throttles the container rather than stopping it.
+func (l Limits) exceeded(state *container.State, peakPids uint64) []string {
+	if state == nil {
+		state = &container.State{}
+	}
+	var exceeded []string
+	if l.Memory > 0 && state.OOMKilled {
+		exceeded = append(exceeded, memoryLimit)
+	}
+	if l.Pids > 0 && peakPids >= uint64(l.Pids) {
+		exceeded = append(exceeded, pidsLimit)
+	}
+	if l.FileSize > 0 && state.ExitCode == sigxfszExitCode {
+		exceeded = append(exceeded, fileSizeLimit)
+	}
+	return exceeded
+}
+
+// report records the exceeded limits in the metrics and returns a message explaining them to the user.
+func (l Limits) report(exceeded []string) string {
+	var msg strings.Builder
+	for _, limit := range exceeded {
+		limitExceededCounter.WithLabelValues(limit).Inc()
+		switch limit {
+		case memoryLimit:
+			fmt.Fprintf(&msg, "\nContainer exceeded the memory limit of %d MB.", l.Memory/megabyte)
+		case pidsLimit:
+			fmt.Fprintf(&msg, "\nContainer reached the limit of %d processes.", l.Pids)
+		case fileSizeLimit:
+			fmt.Fprintf(&msg, "\nContainer exceeded the file size limit of %d MB.", l.FileSize/megabyte)
+		}
+	}
+	return msg.String()
+}
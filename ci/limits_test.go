@@ -0,0 +1,58 @@
+package ci
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/moby/moby/api/types/container"
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+func TestAssignmentLimits(t *testing.T) {
+	limits := assignmentLimits(&qf.Assignment{
+		MemoryLimit:   512,
+		CpuLimit:      1500,
+		PidsLimit:     100,
+		FileSizeLimit: 64,
+	})
+	pids := int64(100)
+	want := container.Resources{
+		Memory:     512 * megabyte,
+		MemorySwap: 512 * megabyte,
+		NanoCPUs:   1_500_000_000,
+		PidsLimit:  &pids,
+		Ulimits:    []*container.Ulimit{{Name: "fsize", Soft: 64 * megabyte, Hard: 64 * megabyte}},
+	}
+	if diff := cmp.Diff(want, limits.resources()); diff != "" {
+		t.Errorf("resources() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(container.Resources{}, assignmentLimits(&qf.Assignment{}).resources()); diff != "" {
+		t.Errorf("resources() without limits mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestLimitsExceeded(t *testing.T) {
+	limits := Limits{Memory: 512 * megabyte, Pids: 100, FileSize: 64 * megabyte}
+	tests := []struct {
+		name   string
+		limits Limits
+		state  *container.State
+		pids   uint64
+		want   []string
+	}{
+		{name: "NoLimitExceeded", limits: limits, state: &container.State{ExitCode: 1}, pids: 99},
+		{name: "NoState", limits: limits},
+		{name: "Memory", limits: limits, state: &container.State{OOMKilled: true, ExitCode: 137}, want: []string{memoryLimit}},
+		{name: "Pids", limits: limits, state: &container.State{ExitCode: 1}, pids: 100, want: []string{pidsLimit}},
+		{name: "FileSize", limits: limits, state: &container.State{ExitCode: sigxfszExitCode}, want: []string{fileSizeLimit}},
+		{name: "NoLimits", state: &container.State{OOMKilled: true, ExitCode: sigxfszExitCode}, pids: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.limits.exceeded(tt.state, tt.pids)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("exceeded() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
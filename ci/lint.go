@@ -0,0 +1,169 @@
+package ci
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+)
+
+// Supported lint file formats for the #lint/ directive.
+const (
+	formatGolangciLint = "golangci-lint"
+	formatSARIF        = "sarif"
+)
+
+// maxLintFindings is the maximum number of lint findings recorded for a submission.
+const maxLintFindings = 500
+
+// parseLintFile returns the lint file given by the #lint/ directive,
+// e.g., #lint/golangci-lint lint/report.json.
+func parseLintFile(directive string) (*jobFile, error) {
+	return parseJobFile("lint", directive, formatGolangciLint, formatSARIF)
+}
+
+// ReadLintFile returns the content of the lint file written by the job's linters.
+func (j *Job) ReadLintFile() ([]byte, error) {
+	return j.readFile("lint", j.LintFile)
+}
+
+// WriteLintFile writes the given content to the job's lint file below the job's reports directory.
+// Runners that do not run the job on this host use this method to make the lint file available.
+func (j *Job) WriteLintFile(content []byte) error {
+	return j.writeFile("lint", j.LintFile, content)
+}
+
+// applyLintFile records the findings in the job's lint file in the build info of the given results.
+// At most maxLintFindings findings are recorded.
+func applyLintFile(job *Job, results *score.Results) error {
+	content, err := job.ReadLintFile()
+	if err != nil {
+		return err
+	}
+	findings, err := parseLintFindings(job.lintFormat, content)
+	if err != nil {
+		return err
+	}
+	if results.BuildInfo == nil {
+		results.BuildInfo = &score.BuildInfo{}
+	}
+	if len(findings) > maxLintFindings {
+		results.BuildInfo.BuildLog += fmt.Sprintf("\nOnly the first %d of %d lint findings are recorded", maxLintFindings, len(findings))
+		findings = findings[:maxLintFindings]
+	}
+	results.BuildInfo.LintFindings = findings
+	return nil
+}
+
+// parseLintFindings returns the lint findings found in the given lint file content.
+func parseLintFindings(format string, content []byte) ([]*score.LintFinding, error) {
+	switch format {
+	case formatGolangciLint:
+		return parseGolangciLint(content)
+	case formatSARIF:
+		return parseSARIF(content)
+	}
+	return nil, fmt.Errorf("unknown lint format: %q", format)
+}
+
+// lintPath returns the given file path relative to the job's home directory, if possible.
+func lintPath(file string) string {
+	file = strings.TrimPrefix(strings.ReplaceAll(file, `\`, "/"), "file://")
+	return strings.TrimPrefix(file, QuickFeedPath+"/")
+}
+
+// golangciReport holds the issues of a report written by golangci-lint's JSON output format.
+type golangciReport struct {
+	Issues []struct {
+		FromLinter string `json:"FromLinter"`
+		Text       string `json:"Text"`
+		Severity   string `json:"Severity"`
+		Pos        struct {
+			Filename string `json:"Filename"`
+			Line     uint32 `json:"Line"`
+			Column   uint32 `json:"Column"`
+		} `json:"Pos"`
+	} `json:"Issues"`
+}
+
+// parseGolangciLint returns the findings in the given golangci-lint JSON report.
+// The linter that reported an issue is recorded as the finding's tool; golangci-lint does not report rules.
+func parseGolangciLint(content []byte) ([]*score.LintFinding, error) {
+	var report golangciReport
+	if err := json.Unmarshal(content, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse golangci-lint JSON report: %w", err)
+	}
+	findings := make([]*score.LintFinding, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		findings = append(findings, &score.LintFinding{
+			File:     lintPath(issue.Pos.Filename),
+			Line:     issue.Pos.Line,
+			Column:   issue.Pos.Column,
+			Tool:     issue.FromLinter,
+			Severity: issue.Severity,
+			Message:  issue.Text,
+		})
+	}
+	return findings, nil
+}
+
+// sarifLog holds the results of a SARIF log, as written by many static analysis tools.
+type sarifLog struct {
+	Runs []struct {
+		Tool struct {
+			Driver struct {
+				Name string `json:"name"`
+			} `json:"driver"`
+		} `json:"tool"`
+		Results []struct {
+			RuleID  string `json:"ruleId"`
+			Level   string `json:"level"`
+			Message struct {
+				Text string `json:"text"`
+			} `json:"message"`
+			Locations []struct {
+				PhysicalLocation struct {
+					ArtifactLocation struct {
+						URI string `json:"uri"`
+					} `json:"artifactLocation"`
+					Region struct {
+						StartLine   uint32 `json:"startLine"`
+						StartColumn uint32 `json:"startColumn"`
+					} `json:"region"`
+				} `json:"physicalLocation"`
+			} `json:"locations"`
+		} `json:"results"`
+	} `json:"runs"`
+}
+
+// parseSARIF returns the findings in the given SARIF log. A result's first location is used as
+// the finding's location; results without a level have SARIF's default level, warning.
+func parseSARIF(content []byte) ([]*score.LintFinding, error) {
+	var sarif sarifLog
+	if err := json.Unmarshal(content, &sarif); err != nil {
+		return nil, fmt.Errorf("failed to parse SARIF log: %w", err)
+	}
+	var findings []*score.LintFinding
+	for _, run := range sarif.Runs {
+		for _, result := range run.Results {
+			finding := &score.LintFinding{
+				Tool:     run.Tool.Driver.Name,
+				Rule:     result.RuleID,
+				Severity: result.Level,
+				Message:  result.Message.Text,
+			}
+			if finding.Severity == "" {
+				finding.Severity = "warning"
+			}
+			if len(result.Locations) > 0 {
+				location := result.Locations[0].PhysicalLocation
+				finding.File = lintPath(location.ArtifactLocation.URI)
+				finding.Line = location.Region.StartLine
+				finding.Column = location.Region.StartColumn
+			}
+			findings = append(findings, finding)
+		}
+	}
+	return findings, nil
+}
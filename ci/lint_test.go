@@ -0,0 +1,167 @@
+package ci
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseRunScriptLint(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		want    *jobFile
+		wantErr bool
+	}{
+		{name: "NoLint", script: "#image/quickfeed:go\necho hello\necho world"},
+		{
+			name:   "GolangciLint",
+			script: "#image/quickfeed:go\n#lint/golangci-lint lint/report.json\ngolangci-lint run ./...",
+			want:   &jobFile{kind: "lint", format: formatGolangciLint, path: "lint/report.json"},
+		},
+		{
+			name:   "SARIF",
+			script: "#image/quickfeed:python\n#lint/SARIF report.sarif\nruff check",
+			want:   &jobFile{kind: "lint", format: formatSARIF, path: "report.sarif"},
+		},
+		{name: "UnknownFormat", script: "#image/quickfeed:go\n#lint/checkstyle report.xml\ngo vet", wantErr: true},
+		{name: "OutsideHome", script: "#image/quickfeed:go\n#lint/sarif /tmp/report.sarif\ngo vet", wantErr: true},
+		{name: "Duplicate", script: "#image/quickfeed:go\n#lint/sarif a.sarif\n#lint/sarif b.sarif\ngo vet", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script, err := parseRunScript(tt.script)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRunScript() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, script.lint, cmp.AllowUnexported(jobFile{})); diff != "" {
+				t.Errorf("parseRunScript() lint mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseGolangciLint(t *testing.T) {
+	const report = `{
+  "Issues": [
+    {
+      "FromLinter": "errcheck",
+      "Text": "Error return value of ` + "`conn.Close`" + ` is not checked",
+      "Severity": "",
+      "Pos": {"Filename": "meling-labs/lab1/server.go", "Offset": 120, "Line": 14, "Column": 12}
+    },
+    {
+      "FromLinter": "unused",
+      "Text": "func ` + "`helper`" + ` is unused",
+      "Severity": "warning",
+      "Pos": {"Filename": "/quickfeed/meling-labs/lab1/util.go", "Line": 3, "Column": 6}
+    }
+  ],
+  "Report": {}
+}`
+	got, err := parseLintFindings(formatGolangciLint, []byte(report))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*score.LintFinding{
+		{File: "meling-labs/lab1/server.go", Line: 14, Column: 12, Tool: "errcheck", Message: "Error return value of `conn.Close` is not checked"},
+		{File: "meling-labs/lab1/util.go", Line: 3, Column: 6, Tool: "unused", Severity: "warning", Message: "func `helper` is unused"},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("parseLintFindings() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := parseLintFindings(formatGolangciLint, []byte("level=error msg=\"typechecking error\"")); err == nil {
+		t.Error("parseLintFindings() succeeded with invalid report, want error")
+	}
+}
+
+func TestParseSARIF(t *testing.T) {
+	const log = `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "ruff"}},
+      "results": [
+        {
+          "ruleId": "F401",
+          "level": "error",
+          "message": {"text": "os imported but unused"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "file:///quickfeed/meling-labs/lab1/main.py"}, "region": {"startLine": 1, "startColumn": 8}}}]
+        },
+        {
+          "ruleId": "E501",
+          "message": {"text": "Line too long"}
+        }
+      ]
+    }
+  ]
+}`
+	got, err := parseLintFindings(formatSARIF, []byte(log))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*score.LintFinding{
+		{File: "meling-labs/lab1/main.py", Line: 1, Column: 8, Tool: "ruff", Rule: "F401", Severity: "error", Message: "os imported but unused"},
+		{Tool: "ruff", Rule: "E501", Severity: "warning", Message: "Line too long"},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("parseLintFindings() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestApplyLintFile(t *testing.T) {
+	const report = `{"Issues": [{"FromLinter": "unused", "Text": "func helper is unused", "Pos": {"Filename": "lab1/util.go", "Line": 3, "Column": 6}}]}`
+	reportsDir, outsideDir := t.TempDir(), t.TempDir()
+	if err := os.WriteFile(filepath.Join(reportsDir, "lint.json"), []byte(report), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// an empty report planted by the student's code, linked from the reports directory
+	if err := os.WriteFile(filepath.Join(outsideDir, "empty.json"), []byte(`{"Issues": []}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(outsideDir, "empty.json"), filepath.Join(reportsDir, "forged.json")); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		job     *Job
+		want    []*score.LintFinding
+		wantErr bool
+	}{
+		{
+			name: "ReportsDir",
+			job:  &Job{ReportsDir: reportsDir, LintFile: "lint.json", lintFormat: formatGolangciLint},
+			want: []*score.LintFinding{{File: "lab1/util.go", Line: 3, Column: 6, Tool: "unused", Message: "func helper is unused"}},
+		},
+		{
+			name:    "Symlink",
+			job:     &Job{ReportsDir: reportsDir, LintFile: "forged.json", lintFormat: formatGolangciLint},
+			wantErr: true,
+		},
+		{
+			name:    "Escape",
+			job:     &Job{ReportsDir: reportsDir, LintFile: "../" + filepath.Base(outsideDir) + "/empty.json", lintFormat: formatGolangciLint},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := &score.Results{}
+			err := applyLintFile(tt.job, results)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyLintFile() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, results.GetBuildInfo().GetLintFindings(), protocmp.Transform()); diff != "" {
+				t.Errorf("applyLintFile() findings mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
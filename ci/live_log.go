@@ -0,0 +1,67 @@
+package ci
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// liveLog is an io.Writer that forwards complete lines of a job's output to a callback,
+// while the job is running. Lines carrying scores or the session secret are omitted,
+// such that the forwarded output cannot be used to forge test results. Lines mentioning
+// hidden tests are also omitted, since students may follow the output before the deadline.
+type liveLog struct {
+	mu      sync.Mutex
+	secret  string
+	hidden  []string // names of the hidden tests
+	partial string   // incomplete last line of the output written so far
+	send    func(output string)
+}
+
+func newLiveLog(secret string, hidden []string, send func(output string)) *liveLog {
+	return &liveLog{secret: secret, hidden: hidden, send: send}
+}
+
+// Write forwards the complete lines in p, and buffers any incomplete last line until the next write.
+// An incomplete line longer than maxLogSize is forwarded without waiting for the rest of the line.
+func (l *liveLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	out := l.partial + string(p)
+	end := strings.LastIndex(out, "\n") + 1
+	if len(out)-end > maxLogSize {
+		end = len(out)
+	}
+	l.partial = out[end:]
+	l.forward(out[:end])
+	return len(p), nil
+}
+
+// Close forwards the incomplete last line of the output, if any.
+func (l *liveLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.forward(l.partial)
+	l.partial = ""
+	return nil
+}
+
+// forward sends the given lines, without score, secret and hidden test lines.
+// This method must only be called when holding the mutex.
+func (l *liveLog) forward(lines string) {
+	if lines == "" {
+		return
+	}
+	var out strings.Builder
+	for line := range strings.SplitAfterSeq(lines, "\n") {
+		if score.HasPrefix(line) || (l.secret != "" && strings.Contains(line, l.secret)) || qf.MentionsTest(line, l.hidden) {
+			continue
+		}
+		out.WriteString(line)
+	}
+	if out.Len() > 0 {
+		l.send(out.String())
+	}
+}
@@ -0,0 +1,51 @@
+package ci
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLiveLog(t *testing.T) {
+	const secret = "For Your Eyes Only"
+	var got []string
+	log := newLiveLog(secret, []string{"TestHidden"}, func(output string) { got = append(got, output) })
+	for _, write := range []string{
+		"=== RUN   TestA\n--- PA",
+		"SS: TestA\n",
+		"=== RUN   TestHidden\n--- FAIL: TestHid",
+		"den (0.00s)\n",
+		`{"Secret":"` + secret + `","TestName":"TestA","Score":1,"MaxScore":1,"Weight":1}` + "\n",
+		"QUICKFEED_SESSION_SECRET=" + secret + "\nok\n",
+		"no newline",
+	} {
+		if _, err := log.Write([]byte(write)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := log.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"=== RUN   TestA\n",
+		"--- PASS: TestA\n",
+		"ok\n",
+		"no newline",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("liveLog output mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestLiveLogLongLine(t *testing.T) {
+	var got []string
+	log := newLiveLog("secret", nil, func(output string) { got = append(got, output) })
+	line := strings.Repeat("x", maxLogSize+1)
+	if _, err := log.Write([]byte(line)); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{line}, got); diff != "" {
+		t.Errorf("liveLog output mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,140 @@
+package ci
+
+import (
+	"cmp"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultLocalMemory is the default memory limit for each process run by the Local runner.
+	defaultLocalMemory = 4 << 30 // bytes
+	// defaultLocalProcesses is the default process limit for the user running the Local runner's jobs.
+	defaultLocalProcesses = 4096
+	localTimeoutMsg       = "Test timeout. Please check for infinite loops or other slowness."
+)
+
+// Local is an implementation of the CI interface executing code locally, for hosts without Docker.
+// Each job runs in a temporary working directory mirroring the /quickfeed layout of the containers.
+// The job's environment variables referring to container paths are rewritten to the corresponding
+// paths in the working directory, or to the job's reports directory. Resource limits are applied on Linux and macOS only.
+type Local struct {
+	// CPUTime limits the CPU time of each process; defaults to DefaultContainerTimeout.
+	CPUTime time.Duration
+	// Memory limits the data segment size (in bytes) of each process; defaults to 4 GiB.
+	// The job's memory limit takes precedence, if specified.
+	Memory uint64
+	// Processes limits the number of processes (and threads) for the user running the job; defaults to 4096.
+	// The job's process limit takes precedence, if specified. The limit applies to all the user's processes,
+	// not only the job's; hence, it must leave room for the processes the user is already running.
+	Processes uint64
+}
+
+// mirror copies the job's bind directory and read-only mounts into the working directory.
+// It returns a map from container paths (and the job's bind directory) to host paths;
+// the job's reports directory is used as is.
+// Jobs with services are rejected, since the Local runner cannot run service containers.
+func mirror(job *Job, workDir string) (map[string]string, error) {
+	if len(job.Services) > 0 {
+		return nil, fmt.Errorf("cannot run job %s: services are not supported by the local runner", job.Name)
+	}
+	paths := map[string]string{QuickFeedPath: workDir}
+	if job.ReportsDir != "" {
+		paths[ReportsPath] = job.ReportsDir
+	}
+	if job.BindDir != "" {
+		if err := os.CopyFS(workDir, os.DirFS(job.BindDir)); err != nil {
+			return nil, fmt.Errorf("failed to copy %s: %w", job.BindDir, err)
+		}
+		paths[job.BindDir] = workDir
+	}
+	for target, name := range job.CacheDirs {
+		src, err := hostCacheDir(name)
+		if err != nil {
+			return nil, err
+		}
+		paths[target] = src
+	}
+	for _, src := range slices.Sorted(maps.Keys(job.ReadOnlyMounts)) {
+		target := job.ReadOnlyMounts[src]
+		dst := hostPath(paths, target)
+		if dst == target {
+			// target outside /quickfeed; mirror it below the working directory
+			dst = filepath.Join(workDir, ".mounts", target)
+		}
+		// the mount hides any content at the target, as with bind mounts
+		if err := os.RemoveAll(dst); err != nil {
+			return nil, err
+		}
+		if err := os.CopyFS(dst, os.DirFS(src)); err != nil {
+			return nil, fmt.Errorf("failed to copy %s: %w", src, err)
+		}
+		if err := chmodAll(dst, func(mode fs.FileMode) fs.FileMode { return mode &^ 0o222 }); err != nil {
+			return nil, err
+		}
+		paths[target] = dst
+	}
+	return paths, nil
+}
+
+// hostPath returns the host path for the given path, using the longest matching
+// prefix in paths. The path is returned unchanged if no prefix matches.
+func hostPath(paths map[string]string, path string) string {
+	prefixes := slices.SortedFunc(maps.Keys(paths), func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+	for _, prefix := range prefixes {
+		if path == prefix {
+			return paths[prefix]
+		}
+		if rest, ok := strings.CutPrefix(path, prefix+"/"); ok {
+			return filepath.Join(paths[prefix], rest)
+		}
+	}
+	return path
+}
+
+// localEnv returns the job's environment variables with container paths replaced by host paths.
+// The host's PATH is included unless specified by the job, since the job uses the host's tools.
+func localEnv(env []string, paths map[string]string) []string {
+	localEnv := make([]string, 0, len(env)+1)
+	hasPath := false
+	for _, kv := range env {
+		name, value, _ := strings.Cut(kv, "=")
+		hasPath = hasPath || name == "PATH"
+		localEnv = append(localEnv, name+"="+hostPath(paths, value))
+	}
+	if !hasPath {
+		localEnv = append(localEnv, "PATH="+os.Getenv("PATH"))
+	}
+	return localEnv
+}
+
+// removeWorkDir removes the working directory, including its read-only content.
+func removeWorkDir(workDir string) error {
+	if err := chmodAll(workDir, func(mode fs.FileMode) fs.FileMode { return mode | 0o700 }); err != nil {
+		return err
+	}
+	return os.RemoveAll(workDir)
+}
+
+// chmodAll changes the mode of all files and directories below root, except symbolic links.
+func chmodAll(root string, modeFn func(fs.FileMode) fs.FileMode) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return os.Chmod(path, modeFn(info.Mode().Perm()))
+	})
+}
@@ -1,6 +1,7 @@
 package ci_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
@@ -25,3 +26,37 @@ func TestLocal(t *testing.T) {
 		t.Errorf("have %#v want %#v", out, wantOut)
 	}
 }
+
+func TestLocalServicesUnsupported(t *testing.T) {
+	local := ci.Local{}
+	_, err := local.Run(context.Background(), &ci.Job{
+		Name:     "services",
+		Commands: []string{`printf "hello world"`},
+		Services: []ci.Service{{Name: "postgres", Image: "postgres:16"}},
+	})
+	if err == nil {
+		t.Error("Run() with services succeeded, want error")
+	}
+}
+
+func TestLocalOutput(t *testing.T) {
+	const (
+		script  = `printf "hello\n"; printf "world\n" >&2`
+		wantOut = "hello\nworld\n"
+	)
+	var live bytes.Buffer
+	local := ci.Local{}
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{script},
+		Output:   &live,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != wantOut {
+		t.Errorf("have %#v want %#v", out, wantOut)
+	}
+	if live.String() != wantOut {
+		t.Errorf("live output: have %#v want %#v", live.String(), wantOut)
+	}
+}
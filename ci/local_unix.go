@@ -3,23 +3,83 @@
 package ci
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
 	"os/exec"
 	"strings"
+	"syscall"
+	"time"
 )
 
-// Local is an implementation of the CI interface executing code locally.
-type Local struct{}
-
 // Run implements the CI interface. This method blocks until the job has been
 // completed or an error occurs, e.g., the context times out.
-func (*Local) Run(_ context.Context, job *Job) (string, error) {
-	// TODO: Execute tests in something like: os.CreateTemp(os.TempDir(), "local-ci")
-	cmd := exec.Command("/bin/bash", "-c", strings.Join(job.Commands, "\n"))
-	cmd.Env = job.Env
-	b, err := cmd.Output()
+func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
+	workDir, err := os.MkdirTemp("", "quickfeed-local")
+	if err != nil {
+		return "", err
+	}
+	defer removeWorkDir(workDir)
+
+	paths, err := mirror(job, workDir)
 	if err != nil {
 		return "", err
 	}
-	return string(b), nil
+	script := l.limits(job.Limits) + "\n" + strings.Join(job.Commands, "\n")
+	cmd := exec.CommandContext(ctx, "/bin/bash", "-c", script)
+	cmd.Dir = workDir
+	cmd.Env = localEnv(job.Env, paths)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if job.Output != nil {
+		cmd.Stdout = io.MultiWriter(&out, job.Output)
+	}
+	// the same writer for both streams ensures that only one goroutine writes to it
+	cmd.Stderr = cmd.Stdout
+	// run the job in its own process group, such that all its processes are killed on cancellation
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = time.Second
+
+	err = cmd.Run()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		out.WriteString("\n" + localTimeoutMsg)
+		err = errors.Join(ctxErr, err)
+	}
+	return truncatedLog(&out), err
+}
+
+// limits returns the shell command that applies the resource limits for the job.
+// The job's CPU quota is not applied, since it cannot be enforced for the job's processes only.
+func (l *Local) limits(jobLimits Limits) string {
+	cpuTime := l.CPUTime
+	if cpuTime == 0 {
+		cpuTime = DefaultContainerTimeout
+	}
+	memory := l.Memory
+	if jobLimits.Memory > 0 {
+		memory = uint64(jobLimits.Memory)
+	}
+	if memory == 0 {
+		memory = defaultLocalMemory
+	}
+	// ulimit uses 1024-byte blocks for the memory and file size limits
+	limits := fmt.Sprintf("ulimit -t %d -d %d", int(cpuTime.Seconds()), memory/1024)
+	if jobLimits.FileSize > 0 {
+		limits += fmt.Sprintf(" -f %d", jobLimits.FileSize/1024)
+	}
+	processes := l.Processes
+	if jobLimits.Pids > 0 {
+		processes = uint64(jobLimits.Pids)
+	}
+	if processes == 0 {
+		processes = defaultLocalProcesses
+	}
+	limits += fmt.Sprintf(" -u %d", processes)
+	return limits + " || exit 1"
 }
@@ -0,0 +1,174 @@
+//go:build linux || darwin
+
+package ci_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quickfeed/quickfeed/ci"
+)
+
+func TestLocalBindDir(t *testing.T) {
+	bindDir, testsDir := t.TempDir(), t.TempDir()
+	if err := os.WriteFile(filepath.Join(bindDir, "main.go"), []byte("package main\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(testsDir, "x_test.go"), []byte("package tests\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	local := ci.Local{}
+	out, err := local.Run(context.Background(), &ci.Job{
+		BindDir:        bindDir,
+		ReadOnlyMounts: map[string]string{testsDir: "/quickfeed/tests"},
+		Env:            []string{"HOME=/quickfeed", "TESTS=/quickfeed/tests"},
+		Commands: []string{
+			`cat main.go $TESTS/x_test.go`,
+			`[ "$HOME" = "$(pwd)" ] && echo "home is working directory"`,
+			`echo "created" > created.txt`,
+			`echo "to stderr" >&2`,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const wantOut = "package main\npackage tests\nhome is working directory\nto stderr\n"
+	if out != wantOut {
+		t.Errorf("local.Run() = %q, want %q", out, wantOut)
+	}
+	// the job runs in a copy of the bind directory
+	if _, err := os.Stat(filepath.Join(bindDir, "created.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("local.Run() modified bind directory: %v", err)
+	}
+}
+
+func TestLocalReadOnlyMount(t *testing.T) {
+	if os.Getuid() == 0 {
+		t.Skip("Read-only mounts are writable by root")
+	}
+	testsDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(testsDir, "x_test.go"), []byte("package tests\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	local := ci.Local{}
+	out, err := local.Run(context.Background(), &ci.Job{
+		ReadOnlyMounts: map[string]string{testsDir: "/quickfeed/tests"},
+		Commands:       []string{`echo "cheat" > tests/x_test.go || echo -n "read-only"`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(out, "read-only") {
+		t.Errorf("local.Run() = %q, want suffix %q", out, "read-only")
+	}
+}
+
+func TestLocalFailureOutput(t *testing.T) {
+	local := ci.Local{}
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{`echo "partial output"`, `exit 3`},
+	})
+	var exitErr interface{ ExitCode() int }
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 3 {
+		t.Errorf("local.Run() error = %v, want exit status 3", err)
+	}
+	if out != "partial output\n" {
+		t.Errorf("local.Run() = %q, want %q", out, "partial output\n")
+	}
+}
+
+func TestLocalTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	local := ci.Local{}
+	start := time.Now()
+	// the background sleep would keep the output open if only bash was killed
+	out, err := local.Run(ctx, &ci.Job{
+		Commands: []string{`echo "hello,"`, `sleep 10 & sleep 10`},
+	})
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("local.Run() returned after %v, want cancellation after timeout", elapsed)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("local.Run() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	const wantOut = "hello,\n\nTest timeout. Please check for infinite loops or other slowness."
+	if out != wantOut {
+		t.Errorf("local.Run() = %q, want %q", out, wantOut)
+	}
+}
+
+func TestLocalCPUTimeLimit(t *testing.T) {
+	local := ci.Local{CPUTime: time.Second}
+	start := time.Now()
+	out, err := local.Run(context.Background(), &ci.Job{
+		Commands: []string{`ulimit -t`, `while true; do :; done`},
+	})
+	if err == nil {
+		t.Error("local.Run() unexpectedly succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("local.Run() returned after %v, want CPU time limit of 1s", elapsed)
+	}
+	if !strings.HasPrefix(out, "1\n") {
+		t.Errorf("local.Run() = %q, want prefix %q", out, "1\n")
+	}
+}
+
+func TestLocalProcessLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		local ci.Local
+		pids  int64
+		want  string
+	}{
+		{name: "Default", want: "4096\n"},
+		{name: "Local", local: ci.Local{Processes: 2048}, want: "2048\n"},
+		{name: "Job", local: ci.Local{Processes: 2048}, pids: 1024, want: "1024\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tt.local.Run(context.Background(), &ci.Job{
+				Limits:   ci.Limits{Pids: tt.pids},
+				Commands: []string{`ulimit -u`},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != tt.want {
+				t.Errorf("local.Run() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalResultsFile(t *testing.T) {
+	local := ci.Local{}
+	job := &ci.Job{
+		BindDir:     t.TempDir(),
+		ReportsDir:  t.TempDir(),
+		ResultsFile: "tap/results.tap",
+		Env:         []string{"HOME=/quickfeed", "REPORTS=/quickfeed-reports"},
+		Commands: []string{
+			`mkdir -p $REPORTS/tap`,
+			`printf 'ok 1 - TestA\n' > $REPORTS/tap/results.tap`,
+		},
+	}
+	if _, err := local.Run(context.Background(), job); err != nil {
+		t.Fatal(err)
+	}
+	// the results file is written directly to the job's reports directory
+	got, err := job.ReadResultsFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "ok 1 - TestA\n"; string(got) != want {
+		t.Errorf("ReadResultsFile() = %q, want %q", got, want)
+	}
+}
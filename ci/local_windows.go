@@ -1,21 +1,44 @@
 package ci
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
+	"os"
 	"os/exec"
 	"strings"
 )
 
-// Local is an implementation of the CI interface executing code locally.
-type Local struct{}
-
 // Run implements the CI interface. This method blocks until the job has been
 // completed or an error occurs, e.g., the context times out.
-func (l *Local) Run(ctx context.Context, job *Job) (string, error) {
-	cmd := exec.Command("bash", "-c", strings.Join(job.Commands, "\n"))
-	b, err := cmd.Output()
+// Resource limits are not applied on Windows.
+func (*Local) Run(ctx context.Context, job *Job) (string, error) {
+	workDir, err := os.MkdirTemp("", "quickfeed-local")
+	if err != nil {
+		return "", err
+	}
+	defer removeWorkDir(workDir)
+
+	paths, err := mirror(job, workDir)
 	if err != nil {
 		return "", err
 	}
-	return string(b), nil
+	cmd := exec.CommandContext(ctx, "bash", "-c", strings.Join(job.Commands, "\n"))
+	cmd.Dir = workDir
+	cmd.Env = localEnv(job.Env, paths)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if job.Output != nil {
+		cmd.Stdout = io.MultiWriter(&out, job.Output)
+	}
+	// the same writer for both streams ensures that only one goroutine writes to it
+	cmd.Stderr = cmd.Stdout
+
+	err = cmd.Run()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		out.WriteString("\n" + localTimeoutMsg)
+		err = errors.Join(ctxErr, err)
+	}
+	return truncatedLog(&out), err
 }
@@ -0,0 +1,228 @@
+package ci
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxArchiveSize is the maximum size of the output archived for each test run.
+	maxArchiveSize     = 100 << 20 // bytes
+	archiveExt         = ".log.gz"
+	archiveTruncateMsg = "\n\n... output exceeds the archive size limit; truncated ...\n"
+)
+
+// commitIDRegexp matches valid commit IDs; commit IDs are used as file names.
+var commitIDRegexp = regexp.MustCompile(`^[0-9a-fA-F]{4,64}$`)
+
+// LogArchive stores the complete, gzip-compressed output of test runs on disk,
+// in contrast to the truncated build log stored with each submission.
+// Logs are stored in files named <dir>/<submission ID>/<commit ID>.log.gz.
+type LogArchive struct {
+	dir string
+	// retention is the duration to keep archived logs; zero means forever.
+	retention time.Duration
+}
+
+// NewLogArchive returns a log archive storing logs below the given directory.
+// Logs older than the given retention are removed by Prune; zero retention keeps logs forever.
+func NewLogArchive(dir string, retention time.Duration) *LogArchive {
+	return &LogArchive{dir: dir, retention: retention}
+}
+
+// Save stores the compressed log for the given submission and commit, replacing any previous log.
+func (a *LogArchive) Save(submissionID uint64, commitID string, compressed []byte) error {
+	path, err := a.path(submissionID, commitID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	// write to a temporary file first, such that a concurrent Load never sees a partial log
+	tmp, err := os.CreateTemp(filepath.Dir(path), "tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(compressed); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Load returns the compressed log for the given submission and commit, and the log's commit ID.
+// If no commit ID is given, the most recently archived log for the submission is returned.
+func (a *LogArchive) Load(submissionID uint64, commitID string) ([]byte, string, error) {
+	if commitID == "" {
+		var err error
+		if commitID, err = a.latest(submissionID); err != nil {
+			return nil, "", err
+		}
+	}
+	path, err := a.path(submissionID, commitID)
+	if err != nil {
+		return nil, "", err
+	}
+	compressed, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	return compressed, commitID, nil
+}
+
+// latest returns the commit ID of the most recently archived log for the given submission.
+func (a *LogArchive) latest(submissionID uint64) (string, error) {
+	entries, err := os.ReadDir(filepath.Join(a.dir, strconv.FormatUint(submissionID, 10)))
+	if err != nil {
+		return "", err
+	}
+	var (
+		commitID string
+		modTime  time.Time
+	)
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), archiveExt)
+		if !ok {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return "", err
+		}
+		if commitID == "" || info.ModTime().After(modTime) {
+			commitID, modTime = name, info.ModTime()
+		}
+	}
+	if commitID == "" {
+		return "", fmt.Errorf("no archived logs for submission %d: %w", submissionID, fs.ErrNotExist)
+	}
+	return commitID, nil
+}
+
+// Prune removes archived logs older than the archive's retention, relative to the given time.
+// It returns the number of logs removed.
+func (a *LogArchive) Prune(now time.Time) (int, error) {
+	if a.retention == 0 {
+		return 0, nil
+	}
+	return pruneFiles(a.dir, now.Add(-a.retention))
+}
+
+// pruneFiles removes the files modified before the cutoff from the subdirectories of the given directory,
+// and removes subdirectories that become empty. It returns the number of files removed.
+func pruneFiles(dir string, cutoff time.Time) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	var (
+		removed int
+		errs    []error
+	)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		subDir := filepath.Join(dir, entry.Name())
+		files, err := os.ReadDir(subDir)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		remaining := len(files)
+		for _, file := range files {
+			info, err := file.Info()
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if info.ModTime().Before(cutoff) {
+				if err := os.Remove(filepath.Join(subDir, file.Name())); err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				removed++
+				remaining--
+			}
+		}
+		if remaining == 0 {
+			if err := os.Remove(subDir); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return removed, errors.Join(errs...)
+}
+
+// path returns the path of the log for the given submission and commit.
+func (a *LogArchive) path(submissionID uint64, commitID string) (string, error) {
+	if submissionID == 0 {
+		return "", errors.New("missing submission ID")
+	}
+	if !commitIDRegexp.MatchString(commitID) {
+		return "", fmt.Errorf("invalid commit ID %q for submission %d", commitID, submissionID)
+	}
+	return filepath.Join(a.dir, strconv.FormatUint(submissionID, 10), strings.ToLower(commitID)+archiveExt), nil
+}
+
+// archiveLog is an io.Writer that compresses a job's output for archival.
+// Output beyond maxArchiveSize is discarded.
+type archiveLog struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	zw        *gzip.Writer
+	written   int
+	truncated bool
+}
+
+func newArchiveLog() *archiveLog {
+	l := &archiveLog{}
+	l.zw = gzip.NewWriter(&l.buf)
+	return l
+}
+
+// Write compresses p, up to the archive size limit; it never returns an error, such that
+// the job's output is not disrupted.
+func (l *archiveLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := len(p)
+	if remaining := maxArchiveSize - l.written; len(p) > remaining {
+		p = p[:remaining]
+		l.truncated = true
+	}
+	// writing to a bytes.Buffer cannot fail
+	_, _ = l.zw.Write(p)
+	l.written += len(p)
+	return n, nil
+}
+
+// Bytes finalizes the compressed output and returns it.
+func (l *archiveLog) Bytes() []byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.truncated {
+		_, _ = l.zw.Write([]byte(archiveTruncateMsg))
+	}
+	_ = l.zw.Close()
+	return slices.Clip(l.buf.Bytes())
+}
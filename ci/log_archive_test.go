@@ -0,0 +1,142 @@
+package ci
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func gunzip(t *testing.T, compressed []byte) string {
+	t.Helper()
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestArchiveLog(t *testing.T) {
+	log := newArchiveLog()
+	for _, write := range []string{"=== RUN   TestA\n", "--- PASS: TestA\n", "ok"} {
+		if _, err := log.Write([]byte(write)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := gunzip(t, log.Bytes()), "=== RUN   TestA\n--- PASS: TestA\nok"; got != want {
+		t.Errorf("archived output = %q, want %q", got, want)
+	}
+}
+
+func TestLogArchive(t *testing.T) {
+	archive := NewLogArchive(t.TempDir(), 0)
+	const (
+		submissionID = 7
+		oldCommit    = "5f3a9c0d"
+		newCommit    = "A1B2C3D4"
+	)
+	for commitID, output := range map[string]string{oldCommit: "old output", newCommit: "new output"} {
+		log := newArchiveLog()
+		log.Write([]byte(output))
+		if err := archive.Save(submissionID, commitID, log.Bytes()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// ensure that the new commit's log is the most recent one
+	path, _ := archive.path(submissionID, oldCommit)
+	past := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(path, past, past); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		commitID   string
+		wantCommit string
+		wantOutput string
+	}{
+		{name: "OldCommit", commitID: oldCommit, wantCommit: oldCommit, wantOutput: "old output"},
+		{name: "NewCommit", commitID: "a1b2c3d4", wantCommit: "a1b2c3d4", wantOutput: "new output"},
+		{name: "Latest", commitID: "", wantCommit: "a1b2c3d4", wantOutput: "new output"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compressed, commitID, err := archive.Load(submissionID, tt.commitID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if commitID != tt.wantCommit {
+				t.Errorf("Load(%d, %q) commit = %q, want %q", submissionID, tt.commitID, commitID, tt.wantCommit)
+			}
+			if got := gunzip(t, compressed); got != tt.wantOutput {
+				t.Errorf("Load(%d, %q) output = %q, want %q", submissionID, tt.commitID, got, tt.wantOutput)
+			}
+		})
+	}
+
+	if _, _, err := archive.Load(submissionID+1, ""); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Load(%d, \"\") error = %v, want %v", submissionID+1, err, fs.ErrNotExist)
+	}
+	if _, _, err := archive.Load(submissionID, "deadbeef"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Load(%d, \"deadbeef\") error = %v, want %v", submissionID, err, fs.ErrNotExist)
+	}
+	for _, commitID := range []string{"", "../../etc/passwd", "main"} {
+		if err := archive.Save(submissionID, commitID, nil); err == nil {
+			t.Errorf("Save(%d, %q) succeeded, want error", submissionID, commitID)
+		}
+	}
+}
+
+func TestLogArchivePrune(t *testing.T) {
+	dir := t.TempDir()
+	archive := NewLogArchive(dir, 24*time.Hour)
+	now := time.Now()
+	logs := []struct {
+		submissionID uint64
+		commitID     string
+		age          time.Duration
+	}{
+		{submissionID: 1, commitID: "aaaa", age: 48 * time.Hour},
+		{submissionID: 2, commitID: "bbbb", age: 48 * time.Hour},
+		{submissionID: 2, commitID: "cccc", age: time.Hour},
+	}
+	for _, log := range logs {
+		if err := archive.Save(log.submissionID, log.commitID, []byte("log")); err != nil {
+			t.Fatal(err)
+		}
+		path, _ := archive.path(log.submissionID, log.commitID)
+		modTime := now.Add(-log.age)
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	removed, err := archive.Prune(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 2 {
+		t.Errorf("Prune() removed %d logs, want 2", removed)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "1")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected empty submission directory to be removed: %v", err)
+	}
+	if _, commitID, err := archive.Load(2, ""); err != nil || commitID != "cccc" {
+		t.Errorf("Load(2, \"\") = %q, %v, want %q", commitID, err, "cccc")
+	}
+
+	// zero retention keeps logs forever
+	removed, err = NewLogArchive(dir, 0).Prune(now.Add(365 * 24 * time.Hour))
+	if err != nil || removed != 0 {
+		t.Errorf("Prune() = %d, %v, want 0 logs removed", removed, err)
+	}
+}
@@ -11,6 +11,8 @@ func TestExecutionMetricsCollectors() []prometheus.Collector {
 		testsStartedCounter,
 		testsFailedCounter,
 		testsSucceededCounter,
+		testsCachedCounter,
+		limitExceededCounter,
 	}
 }
 
@@ -54,6 +56,16 @@ var (
 		Name: "quickfeed_test_execution_succeeded",
 		Help: "Total number of times test execution succeeded",
 	}, []string{"user", "course"})
+
+	testsCachedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "quickfeed_test_execution_cached",
+		Help: "Total number of times test execution was skipped by reusing cached results",
+	}, []string{"user", "course"})
+
+	limitExceededCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "quickfeed_test_execution_limit_exceeded",
+		Help: "Total number of times test execution exceeded a resource limit",
+	}, []string{"limit"})
 )
 
 func timer(jobOwner, course string, gauge *prometheus.GaugeVec) func() {
@@ -6,7 +6,7 @@ import (
 	"testing"
 )
 
-func TestModuleCachePath(t *testing.T) {
+func TestHostCacheDir(t *testing.T) {
 	// Uncomment the following line to run the test locally; do not commit the change
 	t.Skip("Only for local testing; should not be run on quickfeed server")
 	homedir, err := os.UserHomeDir()
@@ -15,12 +15,12 @@ func TestModuleCachePath(t *testing.T) {
 	}
 	_ = os.Remove(filepath.Join(homedir, GoModCache))
 
-	path, err := moduleCachePath()
+	path, err := hostCacheDir(GoModCache)
 	if err != nil {
 		t.Error(err)
 	}
 	if path != filepath.Join(homedir, GoModCache) {
-		t.Errorf("moduleCachePath() = %s, want %s", path, filepath.Join(homedir, GoModCache))
+		t.Errorf("hostCacheDir(%q) = %s, want %s", GoModCache, path, filepath.Join(homedir, GoModCache))
 	}
 	if ok, err := exists(path); !ok {
 		t.Errorf("%s does not exist: %v", path, err)
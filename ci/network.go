@@ -0,0 +1,64 @@
+package ci
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Network policies for test containers, declared by the #network/ directive in run scripts.
+const (
+	// NetworkNone disables networking for the container.
+	NetworkNone = "none"
+	// NetworkLoopback restricts the container to its loopback interface, e.g., for distributed systems labs.
+	NetworkLoopback = "loopback"
+	// NetworkAllow restricts the container to the allowed hosts, reachable through an HTTP(S) proxy.
+	NetworkAllow = "allow"
+)
+
+// NetworkPolicy specifies the network access for a job.
+// The zero value leaves the container engine's default networking in place.
+type NetworkPolicy struct {
+	// Mode is one of NetworkNone, NetworkLoopback or NetworkAllow; empty for default networking.
+	Mode string
+	// Allow lists the hosts reachable with the NetworkAllow policy.
+	// A host of the form *.example.com allows all subdomains of example.com.
+	Allow []string
+}
+
+// parseNetworkPolicy parses the argument of a #network/ directive, e.g.,
+// "none", "loopback" or "allow proxy.golang.org sum.golang.org".
+func parseNetworkPolicy(directive string) (NetworkPolicy, error) {
+	fields := strings.Fields(directive)
+	if len(fields) == 0 {
+		return NetworkPolicy{}, fmt.Errorf("no network policy specified")
+	}
+	policy := NetworkPolicy{Mode: strings.ToLower(fields[0])}
+	switch policy.Mode {
+	case NetworkNone, NetworkLoopback:
+		if len(fields) > 1 {
+			return NetworkPolicy{}, fmt.Errorf("network policy %s does not take hosts: %v", policy.Mode, fields[1:])
+		}
+	case NetworkAllow:
+		if len(fields) == 1 {
+			return NetworkPolicy{}, fmt.Errorf("network policy %s requires at least one host", policy.Mode)
+		}
+		for _, host := range fields[1:] {
+			policy.Allow = append(policy.Allow, strings.ToLower(host))
+		}
+	default:
+		return NetworkPolicy{}, fmt.Errorf("unknown network policy: %s", policy.Mode)
+	}
+	return policy, nil
+}
+
+// allows returns true if the policy allows connecting to the given host.
+func (p NetworkPolicy) allows(host string) bool {
+	host = strings.ToLower(host)
+	return slices.ContainsFunc(p.Allow, func(allowed string) bool {
+		if domain, ok := strings.CutPrefix(allowed, "*."); ok {
+			return strings.HasSuffix(host, "."+domain)
+		}
+		return host == allowed
+	})
+}
@@ -0,0 +1,66 @@
+package ci
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseRunScriptNetworkPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		want    NetworkPolicy
+		wantErr bool
+	}{
+		{name: "Default", script: "#image/quickfeed:go\n#language/go\necho hello\n"},
+		{name: "None", script: "#image/quickfeed:go\n#network/none\necho hello\n", want: NetworkPolicy{Mode: NetworkNone}},
+		{name: "Loopback", script: "#image/quickfeed:go\n#network/Loopback\necho hello\n", want: NetworkPolicy{Mode: NetworkLoopback}},
+		{
+			name:   "Allow",
+			script: "#image/quickfeed:go\n#network/allow proxy.golang.org *.PyPI.org\necho hello\n",
+			want:   NetworkPolicy{Mode: NetworkAllow, Allow: []string{"proxy.golang.org", "*.pypi.org"}},
+		},
+		{name: "AllowWithoutHosts", script: "#image/quickfeed:go\n#network/allow\necho hello\n", wantErr: true},
+		{name: "NoneWithHosts", script: "#image/quickfeed:go\n#network/none example.com\necho hello\n", wantErr: true},
+		{name: "Unknown", script: "#image/quickfeed:go\n#network/host\necho hello\n", wantErr: true},
+		{name: "Empty", script: "#image/quickfeed:go\n#network/\necho hello\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script, err := parseRunScript(tt.script)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRunScript() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, script.network); diff != "" {
+				t.Errorf("parseRunScript() network mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]string{"echo hello", ""}, script.commands); diff != "" {
+				t.Errorf("parseRunScript() commands mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNetworkPolicyAllows(t *testing.T) {
+	policy := NetworkPolicy{Mode: NetworkAllow, Allow: []string{"proxy.golang.org", "*.pypi.org"}}
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{host: "proxy.golang.org", want: true},
+		{host: "Proxy.Golang.org", want: true},
+		{host: "sum.golang.org", want: false},
+		{host: "files.pypi.org", want: true},
+		{host: "pypi.org", want: false},
+		{host: "evilpypi.org", want: false},
+	}
+	for _, tt := range tests {
+		if got := policy.allows(tt.host); got != tt.want {
+			t.Errorf("allows(%q) = %t, want %t", tt.host, got, tt.want)
+		}
+	}
+}
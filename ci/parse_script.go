@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/quickfeed/quickfeed/qf"
@@ -20,41 +21,76 @@ import (
 //	ASSIGNMENTS - to access the assignments (cloned from the course's assignments repository)
 //	SUBMITTED   - to access the student's or group's submitted code (cloned from the student/group repository)
 //	CURRENT     - name of the current assignment folder
+//	REPORTS     - to write the results, coverage and lint files declared by the script
 //	QUICKFEED_SESSION_SECRET - typically used by the test code; not the script itself
-func (r *RunData) parseTestRunnerScript(secret, destDir string) (*Job, error) {
+//	QUICKFEED_SOLUTION - set to true when the tests are run against the course's reference solution
+//	QUICKFEED_FAILED_TESTS - comma-separated names of the failed tests when the tests are rerun
+func (r *RunData) parseTestRunnerScript(secret, destDir, reportsDir string) (*Job, error) {
 	scriptContent, err := r.loadRunScript()
 	if err != nil {
 		return nil, err
 	}
-	image, language, commands, err := parseRunScript(scriptContent)
+	script, err := parseRunScript(scriptContent)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse run script for assignment %s in %s: %w", r.Assignment.GetName(), r.Repo.GetTestURL(), err)
 	}
+	registry, err := loadLanguages(r.Course, r.testsDir())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load language profiles for %s: %w", r.Course.GetCode(), err)
+	}
+	profile := registry[script.language]
+	if script.image == "" {
+		// use the language profile's default image
+		script.image = profile.Image
+	}
+	if script.image == "" {
+		return nil, fmt.Errorf("failed to parse run script for assignment %s in %s: %w", r.Assignment.GetName(), r.Repo.GetTestURL(), errNoImage)
+	}
 	if r.EnvVarsFn == nil {
 		// For docker runs, the home path is set to QuickFeedPath = /quickfeed
 		r.EnvVarsFn = func(secret, _ string) []string {
 			// QuickFeedPath is the home path (inside the container) bound to the temporary tests directory
 			vars := EnvVars(secret, QuickFeedPath, r.Repo.Name(), r.Assignment.GetName())
-			if cfg, ok := languages[language]; ok {
-				vars = append(vars, cfg.envVars...)
+			vars = append(vars, profile.Env...)
+			if r.Solution {
+				// added last to take precedence over the profile's GOFLAGS, if any
+				vars = append(vars, solutionEnvVars...)
 			}
 			return vars
 		}
 	}
-	testsDir := filepath.Join(r.Course.CloneDir(), qf.TestsRepo)
+	testsDir := r.testsDir()
 	assignmentDir := filepath.Join(r.Course.CloneDir(), qf.AssignmentsRepo)
-	return &Job{
-		Name:     r.String(),
-		Image:    image,
-		Language: language,
-		BindDir:  destDir,
+	job := &Job{
+		Name:       r.String(),
+		Image:      script.image,
+		Language:   script.language,
+		CacheDirs:  profile.cacheDirs(),
+		BindDir:    destDir,
+		ReportsDir: reportsDir,
 		ReadOnlyMounts: map[string]string{
 			testsDir:      filepath.Join(QuickFeedPath, qf.TestsRepo),
 			assignmentDir: filepath.Join(QuickFeedPath, qf.AssignmentsRepo),
 		},
 		Env:      r.EnvVarsFn(secret, destDir),
-		Commands: commands,
-	}, nil
+		Commands: script.commands,
+		Limits:   assignmentLimits(r.Assignment),
+		Network:  script.network,
+		Services: script.services,
+	}
+	if script.results != nil {
+		job.ResultsFile = script.results.path
+		job.resultsFormat = script.results.format
+	}
+	if script.coverage != nil {
+		job.CoverageFile = script.coverage.path
+		job.coverageFormat = script.coverage.format
+	}
+	if script.lint != nil {
+		job.LintFile = script.lint.path
+		job.lintFormat = script.lint.format
+	}
+	return job, nil
 }
 
 func (r *RunData) loadRunScript() (string, error) {
@@ -62,7 +98,7 @@ func (r *RunData) loadRunScript() (string, error) {
 		scriptFile   = "run.sh"
 		scriptFolder = "scripts"
 	)
-	courseTestsDir := filepath.Join(r.Course.CloneDir(), qf.TestsRepo)
+	courseTestsDir := r.testsDir()
 	runScript := filepath.Join(courseTestsDir, r.Assignment.GetName(), scriptFile)
 	if _, err := os.Stat(runScript); os.IsNotExist(err) {
 		// If the assignment does not have a run.sh script, use the default run.sh script
@@ -78,24 +114,92 @@ func (r *RunData) loadRunScript() (string, error) {
 	return string(b), nil
 }
 
-func parseRunScript(scriptContent string) (image, language string, commands []string, err error) {
+var errNoImage = errors.New("no docker image specified in run script")
+
+// runScript holds the content of a parsed run script.
+// The image is empty if the script has no #image/ directive on its first line;
+// the language profile's default image is used in this case.
+type runScript struct {
+	image    string
+	language string
+	network  NetworkPolicy
+	services []Service
+	results  *jobFile
+	coverage *jobFile
+	lint     *jobFile
+	commands []string
+}
+
+func parseRunScript(scriptContent string) (*runScript, error) {
 	lines := strings.Split(scriptContent, "\n")
 	if len(lines) < 3 {
-		return "", "", nil, errors.New("empty run script")
+		return nil, errors.New("empty run script")
 	}
-	parts := strings.Split(lines[0], "#image/")
-	if len(parts) < 2 {
-		return "", "", nil, errors.New("no docker image specified in run script")
+	script := &runScript{}
+	if _, image, found := strings.Cut(lines[0], "#image/"); found {
+		script.image = strings.ToLower(image)
+		lines = lines[1:]
 	}
-	image = strings.ToLower(parts[1])
-	for _, line := range lines[1:] {
+	for _, line := range lines {
 		if lang, found := strings.CutPrefix(line, "#language/"); found {
-			language = strings.ToLower(strings.TrimSpace(lang))
+			script.language = strings.ToLower(strings.TrimSpace(lang))
+			continue
+		}
+		if network, found := strings.CutPrefix(line, "#network/"); found {
+			policy, err := parseNetworkPolicy(network)
+			if err != nil {
+				return nil, err
+			}
+			script.network = policy
+			continue
+		}
+		if directive, found := strings.CutPrefix(line, "#service/"); found {
+			service, err := parseService(directive)
+			if err != nil {
+				return nil, err
+			}
+			if slices.ContainsFunc(script.services, func(s Service) bool { return s.Name == service.Name }) {
+				return nil, fmt.Errorf("duplicate service: %s", service.Name)
+			}
+			script.services = append(script.services, service)
+			continue
+		}
+		if directive, found := strings.CutPrefix(line, "#results/"); found {
+			if script.results != nil {
+				return nil, errors.New("duplicate results file")
+			}
+			results, err := parseResultsFile(directive)
+			if err != nil {
+				return nil, err
+			}
+			script.results = results
+			continue
+		}
+		if directive, found := strings.CutPrefix(line, "#coverage/"); found {
+			if script.coverage != nil {
+				return nil, errors.New("duplicate coverage file")
+			}
+			coverage, err := parseCoverageFile(directive)
+			if err != nil {
+				return nil, err
+			}
+			script.coverage = coverage
+			continue
+		}
+		if directive, found := strings.CutPrefix(line, "#lint/"); found {
+			if script.lint != nil {
+				return nil, errors.New("duplicate lint file")
+			}
+			lint, err := parseLintFile(directive)
+			if err != nil {
+				return nil, err
+			}
+			script.lint = lint
 			continue
 		}
-		commands = append(commands, line)
+		script.commands = append(script.commands, line)
 	}
-	return image, language, commands, nil
+	return script, nil
 }
 
 func EnvVars(sessionSecret, home, repoName, currentAssignment string) []string {
@@ -105,6 +209,7 @@ func EnvVars(sessionSecret, home, repoName, currentAssignment string) []string {
 		"ASSIGNMENTS": filepath.Join(home, qf.AssignmentsRepo),
 		"SUBMITTED":   filepath.Join(home, repoName),
 		"CURRENT":     currentAssignment,
+		"REPORTS":     ReportsPath,
 		secretEnvName: sessionSecret,
 	}
 	envVars := make([]string, 0, len(envMap))
@@ -79,7 +79,7 @@ echo "$QUICKFEED_SESSION_SECRET"
 	randomSecret := rand.String()
 
 	runData := testRunData(qfTestOrg)
-	job, err := runData.parseTestRunnerScript(randomSecret, "")
+	job, err := runData.parseTestRunnerScript(randomSecret, "", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -93,6 +93,7 @@ echo "$QUICKFEED_SESSION_SECRET"
 		"ASSIGNMENTS=" + filepath.Join(QuickFeedPath, qf.AssignmentsRepo),
 		"SUBMITTED=" + filepath.Join(QuickFeedPath, qf.StudentRepoName("user")),
 		"CURRENT=" + runData.Assignment.GetName(),
+		"REPORTS=" + ReportsPath,
 		"QUICKFEED_SESSION_SECRET=" + randomSecret,
 	}
 	trans := cmp.Transformer("Sort", func(in []string) []string {
@@ -125,7 +126,7 @@ func TestParseBadTestRunnerScript(t *testing.T) {
 
 	runData := testRunData(qfTestOrg)
 	runData.Assignment = &qf.Assignment{Name: "lab4-bad-run-script"}
-	job, err := runData.parseTestRunnerScript(randomSecret, "")
+	job, err := runData.parseTestRunnerScript(randomSecret, "", "")
 	if err == nil {
 		t.Fatalf("expected error, got nil: %+v", job)
 	}
@@ -135,7 +136,7 @@ func TestParseBadTestRunnerScript(t *testing.T) {
 	}
 
 	runData.Assignment = &qf.Assignment{Name: "lab5-bad-run-script"}
-	job, err = runData.parseTestRunnerScript(randomSecret, "")
+	job, err = runData.parseTestRunnerScript(randomSecret, "", "")
 	if err == nil {
 		t.Fatalf("expected error, got nil: %+v", job)
 	}
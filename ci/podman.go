@@ -0,0 +1,26 @@
+package ci
+
+import (
+	"github.com/moby/moby/client"
+	"go.uber.org/zap"
+)
+
+// keepID maps the current user to the same uid and gid inside rootless Podman containers.
+// Without it, the container user is mapped to one of the host user's subordinate ids,
+// and files written to the bind mounted directories would not be owned by the current user.
+const keepID = "keep-id"
+
+// NewPodmanCI returns a runner to run CI tests using rootless Podman's
+// Docker-compatible API, served on the given host socket. Unlike the Docker
+// daemon, rootless Podman runs containers without root privileges on the host.
+func NewPodmanCI(logger *zap.SugaredLogger, host string) (*Docker, error) {
+	cli, err := client.New(client.WithHost(host))
+	if err != nil {
+		return nil, err
+	}
+	return &Docker{
+		client: cli,
+		logger: logger,
+		userns: keepID,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package ci_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/internal/env"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+)
+
+var podman bool
+
+func init() {
+	if os.Getenv("PODMAN_TESTS") != "" {
+		podman = true
+	}
+}
+
+func podmanClient(t *testing.T) (*ci.Docker, func()) {
+	t.Helper()
+	podman, err := ci.NewPodmanCI(qtest.Logger(t), env.PodmanHost())
+	if err != nil {
+		t.Fatalf("Failed to set up podman client: %v", err)
+	}
+	return podman, func() { _ = podman.Close() }
+}
+
+func TestPodmanBindDir(t *testing.T) {
+	if !podman {
+		t.SkipNow()
+	}
+
+	const (
+		script  = `echo -n "hello" > /quickfeed/hello.txt; cat /quickfeed-tests/x_test.go > /dev/null && echo -n "hello world"`
+		wantOut = "hello world"
+		image   = "quickfeed:go"
+	)
+	podman, closeFn := podmanClient(t)
+	defer closeFn()
+
+	testsDir, err := filepath.Abs("./testdata/tests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// dir is the directory to map into /quickfeed in the podman container.
+	dir := t.TempDir()
+	out, err := podman.Run(context.Background(), &ci.Job{
+		Name:  t.Name() + "-" + qtest.RandomString(t),
+		Image: image,
+		BuildContext: map[string]string{
+			ci.Dockerfile: "FROM golang:latest\nWORKDIR /quickfeed\n",
+		},
+		BindDir:        dir,
+		ReadOnlyMounts: map[string]string{testsDir: "/quickfeed-tests"},
+		Commands:       []string{script},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != wantOut {
+		t.Errorf("podman.Run(%#v) = %#v, want %#v", script, out, wantOut)
+	}
+	// files created in the container must be owned by the current user
+	checkOwner(t, filepath.Join(dir, "hello.txt"))
+}
+
+func TestPodmanTimeout(t *testing.T) {
+	if !podman {
+		t.SkipNow()
+	}
+
+	const (
+		script  = `sleep 10`
+		wantOut = "Container timeout. Please check for infinite loops or other slowness."
+		image   = "golang:latest"
+	)
+	podman, closeFn := podmanClient(t)
+	defer closeFn()
+
+	// The timeout must allow the container to start; see TestDockerTimeout.
+	ctx, cancel := context.WithTimeout(context.Background(), 5000*time.Millisecond)
+	defer cancel()
+	out, err := podman.Run(ctx, &ci.Job{
+		Name:     t.Name() + "-" + qtest.RandomString(t),
+		Image:    image,
+		Commands: []string{script},
+	})
+	if out != wantOut {
+		t.Errorf("podman.Run(%#v) = %#v, want %#v", script, out, wantOut)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("podman.Run(%#v) = %v, want %v", script, err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,444 @@
+package ci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/quickfeed/quickfeed/database"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxConcurrentJobs is the maximum number of jobs executed concurrently.
+	maxConcurrentJobs = 10
+	// pruneInterval is the interval between removals of expired build log archives and cached results.
+	pruneInterval = 24 * time.Hour
+)
+
+// ResultsHandler is called after the results of a job have been recorded.
+// The results are nil for manually graded assignments.
+type ResultsHandler func(ctx context.Context, sc scm.SCM, job *qf.Job, runData *RunData, results *score.Results, submission *qf.Submission)
+
+// FinishedHandler is called after a job has succeeded, failed or been cancelled.
+type FinishedHandler func(job *qf.Job)
+
+// OutputHandler is called with the output of a running job's tests, as the output is produced.
+// The output consists of complete lines, without score lines.
+type OutputHandler func(job *qf.Job, output string)
+
+// Queue is a persistent queue of test run jobs backed by the database.
+// Jobs are executed by a pool of workers using the queue's Runner.
+// Jobs that were running when the server stopped are restarted when the queue is started.
+type Queue struct {
+	logger   *zap.SugaredLogger
+	db       database.Database
+	scmMgr   *scm.Manager
+	runner   Runner
+	wakeup   chan struct{}
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	handlers []ResultsHandler
+	finished []FinishedHandler
+	output   []OutputHandler
+	archive  *LogArchive
+	cache    *ResultsCache
+	done     map[uint64]chan struct{}      // map: job ID -> closed when the job is finished
+	running  map[uint64]context.CancelFunc // map: job ID -> cancels the running job
+	canceled map[uint64]bool               // map: job ID -> true if the running job was cancelled by Cancel
+}
+
+// NewQueue returns a new job queue. The queue's workers are not started until Start is called.
+func NewQueue(logger *zap.SugaredLogger, db database.Database, scmMgr *scm.Manager, runner Runner) *Queue {
+	return &Queue{
+		logger:   logger,
+		db:       db,
+		scmMgr:   scmMgr,
+		runner:   runner,
+		wakeup:   make(chan struct{}, maxConcurrentJobs),
+		done:     make(map[uint64]chan struct{}),
+		running:  make(map[uint64]context.CancelFunc),
+		canceled: make(map[uint64]bool),
+	}
+}
+
+// Runner returns the runner used to execute the queue's jobs, or nil if the queue is nil.
+func (q *Queue) Runner() Runner {
+	if q == nil {
+		return nil
+	}
+	return q.runner
+}
+
+// Handle registers a handler to be called after the results of each job have been recorded.
+// Handlers are called in the order they were registered.
+func (q *Queue) Handle(handler ResultsHandler) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.handlers = append(q.handlers, handler)
+}
+
+// HandleFinished registers a handler to be called after each job has finished.
+func (q *Queue) HandleFinished(handler FinishedHandler) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.finished = append(q.finished, handler)
+}
+
+// HandleOutput registers a handler to be called with the output of each job's tests while they are running.
+func (q *Queue) HandleOutput(handler OutputHandler) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.output = append(q.output, handler)
+}
+
+// ArchiveLogs configures the queue to store the complete test output of each job in the given archive.
+// Expired logs are removed from the archive while the queue is running.
+// ArchiveLogs must be called before Start.
+func (q *Queue) ArchiveLogs(archive *LogArchive) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.archive = archive
+}
+
+// CacheResults configures the queue to cache the results of each job's tests in the given cache,
+// and to reuse cached results for jobs testing the same student commit and tests, unless forced.
+// Expired results are removed from the cache while the queue is running.
+// CacheResults must be called before Start.
+func (q *Queue) CacheResults(cache *ResultsCache) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.cache = cache
+}
+
+// Start requeues jobs that were interrupted and starts the queue's workers.
+func (q *Queue) Start() error {
+	requeued, err := q.db.RequeueRunningJobs()
+	if err != nil {
+		return fmt.Errorf("failed to requeue interrupted jobs: %w", err)
+	}
+	if requeued > 0 {
+		q.logger.Infof("Requeued %d interrupted jobs", requeued)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+	q.wg.Add(maxConcurrentJobs)
+	for range maxConcurrentJobs {
+		go q.worker(ctx)
+	}
+	if q.archive != nil || q.cache != nil {
+		q.wg.Add(1)
+		go q.prune(ctx)
+	}
+	return nil
+}
+
+// prune removes expired logs from the archive and expired results from the cache,
+// once when started and then periodically.
+func (q *Queue) prune(ctx context.Context) {
+	defer q.wg.Done()
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+	for {
+		if q.archive != nil {
+			removed, err := q.archive.Prune(time.Now())
+			if err != nil {
+				q.logger.Errorf("Failed to prune build log archive: %v", err)
+			}
+			if removed > 0 {
+				q.logger.Infof("Removed %d expired build logs from archive", removed)
+			}
+		}
+		if q.cache != nil {
+			removed, err := q.cache.Prune(time.Now())
+			if err != nil {
+				q.logger.Errorf("Failed to prune results cache: %v", err)
+			}
+			if removed > 0 {
+				q.logger.Infof("Removed %d expired results from cache", removed)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close stops the queue's workers and waits for them to exit.
+// Jobs that are interrupted are returned to the queue and restarted on the next Start.
+func (q *Queue) Close() {
+	if q.cancel != nil {
+		q.cancel()
+	}
+	q.wg.Wait()
+}
+
+// Enqueue persists the given job and schedules it for execution.
+func (q *Queue) Enqueue(job *qf.Job) error {
+	// The job's done channel must be registered before a worker can finish the job.
+	// A worker may claim the job as soon as it is created, but it must hold q.mu to finish
+	// the job; hence, the job is created and its done channel registered while holding q.mu.
+	q.mu.Lock()
+	if err := q.db.CreateJob(job); err != nil {
+		q.mu.Unlock()
+		return fmt.Errorf("failed to create job for %s: %w", job.GetJobOwner(), err)
+	}
+	q.done[job.GetID()] = make(chan struct{})
+	q.mu.Unlock()
+	q.logger.Debugf("Enqueued job %d for %s", job.GetID(), job.GetJobOwner())
+	select {
+	case q.wakeup <- struct{}{}:
+	default:
+		// all workers are busy; they will claim the job when they are done
+	}
+	return nil
+}
+
+// Wait blocks until the jobs with the given IDs are finished or the context is done.
+func (q *Queue) Wait(ctx context.Context, jobIDs ...uint64) error {
+	for _, id := range jobIDs {
+		q.mu.Lock()
+		done, ok := q.done[id]
+		q.mu.Unlock()
+		if !ok {
+			// the job is already finished
+			continue
+		}
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Cancel cancels the jobs matching the given query. Queued jobs are cancelled
+// immediately, while running jobs are stopped and marked as cancelled by their worker.
+func (q *Queue) Cancel(query *qf.Job) error {
+	cancelled, err := q.db.CancelQueuedJobs(query)
+	if err != nil {
+		return fmt.Errorf("failed to cancel queued jobs: %w", err)
+	}
+	for _, job := range cancelled {
+		q.finish(job)
+	}
+	runningQuery := proto.CloneOf(query)
+	runningQuery.Status = qf.Job_RUNNING
+	running, err := q.db.GetJobs(runningQuery)
+	if err != nil {
+		return fmt.Errorf("failed to get running jobs: %w", err)
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, job := range running {
+		// the flag is also set if the job's worker has not yet started it
+		q.canceled[job.GetID()] = true
+		if cancel, ok := q.running[job.GetID()]; ok {
+			cancel()
+		}
+	}
+	return nil
+}
+
+func (q *Queue) worker(ctx context.Context) {
+	defer q.wg.Done()
+	for {
+		job, err := q.db.ClaimJob()
+		if errors.Is(err, database.ErrJobAlreadyClaimed) {
+			// another worker claimed the job; try to claim the next queued job
+			continue
+		}
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				q.logger.Errorf("Failed to claim job: %v", err)
+			}
+			select {
+			case <-q.wakeup:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		q.execute(ctx, job)
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// execute runs the given job and records its final status.
+func (q *Queue) execute(ctx context.Context, job *qf.Job) {
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	q.mu.Lock()
+	q.running[job.GetID()] = cancel
+	if q.canceled[job.GetID()] {
+		// the job was cancelled after it was claimed
+		cancel()
+	}
+	q.mu.Unlock()
+
+	err := q.run(jobCtx, job)
+
+	q.mu.Lock()
+	canceled := q.canceled[job.GetID()]
+	delete(q.canceled, job.GetID())
+	delete(q.running, job.GetID())
+	q.mu.Unlock()
+
+	switch {
+	case canceled:
+		job.Status = qf.Job_CANCELLED
+	case err != nil && ctx.Err() != nil:
+		// the job was interrupted by closing the queue; restart it on the next Start
+		job.Status = qf.Job_QUEUED
+	case err != nil:
+		q.logger.Errorf("Job %d for %s failed: %v", job.GetID(), job.GetJobOwner(), err)
+		job.Status = qf.Job_FAILED
+		job.Error = err.Error()
+	default:
+		job.Status = qf.Job_SUCCEEDED
+	}
+	if err := q.db.UpdateJob(job); err != nil {
+		q.logger.Errorf("Failed to update job %d: %v", job.GetID(), err)
+	}
+	if job.GetStatus() == qf.Job_QUEUED {
+		return
+	}
+	q.finish(job)
+}
+
+// finish notifies waiters and the registered handlers that the given job has finished.
+func (q *Queue) finish(job *qf.Job) {
+	q.mu.Lock()
+	if done, ok := q.done[job.GetID()]; ok {
+		close(done)
+		delete(q.done, job.GetID())
+	}
+	handlers := q.finished
+	q.mu.Unlock()
+	for _, handler := range handlers {
+		handler(job)
+	}
+}
+
+// run runs the tests for the given job, records the results and calls the registered handlers.
+func (q *Queue) run(ctx context.Context, job *qf.Job) error {
+	runData, err := q.runData(job)
+	if err != nil {
+		return err
+	}
+	if runData.Assignment.GradedManually() {
+		if job.GetDryRun() || job.GetSolution() {
+			return fmt.Errorf("assignment %s for course %s is manually reviewed; there are no tests to run", runData.Assignment.GetName(), runData.Course.GetName())
+		}
+		q.logger.Debugf("Assignment %s for course %s is manually reviewed", runData.Assignment.GetName(), runData.Course.GetName())
+		submission, err := runData.RecordResults(q.logger, q.db, nil)
+		if err != nil {
+			return err
+		}
+		job.Score = submission.GetScore()
+		q.notify(ctx, nil, job, runData, nil, submission)
+		return nil
+	}
+
+	jobCtx, cancel := runData.Assignment.WithTimeout(DefaultContainerTimeout)
+	defer cancel()
+	// stop the job if it is cancelled or the queue is closed
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+
+	sc, err := q.scmMgr.GetOrCreateSCM(jobCtx, q.logger, runData.Course.GetScmOrganizationName())
+	if err != nil {
+		return fmt.Errorf("could not create scm client for course %s: %w", runData.Course.GetScmOrganizationName(), err)
+	}
+	q.mu.Lock()
+	outputHandlers := q.output
+	if !job.GetSolution() {
+		// runs against the reference solution are neither archived nor cached
+		runData.Archive = q.archive
+		runData.Cache = q.cache
+	}
+	q.mu.Unlock()
+	// the output of a dry run or solution run is not sent to the users following the test run
+	if len(outputHandlers) > 0 && !job.GetDryRun() && !job.GetSolution() {
+		runData.OutputFn = func(output string) {
+			for _, handler := range outputHandlers {
+				handler(job, output)
+			}
+		}
+	}
+	results, err := runData.RunTests(jobCtx, q.logger, sc, q.runner)
+	if err != nil {
+		return err
+	}
+	if job.GetDryRun() {
+		return q.reportDryRun(job, results)
+	}
+	if job.GetSolution() {
+		reportSolution(job, results)
+		return nil
+	}
+	submission, err := runData.RecordResults(q.logger, q.db, results)
+	if err != nil {
+		return fmt.Errorf("failed to record results for assignment %s for course %s: %w", runData.Assignment.GetName(), runData.Course.GetName(), err)
+	}
+	job.Score = submission.GetScore()
+	q.notify(jobCtx, sc, job, runData, results, submission)
+	return nil
+}
+
+func (q *Queue) notify(ctx context.Context, sc scm.SCM, job *qf.Job, runData *RunData, results *score.Results, submission *qf.Submission) {
+	q.mu.Lock()
+	handlers := q.handlers
+	q.mu.Unlock()
+	for _, handler := range handlers {
+		handler(ctx, sc, job, runData, results, submission)
+	}
+}
+
+// runData returns the run data for the given job.
+func (q *Queue) runData(job *qf.Job) (*RunData, error) {
+	assignment, err := q.db.GetAssignment(&qf.Assignment{ID: job.GetAssignmentID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignment %d: %w", job.GetAssignmentID(), err)
+	}
+	course, err := q.db.GetCourse(assignment.GetCourseID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course %d: %w", assignment.GetCourseID(), err)
+	}
+	repos, err := q.db.GetRepositories(&qf.Repository{ID: job.GetRepositoryID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository %d: %w", job.GetRepositoryID(), err)
+	}
+	if len(repos) != 1 {
+		return nil, fmt.Errorf("unknown repository: %d", job.GetRepositoryID())
+	}
+	repo := repos[0]
+	if job.GetSolution() {
+		repo = solutionRepo(course, repo)
+	}
+	return &RunData{
+		Course:      course,
+		Assignment:  assignment,
+		Repo:        repo,
+		BranchName:  job.GetBranchName(),
+		CommitID:    job.GetCommitID(),
+		JobOwner:    job.GetJobOwner(),
+		Rebuild:     job.GetRebuild(),
+		Force:       job.GetForce(),
+		TestsBranch: job.GetTestsBranch(),
+		Solution:    job.GetSolution(),
+	}, nil
+}
@@ -35,6 +35,7 @@ func (r *RunData) RecordResults(logger *zap.SugaredLogger, db database.Database,
 		return nil, fmt.Errorf("failed to record submission %d for %s: %w", previous.GetID(), r, err)
 	}
 	logger.Debugf("Recorded %s for %s with status %s and score %d", resType, r, newSubmission.GetStatuses(), newSubmission.GetScore())
+	r.archiveLog(logger, newSubmission)
 
 	if !r.Rebuild {
 		if err := r.updateSlipDays(logger, db, newSubmission); err != nil {
@@ -45,6 +46,17 @@ func (r *RunData) RecordResults(logger *zap.SugaredLogger, db database.Database,
 	return newSubmission, nil
 }
 
+// archiveLog stores the complete test output for the given submission, if archival is enabled.
+// Failing to archive the output is logged, but does not fail the recording of the results.
+func (r *RunData) archiveLog(logger *zap.SugaredLogger, submission *qf.Submission) {
+	if r.Archive == nil || r.archived == nil {
+		return
+	}
+	if err := r.Archive.Save(submission.GetID(), r.CommitID, r.archived); err != nil {
+		logger.Errorf("Failed to archive build log for %s: %v", r, err)
+	}
+}
+
 func (r *RunData) previousSubmission(db database.Database) (*qf.Submission, error) {
 	submissionQuery := &qf.Submission{
 		AssignmentID: r.Assignment.GetID(),
@@ -84,7 +96,16 @@ func (r *RunData) newTestRunSubmission(previous *qf.Submission, results *score.R
 		// Keep previous submission's delivery date if this is a rebuild.
 		results.BuildInfo.SubmissionDate = previous.GetBuildInfo().GetSubmissionDate()
 	}
+	// classify the scores, e.g., of cached results, against the assignment's current expected tests
+	results.Classify(r.Assignment.ZeroScoreTests())
+	if sc := r.Assignment.CoverageScore(results.GetBuildInfo().GetCoverage()); sc != nil {
+		results.Scores = append(results.Scores, sc)
+	}
 	score := results.Sum()
+	if results.BuildInfo != nil {
+		results.BuildInfo.LintDeduction = r.Assignment.LintDeduction(len(results.BuildInfo.GetLintFindings()))
+		score -= min(score, results.BuildInfo.GetLintDeduction())
+	}
 	previous.SetGradesIfApproved(r.Assignment, score)
 	return &qf.Submission{
 		ID:           previous.GetID(),
@@ -0,0 +1,182 @@
+package remote
+
+import (
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/ci/remote/remotepb"
+)
+
+// newJob returns a job bundle for the given ci.Job, including the
+// content of the job's bind directory and read-only mounts.
+func newJob(job *ci.Job) (*remotepb.Job, error) {
+	remoteJob := &remotepb.Job{
+		Name:         job.Name,
+		Image:        job.Image,
+		Language:     job.Language,
+		CacheDirs:    job.CacheDirs,
+		BuildContext: job.BuildContext,
+		Env:          job.Env,
+		Commands:     job.Commands,
+		LiveOutput:   job.Output != nil,
+		ResultsFile:  job.ResultsFile,
+		CoverageFile: job.CoverageFile,
+		LintFile:     job.LintFile,
+		Limits: &remotepb.Limits{
+			Memory:   job.Limits.Memory,
+			NanoCPUs: job.Limits.NanoCPUs,
+			Pids:     job.Limits.Pids,
+			FileSize: job.Limits.FileSize,
+		},
+		Network: &remotepb.Network{
+			Mode:  job.Network.Mode,
+			Allow: job.Network.Allow,
+		},
+	}
+	for _, service := range job.Services {
+		remoteJob.Services = append(remoteJob.Services, &remotepb.Service{
+			Name:  service.Name,
+			Image: service.Image,
+			Env:   service.Env,
+		})
+	}
+	if job.BindDir != "" {
+		dir, err := readDir(job.BindDir, ci.QuickFeedPath)
+		if err != nil {
+			return nil, err
+		}
+		remoteJob.BindDir = dir
+	}
+	for _, src := range slices.Sorted(maps.Keys(job.ReadOnlyMounts)) {
+		dir, err := readDir(src, job.ReadOnlyMounts[src])
+		if err != nil {
+			return nil, err
+		}
+		remoteJob.ReadOnlyMounts = append(remoteJob.ReadOnlyMounts, dir)
+	}
+	return remoteJob, nil
+}
+
+// readDir returns the regular files in the given host directory.
+// Symbolic links and other non-regular files are skipped.
+func readDir(root, target string) (*remotepb.Directory, error) {
+	dir := &remotepb.Directory{Target: target}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		dir.Files = append(dir.Files, &remotepb.File{
+			Path:    filepath.ToSlash(rel),
+			Content: content,
+			Mode:    uint32(info.Mode().Perm()),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", root, err)
+	}
+	return dir, nil
+}
+
+// unpackJob writes the content of the job bundle's directories below
+// the given base directory and returns the corresponding ci.Job.
+// The job's reports directory is created empty, since the job's tests write its files.
+func unpackJob(j *remotepb.Job, baseDir string) (*ci.Job, error) {
+	job := &ci.Job{
+		Name:         j.GetName(),
+		Image:        j.GetImage(),
+		Language:     j.GetLanguage(),
+		CacheDirs:    j.GetCacheDirs(),
+		BuildContext: j.GetBuildContext(),
+		Env:          j.GetEnv(),
+		Commands:     j.GetCommands(),
+		ResultsFile:  j.GetResultsFile(),
+		CoverageFile: j.GetCoverageFile(),
+		LintFile:     j.GetLintFile(),
+		Limits: ci.Limits{
+			Memory:   j.GetLimits().GetMemory(),
+			NanoCPUs: j.GetLimits().GetNanoCPUs(),
+			Pids:     j.GetLimits().GetPids(),
+			FileSize: j.GetLimits().GetFileSize(),
+		},
+		Network: ci.NetworkPolicy{
+			Mode:  j.GetNetwork().GetMode(),
+			Allow: j.GetNetwork().GetAllow(),
+		},
+	}
+	for _, service := range j.GetServices() {
+		job.Services = append(job.Services, ci.Service{
+			Name:  service.GetName(),
+			Image: service.GetImage(),
+			Env:   service.GetEnv(),
+		})
+	}
+	if j.GetBindDir() != nil {
+		bindDir := filepath.Join(baseDir, "bind")
+		if err := writeDir(j.GetBindDir(), bindDir); err != nil {
+			return nil, err
+		}
+		job.BindDir = bindDir
+		reportsDir := filepath.Join(baseDir, "reports")
+		if err := os.Mkdir(reportsDir, 0o700); err != nil {
+			return nil, err
+		}
+		job.ReportsDir = reportsDir
+	}
+	if len(j.GetReadOnlyMounts()) > 0 {
+		job.ReadOnlyMounts = make(map[string]string)
+	}
+	for i, mount := range j.GetReadOnlyMounts() {
+		src := filepath.Join(baseDir, fmt.Sprintf("mount-%d", i))
+		if err := writeDir(mount, src); err != nil {
+			return nil, err
+		}
+		job.ReadOnlyMounts[src] = mount.GetTarget()
+	}
+	return job, nil
+}
+
+// writeDir writes the directory's files below the given root directory.
+func writeDir(d *remotepb.Directory, root string) error {
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return err
+	}
+	rootFS, err := os.OpenRoot(root)
+	if err != nil {
+		return err
+	}
+	defer rootFS.Close()
+	for _, file := range d.GetFiles() {
+		// os.Root rejects paths that escape the root directory
+		path := filepath.FromSlash(file.GetPath())
+		if dir := filepath.Dir(path); dir != "." {
+			if err := rootFS.MkdirAll(dir, 0o700); err != nil {
+				return fmt.Errorf("failed to create directory for %s: %w", file.GetPath(), err)
+			}
+		}
+		if err := rootFS.WriteFile(path, file.GetContent(), fs.FileMode(file.GetMode())&fs.ModePerm); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.GetPath(), err)
+		}
+	}
+	return nil
+}
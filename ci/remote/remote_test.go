@@ -0,0 +1,198 @@
+package remote_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/ci/remote"
+	"github.com/quickfeed/quickfeed/ci/remote/remotepb/remotepbconnect"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+)
+
+// fileRunner is a fake runner that returns the content of the files in the job's
+// bind directory and read-only mounts, followed by the job's output.
+type fileRunner struct {
+	output string
+	err    error
+}
+
+func (f *fileRunner) Run(_ context.Context, job *ci.Job) (string, error) {
+	if job.Limits != jobLimits || !slices.Equal(job.Network.Allow, jobNetwork.Allow) || job.Network.Mode != jobNetwork.Mode {
+		return "", fmt.Errorf("unexpected limits or network policy: %+v, %+v", job.Limits, job.Network)
+	}
+	if !slices.EqualFunc(job.Services, jobServices, func(a, b ci.Service) bool {
+		return a.Name == b.Name && a.Image == b.Image && slices.Equal(a.Env, b.Env)
+	}) {
+		return "", fmt.Errorf("unexpected services: %+v", job.Services)
+	}
+	var out strings.Builder
+	for _, path := range []string{
+		filepath.Join(job.BindDir, "user-labs", "lab1", "main.go"),
+		filepath.Join(sourceFor(job, "/quickfeed/tests"), "lab1", "run.sh"),
+	} {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		out.Write(content)
+	}
+	out.WriteString(f.output)
+	if job.Output != nil {
+		if _, err := job.Output.Write([]byte(f.output)); err != nil {
+			return "", err
+		}
+	}
+	return out.String(), f.err
+}
+
+var (
+	jobLimits   = ci.Limits{Memory: 512 << 20, NanoCPUs: 1_500_000_000, Pids: 100, FileSize: 64 << 20}
+	jobNetwork  = ci.NetworkPolicy{Mode: ci.NetworkAllow, Allow: []string{"proxy.golang.org"}}
+	jobServices = []ci.Service{{Name: "postgres", Image: "postgres:16", Env: []string{"POSTGRES_PASSWORD=secret"}}}
+)
+
+// sourceFor returns the worker's source directory for the given read-only mount target.
+func sourceFor(job *ci.Job, target string) string {
+	for src, dst := range job.ReadOnlyMounts {
+		if dst == target {
+			return src
+		}
+	}
+	return ""
+}
+
+func newWorker(t *testing.T, runner ci.Runner, token string) string {
+	t.Helper()
+	router := http.NewServeMux()
+	router.Handle(remotepbconnect.NewRunnerServiceHandler(remote.NewWorker(qtest.Logger(t), runner, token)))
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRemoteRunner(t *testing.T) {
+	bindDir, testsDir := t.TempDir(), t.TempDir()
+	writeFile(t, filepath.Join(bindDir, "user-labs", "lab1", "main.go"), "package main\n")
+	writeFile(t, filepath.Join(testsDir, "lab1", "run.sh"), "#image/quickfeed:go\n")
+	job := &ci.Job{
+		Name:           "remote-test",
+		BindDir:        bindDir,
+		ReadOnlyMounts: map[string]string{testsDir: "/quickfeed/tests"},
+		Limits:         jobLimits,
+		Network:        jobNetwork,
+		Services:       jobServices,
+	}
+	// output larger than a single output message, including multi-byte characters
+	longOutput := strings.Repeat("æøå", 20_000)
+
+	const token = "secret"
+	unavailable := httptest.NewServer(http.NotFoundHandler())
+	unavailable.Close()
+
+	tests := []struct {
+		name     string
+		workers  []string
+		token    string
+		wantOut  string
+		wantLive string
+		wantErr  error
+	}{
+		{
+			name:     "Success",
+			workers:  []string{newWorker(t, &fileRunner{output: longOutput}, token)},
+			token:    token,
+			wantOut:  "package main\n#image/quickfeed:go\n" + longOutput,
+			wantLive: longOutput,
+		},
+		{
+			name:     "SkipUnavailableWorker",
+			workers:  []string{unavailable.URL, newWorker(t, &fileRunner{output: "ok"}, token)},
+			token:    token,
+			wantOut:  "package main\n#image/quickfeed:go\nok",
+			wantLive: "ok",
+		},
+		{
+			name:    "Conflict",
+			workers: []string{newWorker(t, &fileRunner{err: ci.ErrConflict}, token)},
+			token:   token,
+			wantOut: "package main\n#image/quickfeed:go\n",
+			wantErr: ci.ErrConflict,
+		},
+		{
+			name:    "InvalidToken",
+			workers: []string{newWorker(t, &fileRunner{}, token)},
+			token:   "wrong",
+			wantErr: connect.NewError(connect.CodeUnauthenticated, errors.New("invalid runner token")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner, err := remote.NewRunner(qtest.Logger(t), tt.token, tt.workers...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var live bytes.Buffer
+			liveJob := *job
+			liveJob.Output = &live
+			out, err := runner.Run(t.Context(), &liveJob)
+			qtest.CheckError(t, err, tt.wantErr)
+			if out != tt.wantOut {
+				t.Errorf("Run() output mismatch: got %d bytes, want %d bytes", len(out), len(tt.wantOut))
+			}
+			if live.String() != tt.wantLive {
+				t.Errorf("Run() live output mismatch: got %d bytes, want %d bytes", live.Len(), len(tt.wantLive))
+			}
+		})
+	}
+}
+
+// resultsRunner is a fake runner that writes the given results to the job's results file.
+type resultsRunner struct {
+	results string
+}
+
+func (r *resultsRunner) Run(_ context.Context, job *ci.Job) (string, error) {
+	return "ok", job.WriteResultsFile([]byte(r.results))
+}
+
+func TestRemoteRunnerResultsFile(t *testing.T) {
+	// results larger than a single output message
+	results := "<testsuite>" + strings.Repeat(`<testcase name="TestA"/>`, 5_000) + "</testsuite>"
+	const token = "secret"
+	runner, err := remote.NewRunner(qtest.Logger(t), token, newWorker(t, &resultsRunner{results: results}, token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	job := &ci.Job{Name: "remote-results", BindDir: t.TempDir(), ReportsDir: t.TempDir(), ResultsFile: "reports/junit.xml"}
+	if _, err := runner.Run(t.Context(), job); err != nil {
+		t.Fatal(err)
+	}
+	got, err := job.ReadResultsFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != results {
+		t.Errorf("ReadResultsFile() = %d bytes, want %d bytes", len(got), len(results))
+	}
+}
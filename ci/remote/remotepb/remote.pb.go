@@ -0,0 +1,689 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ci/remote/remotepb/remote.proto
+
+package remotepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Job is a self-contained bundle of a ci.Job. It includes the content of
+// the host directories used by the job, since these are not available on the worker.
+type Job struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image          string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Language       string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	BuildContext   map[string]string      `protobuf:"bytes,4,rep,name=buildContext,proto3" json:"buildContext,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BindDir        *Directory             `protobuf:"bytes,5,opt,name=bindDir,proto3" json:"bindDir,omitempty"` // content of the directory bound to the container's /quickfeed directory
+	ReadOnlyMounts []*Directory           `protobuf:"bytes,6,rep,name=readOnlyMounts,proto3" json:"readOnlyMounts,omitempty"`
+	Env            []string               `protobuf:"bytes,7,rep,name=env,proto3" json:"env,omitempty"`
+	Commands       []string               `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	Limits         *Limits                `protobuf:"bytes,9,opt,name=limits,proto3" json:"limits,omitempty"`
+	Network        *Network               `protobuf:"bytes,10,opt,name=network,proto3" json:"network,omitempty"`
+	Services       []*Service             `protobuf:"bytes,11,rep,name=services,proto3" json:"services,omitempty"`
+	LiveOutput     bool                   `protobuf:"varint,12,opt,name=liveOutput,proto3" json:"liveOutput,omitempty"`                                                                        // forward the job's output while the job is running
+	ResultsFile    string                 `protobuf:"bytes,13,opt,name=resultsFile,proto3" json:"resultsFile,omitempty"`                                                                       // slash-separated path of the results file, relative to the reports directory
+	CacheDirs      map[string]string      `protobuf:"bytes,14,rep,name=cacheDirs,proto3" json:"cacheDirs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // container path -> host cache directory, relative to the worker's home directory
+	CoverageFile   string                 `protobuf:"bytes,15,opt,name=coverageFile,proto3" json:"coverageFile,omitempty"`                                                                     // slash-separated path of the coverage file, relative to the reports directory
+	LintFile       string                 `protobuf:"bytes,16,opt,name=lintFile,proto3" json:"lintFile,omitempty"`                                                                             // slash-separated path of the lint file, relative to the reports directory
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_ci_remote_remotepb_remote_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Job) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Job) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Job) GetBuildContext() map[string]string {
+	if x != nil {
+		return x.BuildContext
+	}
+	return nil
+}
+
+func (x *Job) GetBindDir() *Directory {
+	if x != nil {
+		return x.BindDir
+	}
+	return nil
+}
+
+func (x *Job) GetReadOnlyMounts() []*Directory {
+	if x != nil {
+		return x.ReadOnlyMounts
+	}
+	return nil
+}
+
+func (x *Job) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Job) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *Job) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Job) GetNetwork() *Network {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *Job) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *Job) GetLiveOutput() bool {
+	if x != nil {
+		return x.LiveOutput
+	}
+	return false
+}
+
+func (x *Job) GetResultsFile() string {
+	if x != nil {
+		return x.ResultsFile
+	}
+	return ""
+}
+
+func (x *Job) GetCacheDirs() map[string]string {
+	if x != nil {
+		return x.CacheDirs
+	}
+	return nil
+}
+
+func (x *Job) GetCoverageFile() string {
+	if x != nil {
+		return x.CoverageFile
+	}
+	return ""
+}
+
+func (x *Job) GetLintFile() string {
+	if x != nil {
+		return x.LintFile
+	}
+	return ""
+}
+
+// Service describes a sidecar service container for the job; see ci.Service.
+type Service struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Env           []string               `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_ci_remote_remotepb_remote_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Service) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Service) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Service) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+// Network holds the job's network policy; see ci.NetworkPolicy.
+type Network struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Allow         []string               `protobuf:"bytes,2,rep,name=allow,proto3" json:"allow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Network) Reset() {
+	*x = Network{}
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Network) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Network) ProtoMessage() {}
+
+func (x *Network) ProtoReflect() protoreflect.Message {
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Network.ProtoReflect.Descriptor instead.
+func (*Network) Descriptor() ([]byte, []int) {
+	return file_ci_remote_remotepb_remote_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Network) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Network) GetAllow() []string {
+	if x != nil {
+		return x.Allow
+	}
+	return nil
+}
+
+// Limits holds the job's resource limits; zero values mean no limit.
+type Limits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memory        int64                  `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`     // bytes
+	NanoCPUs      int64                  `protobuf:"varint,2,opt,name=nanoCPUs,proto3" json:"nanoCPUs,omitempty"` // CPU quota in units of 1e-9 CPUs
+	Pids          int64                  `protobuf:"varint,3,opt,name=pids,proto3" json:"pids,omitempty"`         // maximum number of processes
+	FileSize      int64                  `protobuf:"varint,4,opt,name=fileSize,proto3" json:"fileSize,omitempty"` // maximum size of files written, in bytes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Limits) Reset() {
+	*x = Limits{}
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limits) ProtoMessage() {}
+
+func (x *Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
+func (*Limits) Descriptor() ([]byte, []int) {
+	return file_ci_remote_remotepb_remote_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Limits) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Limits) GetNanoCPUs() int64 {
+	if x != nil {
+		return x.NanoCPUs
+	}
+	return 0
+}
+
+func (x *Limits) GetPids() int64 {
+	if x != nil {
+		return x.Pids
+	}
+	return 0
+}
+
+func (x *Limits) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+// Directory holds the regular files of a host directory.
+type Directory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"` // mount point inside the container; not used for the bind directory
+	Files         []*File                `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Directory) Reset() {
+	*x = Directory{}
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Directory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Directory) ProtoMessage() {}
+
+func (x *Directory) ProtoReflect() protoreflect.Message {
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Directory.ProtoReflect.Descriptor instead.
+func (*Directory) Descriptor() ([]byte, []int) {
+	return file_ci_remote_remotepb_remote_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Directory) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Directory) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type File struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // slash-separated path relative to the directory
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Mode          uint32                 `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"` // permission bits
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_ci_remote_remotepb_remote_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *File) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *File) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *File) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+// Output is a chunk of the job's output.
+// Chunks may split multi-byte characters; hence, bytes are used instead of string.
+type Output struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        []byte                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	LiveOutput    []byte                 `protobuf:"bytes,2,opt,name=liveOutput,proto3" json:"liveOutput,omitempty"`     // output forwarded while the job is running; also included in the final output
+	ResultsFile   []byte                 `protobuf:"bytes,3,opt,name=resultsFile,proto3" json:"resultsFile,omitempty"`   // content of the job's results file, if any, sent when the job is done
+	CoverageFile  []byte                 `protobuf:"bytes,4,opt,name=coverageFile,proto3" json:"coverageFile,omitempty"` // content of the job's coverage file, if any, sent when the job is done
+	LintFile      []byte                 `protobuf:"bytes,5,opt,name=lintFile,proto3" json:"lintFile,omitempty"`         // content of the job's lint file, if any, sent when the job is done
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Output) Reset() {
+	*x = Output{}
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Output) ProtoMessage() {}
+
+func (x *Output) ProtoReflect() protoreflect.Message {
+	mi := &file_ci_remote_remotepb_remote_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Output.ProtoReflect.Descriptor instead.
+func (*Output) Descriptor() ([]byte, []int) {
+	return file_ci_remote_remotepb_remote_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Output) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *Output) GetLiveOutput() []byte {
+	if x != nil {
+		return x.LiveOutput
+	}
+	return nil
+}
+
+func (x *Output) GetResultsFile() []byte {
+	if x != nil {
+		return x.ResultsFile
+	}
+	return nil
+}
+
+func (x *Output) GetCoverageFile() []byte {
+	if x != nil {
+		return x.CoverageFile
+	}
+	return nil
+}
+
+func (x *Output) GetLintFile() []byte {
+	if x != nil {
+		return x.LintFile
+	}
+	return nil
+}
+
+var File_ci_remote_remotepb_remote_proto protoreflect.FileDescriptor
+
+const file_ci_remote_remotepb_remote_proto_rawDesc = "" +
+	"\n" +
+	"\x1fci/remote/remotepb/remote.proto\x12\x06remote\"\xdf\x05\n" +
+	"\x03Job\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12A\n" +
+	"\fbuildContext\x18\x04 \x03(\v2\x1d.remote.Job.BuildContextEntryR\fbuildContext\x12+\n" +
+	"\abindDir\x18\x05 \x01(\v2\x11.remote.DirectoryR\abindDir\x129\n" +
+	"\x0ereadOnlyMounts\x18\x06 \x03(\v2\x11.remote.DirectoryR\x0ereadOnlyMounts\x12\x10\n" +
+	"\x03env\x18\a \x03(\tR\x03env\x12\x1a\n" +
+	"\bcommands\x18\b \x03(\tR\bcommands\x12&\n" +
+	"\x06limits\x18\t \x01(\v2\x0e.remote.LimitsR\x06limits\x12)\n" +
+	"\anetwork\x18\n" +
+	" \x01(\v2\x0f.remote.NetworkR\anetwork\x12+\n" +
+	"\bservices\x18\v \x03(\v2\x0f.remote.ServiceR\bservices\x12\x1e\n" +
+	"\n" +
+	"liveOutput\x18\f \x01(\bR\n" +
+	"liveOutput\x12 \n" +
+	"\vresultsFile\x18\r \x01(\tR\vresultsFile\x128\n" +
+	"\tcacheDirs\x18\x0e \x03(\v2\x1a.remote.Job.CacheDirsEntryR\tcacheDirs\x12\"\n" +
+	"\fcoverageFile\x18\x0f \x01(\tR\fcoverageFile\x12\x1a\n" +
+	"\blintFile\x18\x10 \x01(\tR\blintFile\x1a?\n" +
+	"\x11BuildContextEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n" +
+	"\x0eCacheDirsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\aService\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12\x10\n" +
+	"\x03env\x18\x03 \x03(\tR\x03env\"3\n" +
+	"\aNetwork\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x14\n" +
+	"\x05allow\x18\x02 \x03(\tR\x05allow\"l\n" +
+	"\x06Limits\x12\x16\n" +
+	"\x06memory\x18\x01 \x01(\x03R\x06memory\x12\x1a\n" +
+	"\bnanoCPUs\x18\x02 \x01(\x03R\bnanoCPUs\x12\x12\n" +
+	"\x04pids\x18\x03 \x01(\x03R\x04pids\x12\x1a\n" +
+	"\bfileSize\x18\x04 \x01(\x03R\bfileSize\"G\n" +
+	"\tDirectory\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\"\n" +
+	"\x05files\x18\x02 \x03(\v2\f.remote.FileR\x05files\"H\n" +
+	"\x04File\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\rR\x04mode\"\xa2\x01\n" +
+	"\x06Output\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\fR\x06output\x12\x1e\n" +
+	"\n" +
+	"liveOutput\x18\x02 \x01(\fR\n" +
+	"liveOutput\x12 \n" +
+	"\vresultsFile\x18\x03 \x01(\fR\vresultsFile\x12\"\n" +
+	"\fcoverageFile\x18\x04 \x01(\fR\fcoverageFile\x12\x1a\n" +
+	"\blintFile\x18\x05 \x01(\fR\blintFile27\n" +
+	"\rRunnerService\x12&\n" +
+	"\x03Run\x12\v.remote.Job\x1a\x0e.remote.Output\"\x000\x01B3Z1github.com/quickfeed/quickfeed/ci/remote/remotepbb\x06proto3"
+
+var (
+	file_ci_remote_remotepb_remote_proto_rawDescOnce sync.Once
+	file_ci_remote_remotepb_remote_proto_rawDescData []byte
+)
+
+func file_ci_remote_remotepb_remote_proto_rawDescGZIP() []byte {
+	file_ci_remote_remotepb_remote_proto_rawDescOnce.Do(func() {
+		file_ci_remote_remotepb_remote_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ci_remote_remotepb_remote_proto_rawDesc), len(file_ci_remote_remotepb_remote_proto_rawDesc)))
+	})
+	return file_ci_remote_remotepb_remote_proto_rawDescData
+}
+
+var file_ci_remote_remotepb_remote_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ci_remote_remotepb_remote_proto_goTypes = []any{
+	(*Job)(nil),       // 0: remote.Job
+	(*Service)(nil),   // 1: remote.Service
+	(*Network)(nil),   // 2: remote.Network
+	(*Limits)(nil),    // 3: remote.Limits
+	(*Directory)(nil), // 4: remote.Directory
+	(*File)(nil),      // 5: remote.File
+	(*Output)(nil),    // 6: remote.Output
+	nil,               // 7: remote.Job.BuildContextEntry
+	nil,               // 8: remote.Job.CacheDirsEntry
+}
+var file_ci_remote_remotepb_remote_proto_depIdxs = []int32{
+	7, // 0: remote.Job.buildContext:type_name -> remote.Job.BuildContextEntry
+	4, // 1: remote.Job.bindDir:type_name -> remote.Directory
+	4, // 2: remote.Job.readOnlyMounts:type_name -> remote.Directory
+	3, // 3: remote.Job.limits:type_name -> remote.Limits
+	2, // 4: remote.Job.network:type_name -> remote.Network
+	1, // 5: remote.Job.services:type_name -> remote.Service
+	8, // 6: remote.Job.cacheDirs:type_name -> remote.Job.CacheDirsEntry
+	5, // 7: remote.Directory.files:type_name -> remote.File
+	0, // 8: remote.RunnerService.Run:input_type -> remote.Job
+	6, // 9: remote.RunnerService.Run:output_type -> remote.Output
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_ci_remote_remotepb_remote_proto_init() }
+func file_ci_remote_remotepb_remote_proto_init() {
+	if File_ci_remote_remotepb_remote_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ci_remote_remotepb_remote_proto_rawDesc), len(file_ci_remote_remotepb_remote_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ci_remote_remotepb_remote_proto_goTypes,
+		DependencyIndexes: file_ci_remote_remotepb_remote_proto_depIdxs,
+		MessageInfos:      file_ci_remote_remotepb_remote_proto_msgTypes,
+	}.Build()
+	File_ci_remote_remotepb_remote_proto = out.File
+	file_ci_remote_remotepb_remote_proto_goTypes = nil
+	file_ci_remote_remotepb_remote_proto_depIdxs = nil
+}
@@ -0,0 +1,110 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ci/remote/remotepb/remote.proto
+
+package remotepbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	remotepb "github.com/quickfeed/quickfeed/ci/remote/remotepb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RunnerServiceName is the fully-qualified name of the RunnerService service.
+	RunnerServiceName = "remote.RunnerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RunnerServiceRunProcedure is the fully-qualified name of the RunnerService's Run RPC.
+	RunnerServiceRunProcedure = "/remote.RunnerService/Run"
+)
+
+// RunnerServiceClient is a client for the remote.RunnerService service.
+type RunnerServiceClient interface {
+	// Run executes the given job and streams the job's output back to the caller.
+	Run(context.Context, *remotepb.Job) (*connect.ServerStreamForClient[remotepb.Output], error)
+}
+
+// NewRunnerServiceClient constructs a client for the remote.RunnerService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRunnerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RunnerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	runnerServiceMethods := remotepb.File_ci_remote_remotepb_remote_proto.Services().ByName("RunnerService").Methods()
+	return &runnerServiceClient{
+		run: connect.NewClient[remotepb.Job, remotepb.Output](
+			httpClient,
+			baseURL+RunnerServiceRunProcedure,
+			connect.WithSchema(runnerServiceMethods.ByName("Run")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// runnerServiceClient implements RunnerServiceClient.
+type runnerServiceClient struct {
+	run *connect.Client[remotepb.Job, remotepb.Output]
+}
+
+// Run calls remote.RunnerService.Run.
+func (c *runnerServiceClient) Run(ctx context.Context, req *remotepb.Job) (*connect.ServerStreamForClient[remotepb.Output], error) {
+	return c.run.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// RunnerServiceHandler is an implementation of the remote.RunnerService service.
+type RunnerServiceHandler interface {
+	// Run executes the given job and streams the job's output back to the caller.
+	Run(context.Context, *remotepb.Job, *connect.ServerStream[remotepb.Output]) error
+}
+
+// NewRunnerServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRunnerServiceHandler(svc RunnerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	runnerServiceMethods := remotepb.File_ci_remote_remotepb_remote_proto.Services().ByName("RunnerService").Methods()
+	runnerServiceRunHandler := connect.NewServerStreamHandlerSimple(
+		RunnerServiceRunProcedure,
+		svc.Run,
+		connect.WithSchema(runnerServiceMethods.ByName("Run")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/remote.RunnerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RunnerServiceRunProcedure:
+			runnerServiceRunHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRunnerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRunnerServiceHandler struct{}
+
+func (UnimplementedRunnerServiceHandler) Run(context.Context, *remotepb.Job, *connect.ServerStream[remotepb.Output]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("remote.RunnerService.Run is not implemented"))
+}
@@ -0,0 +1,119 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/ci/remote/remotepb"
+	"github.com/quickfeed/quickfeed/ci/remote/remotepb/remotepbconnect"
+	"go.uber.org/zap"
+)
+
+// Runner is an implementation of the ci.Runner interface that dispatches
+// jobs to remote workers. Jobs are distributed across the workers in
+// round-robin order; unavailable workers are skipped.
+type Runner struct {
+	logger  *zap.SugaredLogger
+	token   string
+	workers []worker
+	next    atomic.Uint64
+}
+
+type worker struct {
+	url    string
+	client remotepbconnect.RunnerServiceClient
+}
+
+// NewRunner returns a runner that dispatches jobs to the workers at the given URLs.
+// The token is used to authenticate with the workers.
+func NewRunner(logger *zap.SugaredLogger, token string, workerURLs ...string) (*Runner, error) {
+	if len(workerURLs) == 0 {
+		return nil, errors.New("no remote workers specified")
+	}
+	if token == "" {
+		return nil, errors.New("a token is required to authenticate with remote workers")
+	}
+	r := &Runner{logger: logger, token: token}
+	for _, url := range workerURLs {
+		r.workers = append(r.workers, worker{
+			url:    url,
+			client: remotepbconnect.NewRunnerServiceClient(http.DefaultClient, url),
+		})
+	}
+	return r, nil
+}
+
+// Run implements the CI interface. This method blocks until the job has been
+// completed by a remote worker or an error occurs, e.g., the context times out.
+func (r *Runner) Run(ctx context.Context, job *ci.Job) (string, error) {
+	remoteJob, err := newJob(job)
+	if err != nil {
+		return "", fmt.Errorf("failed to bundle job %s: %w", job.Name, err)
+	}
+	start := r.next.Add(1)
+	for i := range r.workers {
+		w := r.workers[(start+uint64(i))%uint64(len(r.workers))]
+		out, err := r.run(ctx, w, remoteJob, job)
+		if connect.CodeOf(err) == connect.CodeUnavailable && out == "" {
+			r.logger.Errorf("Worker %s unavailable for %s: %v", w.url, job.Name, err)
+			continue
+		}
+		return out, err
+	}
+	return "", fmt.Errorf("cannot run job: %s; no remote workers available", job.Name)
+}
+
+// run runs the job bundle on the given worker. Live output from the worker is written to the job's Output, if set,
+// and the results, coverage and lint files received from the worker, if any, are written to the job's bind directory.
+func (r *Runner) run(ctx context.Context, w worker, remoteJob *remotepb.Job, job *ci.Job) (string, error) {
+	r.logger.Infof("Dispatching %s to worker %s", remoteJob.GetName(), w.url)
+	ctx, callInfo := connect.NewClientContext(ctx)
+	callInfo.RequestHeader().Set(authHeader, "Bearer "+r.token)
+	stream, err := w.client.Run(ctx, remoteJob)
+	if err != nil {
+		return "", err
+	}
+	defer stream.Close()
+
+	var out strings.Builder
+	var results, coverage, lint bytes.Buffer
+	for stream.Receive() {
+		out.Write(stream.Msg().GetOutput())
+		results.Write(stream.Msg().GetResultsFile())
+		coverage.Write(stream.Msg().GetCoverageFile())
+		lint.Write(stream.Msg().GetLintFile())
+		if job.Output != nil && len(stream.Msg().GetLiveOutput()) > 0 {
+			// write errors are ignored; the final output is still returned
+			_, _ = job.Output.Write(stream.Msg().GetLiveOutput())
+		}
+	}
+	if results.Len() > 0 {
+		if err := job.WriteResultsFile(results.Bytes()); err != nil {
+			r.logger.Errorf("Failed to write results file for %s: %v", job.Name, err)
+		}
+	}
+	if coverage.Len() > 0 {
+		if err := job.WriteCoverageFile(coverage.Bytes()); err != nil {
+			r.logger.Errorf("Failed to write coverage file for %s: %v", job.Name, err)
+		}
+	}
+	if lint.Len() > 0 {
+		if err := job.WriteLintFile(lint.Bytes()); err != nil {
+			r.logger.Errorf("Failed to write lint file for %s: %v", job.Name, err)
+		}
+	}
+	if err := stream.Err(); err != nil {
+		if connect.CodeOf(err) == connect.CodeAborted {
+			return out.String(), ci.ErrConflict
+		}
+		return out.String(), err
+	}
+	return out.String(), nil
+}
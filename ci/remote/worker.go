@@ -0,0 +1,143 @@
+package remote
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"os"
+	"slices"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/ci/remote/remotepb"
+	"github.com/quickfeed/quickfeed/ci/remote/remotepb/remotepbconnect"
+	"go.uber.org/zap"
+)
+
+const (
+	authHeader = "Authorization"
+	// maxChunkSize is the maximum size of each output message sent to the runner.
+	maxChunkSize = 32 * 1024 // bytes
+)
+
+// Worker executes jobs received from a remote Runner using a local runner, e.g., ci.Docker.
+type Worker struct {
+	logger *zap.SugaredLogger
+	runner ci.Runner
+	token  string
+	remotepbconnect.UnimplementedRunnerServiceHandler
+}
+
+// NewWorker returns a worker that executes jobs using the given runner.
+// Requests must be authenticated with the given token.
+func NewWorker(logger *zap.SugaredLogger, runner ci.Runner, token string) *Worker {
+	return &Worker{
+		logger: logger,
+		runner: runner,
+		token:  token,
+	}
+}
+
+// Run executes the given job and streams the job's output back to the runner.
+func (w *Worker) Run(ctx context.Context, remoteJob *remotepb.Job, st *connect.ServerStream[remotepb.Output]) error {
+	if !w.authenticated(ctx) {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid runner token"))
+	}
+	baseDir, err := os.MkdirTemp("", "quickfeed-worker")
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	defer os.RemoveAll(baseDir)
+
+	job, err := unpackJob(remoteJob, baseDir)
+	if err != nil {
+		w.logger.Errorf("Failed to unpack job %s: %v", remoteJob.GetName(), err)
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if remoteJob.GetLiveOutput() {
+		job.Output = &liveOutput{stream: st}
+	}
+	w.logger.Infof("Running job %s", job.Name)
+	out, err := w.runner.Run(ctx, job)
+	for len(out) > 0 {
+		n := min(len(out), maxChunkSize)
+		if sendErr := st.Send(&remotepb.Output{Output: []byte(out[:n])}); sendErr != nil {
+			return sendErr
+		}
+		out = out[n:]
+	}
+	if job.ResultsFile != "" {
+		if sendErr := w.sendJobFile(job, "results", job.ReadResultsFile, func(chunk []byte) *remotepb.Output {
+			return &remotepb.Output{ResultsFile: chunk}
+		}, st); sendErr != nil {
+			return sendErr
+		}
+	}
+	if job.CoverageFile != "" {
+		if sendErr := w.sendJobFile(job, "coverage", job.ReadCoverageFile, func(chunk []byte) *remotepb.Output {
+			return &remotepb.Output{CoverageFile: chunk}
+		}, st); sendErr != nil {
+			return sendErr
+		}
+	}
+	if job.LintFile != "" {
+		if sendErr := w.sendJobFile(job, "lint", job.ReadLintFile, func(chunk []byte) *remotepb.Output {
+			return &remotepb.Output{LintFile: chunk}
+		}, st); sendErr != nil {
+			return sendErr
+		}
+	}
+	if err != nil {
+		w.logger.Errorf("Job %s failed: %v", job.Name, err)
+		if errors.Is(err, ci.ErrConflict) {
+			return connect.NewError(connect.CodeAborted, err)
+		}
+		return connect.NewError(connect.CodeUnknown, err)
+	}
+	return nil
+}
+
+// sendJobFile sends the content of the job's file of the given kind to the runner, if the job's tests wrote one.
+// The file's content is read with read, and each chunk of the content is wrapped in an output message with output.
+func (w *Worker) sendJobFile(job *ci.Job, kind string, read func() ([]byte, error), output func([]byte) *remotepb.Output, st *connect.ServerStream[remotepb.Output]) error {
+	content, err := read()
+	if err != nil {
+		// the runner reports the missing file
+		w.logger.Errorf("Failed to read %s file for job %s: %v", kind, job.Name, err)
+		return nil
+	}
+	for chunk := range slices.Chunk(content, maxChunkSize) {
+		if err := st.Send(output(chunk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authenticated returns true if the request's authorization header holds the worker's token.
+func (w *Worker) authenticated(ctx context.Context) bool {
+	callInfo, ok := connect.CallInfoForHandlerContext(ctx)
+	if !ok {
+		return false
+	}
+	auth := callInfo.RequestHeader().Get(authHeader)
+	return subtle.ConstantTimeCompare([]byte(auth), []byte("Bearer "+w.token)) == 1
+}
+
+// liveOutput is an io.Writer that forwards a running job's output to the runner.
+type liveOutput struct {
+	mu     sync.Mutex
+	stream *connect.ServerStream[remotepb.Output]
+}
+
+func (l *liveOutput) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for chunk := range slices.Chunk(p, maxChunkSize) {
+		if err := l.stream.Send(&remotepb.Output{LiveOutput: chunk}); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
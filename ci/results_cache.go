@@ -0,0 +1,122 @@
+package ci
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	cacheExt = ".json"
+	// cachedResultsMsg is appended to the build log of results reused from the cache.
+	cachedResultsMsg = "\n\n*** Results reused from a previous test run of the same commit and tests ***\n"
+)
+
+// ResultsCache stores the results of test runs on disk, such that rebuilds and duplicate pushes
+// need not run the tests again when neither the student's commit, the tests, nor the assignments have changed.
+// Results are stored in files named <dir>/<assignment ID>/<key digest>.json,
+// holding the build info and scores in the JSON format of a submission.
+type ResultsCache struct {
+	dir string
+	// retention is the duration to keep cached results; zero means forever.
+	retention time.Duration
+}
+
+// NewResultsCache returns a results cache storing results below the given directory.
+// Results older than the given retention are removed by Prune; zero retention keeps results forever.
+func NewResultsCache(dir string, retention time.Duration) *ResultsCache {
+	return &ResultsCache{dir: dir, retention: retention}
+}
+
+// cacheKey identifies the inputs of a test run that determine its results.
+type cacheKey struct {
+	assignmentID        uint64
+	commitID            string // the student's commit
+	testsCommitID       string // the tests repository's commit
+	assignmentsCommitID string // the assignments repository's commit
+	dockerfileDigest    string // the course's Dockerfile digest
+}
+
+// path returns the path of the cached results for the key.
+func (c *ResultsCache) path(key cacheKey) string {
+	digest := sha256.Sum256([]byte(key.commitID + "\n" + key.testsCommitID + "\n" + key.assignmentsCommitID + "\n" + key.dockerfileDigest))
+	return filepath.Join(c.dir, strconv.FormatUint(key.assignmentID, 10), fmt.Sprintf("%x", digest)+cacheExt)
+}
+
+// Get returns the cached results for the given key, or false if there are no cached results.
+// The returned results have new build and submission dates, and a note in the build log.
+func (c *ResultsCache) Get(key cacheKey) (*score.Results, bool) {
+	content, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	cached := &qf.Submission{}
+	if err := protojson.Unmarshal(content, cached); err != nil {
+		return nil, false
+	}
+	buildInfo := cached.GetBuildInfo()
+	if buildInfo == nil {
+		buildInfo = &score.BuildInfo{}
+	}
+	buildInfo.BuildDate = timestamppb.Now()
+	buildInfo.SubmissionDate = timestamppb.Now()
+	buildInfo.BuildLog += cachedResultsMsg
+	return &score.Results{BuildInfo: buildInfo, Scores: cached.GetScores()}, true
+}
+
+// Put stores the given results for the given key, replacing any previously cached results.
+func (c *ResultsCache) Put(key cacheKey, results *score.Results) error {
+	content, err := protojson.Marshal(&qf.Submission{BuildInfo: results.BuildInfo, Scores: results.Scores})
+	if err != nil {
+		return err
+	}
+	path := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	// write to a temporary file first, such that a concurrent Get never sees partial results
+	tmp, err := os.CreateTemp(filepath.Dir(path), "tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Prune removes cached results older than the cache's retention, relative to the given time.
+// It returns the number of results removed.
+func (c *ResultsCache) Prune(now time.Time) (int, error) {
+	if c.retention == 0 {
+		return 0, nil
+	}
+	return pruneFiles(c.dir, now.Add(-c.retention))
+}
+
+// headCommit returns the commit ID of the HEAD of the git repository in the given directory.
+func headCommit(repoDir string) (string, error) {
+	repo, err := git.PlainOpen(repoDir)
+	if err != nil {
+		return "", err
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+	return head.Hash().String(), nil
+}
@@ -0,0 +1,123 @@
+package ci
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestResultsCache(t *testing.T) {
+	cache := NewResultsCache(t.TempDir(), 0)
+	key := cacheKey{assignmentID: 1, commitID: "a1b2c3", testsCommitID: "d4e5f6", assignmentsCommitID: "0a1b2c", dockerfileDigest: "abcdef"}
+	if _, ok := cache.Get(key); ok {
+		t.Fatal("Get() found results in empty cache")
+	}
+	results := &score.Results{
+		BuildInfo: &score.BuildInfo{BuildLog: "ok", ExecTime: 1234},
+		Scores: []*score.Score{
+			{TestName: "TestA", Score: 5, MaxScore: 10, Weight: 1},
+			{TestName: "TestB", Score: 10, MaxScore: 10, Weight: 2},
+		},
+	}
+	if err := cache.Put(key, results); err != nil {
+		t.Fatal(err)
+	}
+	got, ok := cache.Get(key)
+	if !ok {
+		t.Fatal("Get() found no cached results")
+	}
+	if diff := cmp.Diff(results.Scores, got.Scores, protocmp.Transform()); diff != "" {
+		t.Errorf("Get() scores mismatch (-want +got):\n%s", diff)
+	}
+	if got.Sum() != results.Sum() {
+		t.Errorf("Get() score = %d, want %d", got.Sum(), results.Sum())
+	}
+	buildInfo := got.GetBuildInfo()
+	if buildInfo.GetExecTime() != 1234 || !strings.HasPrefix(buildInfo.GetBuildLog(), "ok") || !strings.HasSuffix(buildInfo.GetBuildLog(), cachedResultsMsg) {
+		t.Errorf("Get() build info = %v, want the cached build info with a note in the build log", buildInfo)
+	}
+	if buildInfo.GetBuildDate() == nil || buildInfo.GetSubmissionDate() == nil {
+		t.Error("Get() build info has no build or submission date")
+	}
+
+	// changing any part of the key must miss the cache
+	for _, other := range []cacheKey{
+		{assignmentID: 2, commitID: "a1b2c3", testsCommitID: "d4e5f6", assignmentsCommitID: "0a1b2c", dockerfileDigest: "abcdef"},
+		{assignmentID: 1, commitID: "a1b2c4", testsCommitID: "d4e5f6", assignmentsCommitID: "0a1b2c", dockerfileDigest: "abcdef"},
+		{assignmentID: 1, commitID: "a1b2c3", testsCommitID: "d4e5f7", assignmentsCommitID: "0a1b2c", dockerfileDigest: "abcdef"},
+		{assignmentID: 1, commitID: "a1b2c3", testsCommitID: "d4e5f6", assignmentsCommitID: "0a1b2d", dockerfileDigest: "abcdef"},
+		{assignmentID: 1, commitID: "a1b2c3", testsCommitID: "d4e5f6", assignmentsCommitID: "0a1b2c", dockerfileDigest: ""},
+	} {
+		if _, ok := cache.Get(other); ok {
+			t.Errorf("Get(%+v) found results cached for %+v", other, key)
+		}
+	}
+}
+
+func TestResultsCachePrune(t *testing.T) {
+	cache := NewResultsCache(t.TempDir(), 24*time.Hour)
+	oldKey := cacheKey{assignmentID: 1, commitID: "old"}
+	newKey := cacheKey{assignmentID: 1, commitID: "new"}
+	now := time.Now()
+	for key, age := range map[cacheKey]time.Duration{oldKey: 48 * time.Hour, newKey: time.Hour} {
+		if err := cache.Put(key, &score.Results{}); err != nil {
+			t.Fatal(err)
+		}
+		modTime := now.Add(-age)
+		if err := os.Chtimes(cache.path(key), modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	removed, err := cache.Prune(now)
+	if err != nil || removed != 1 {
+		t.Errorf("Prune() = %d, %v, want 1 result removed", removed, err)
+	}
+	if _, ok := cache.Get(oldKey); ok {
+		t.Error("Get() found expired results")
+	}
+	if _, ok := cache.Get(newKey); !ok {
+		t.Error("Get() found no results for unexpired key")
+	}
+}
+
+func TestHeadCommit(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := headCommit(dir); err == nil {
+		t.Error("headCommit() succeeded for a directory that is not a git repository")
+	}
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := worktree.Add("main.go"); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := worktree.Commit("initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "student", Email: "student@example.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := headCommit(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != hash.String() {
+		t.Errorf("headCommit() = %q, want %q", got, hash.String())
+	}
+}
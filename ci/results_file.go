@@ -0,0 +1,337 @@
+package ci
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+)
+
+// Supported results file formats for the #results/ directive.
+const (
+	formatJUnit      = "junit"
+	formatTAP        = "tap"
+	formatPytestJSON = "pytest-json"
+)
+
+const (
+	// maxJobFileSize is the maximum size of a results or coverage file.
+	maxJobFileSize = 10 << 20 // bytes
+	// maxTestDetailsSize is the maximum size of the failure details recorded for a test.
+	maxTestDetailsSize = 2000 // bytes
+)
+
+// jobFile describes a file in which a job's tests write a report, such as their results or coverage.
+type jobFile struct {
+	kind   string // the kind of report, e.g., results or coverage
+	format string
+	path   string // slash-separated path relative to the job's reports directory
+}
+
+// parseResultsFile returns the results file given by the #results/ directive,
+// e.g., #results/junit reports/junit.xml.
+func parseResultsFile(directive string) (*jobFile, error) {
+	return parseJobFile("results", directive, formatJUnit, formatTAP, formatPytestJSON)
+}
+
+// parseJobFile returns the job file of the given kind given by a directive of the form
+// <format> <path>, where format must be one of the given formats.
+func parseJobFile(kind, directive string, formats ...string) (*jobFile, error) {
+	format, path, _ := strings.Cut(strings.TrimSpace(directive), " ")
+	format, path = strings.ToLower(format), strings.TrimSpace(path)
+	if !slices.Contains(formats, format) {
+		return nil, fmt.Errorf("unknown %s format: %q", kind, format)
+	}
+	if path == "" || !filepath.IsLocal(filepath.FromSlash(path)) {
+		return nil, fmt.Errorf("invalid %s file path: %q; must be relative to the reports directory", kind, path)
+	}
+	return &jobFile{kind: kind, format: format, path: filepath.ToSlash(filepath.Clean(path))}, nil
+}
+
+// ReadResultsFile returns the content of the results file written by the job's tests.
+func (j *Job) ReadResultsFile() ([]byte, error) {
+	return j.readFile("results", j.ResultsFile)
+}
+
+// WriteResultsFile writes the given content to the job's results file below the job's reports directory.
+// Runners that do not run the job on this host use this method to make the results file available.
+func (j *Job) WriteResultsFile(content []byte) error {
+	return j.writeFile("results", j.ResultsFile, content)
+}
+
+// readFile returns the content of the job's file of the given kind at the given path relative to the reports directory.
+// The file must be a regular file; symbolic links and other files that the job's code may have created are rejected.
+func (j *Job) readFile(kind, path string) ([]byte, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no %s file specified", kind)
+	}
+	if j.ReportsDir == "" || !filepath.IsLocal(filepath.FromSlash(path)) {
+		return nil, fmt.Errorf("invalid %s file path: %q", kind, path)
+	}
+	root, err := os.OpenRoot(j.ReportsDir)
+	if err != nil {
+		return nil, err
+	}
+	defer root.Close()
+	// os.Root rejects paths that escape the reports directory
+	info, err := root.Lstat(filepath.FromSlash(path))
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s file %q is not a regular file", kind, path)
+	}
+	f, err := root.Open(filepath.FromSlash(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	content, err := io.ReadAll(io.LimitReader(f, maxJobFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxJobFileSize {
+		return nil, fmt.Errorf("%s file %q exceeds %d bytes", kind, path, maxJobFileSize)
+	}
+	return content, nil
+}
+
+// writeFile writes the given content to the job's file of the given kind at the given path relative to the reports directory.
+func (j *Job) writeFile(kind, path string, content []byte) error {
+	if j.ReportsDir == "" || !filepath.IsLocal(filepath.FromSlash(path)) {
+		return fmt.Errorf("invalid %s file path: %q", kind, path)
+	}
+	root, err := os.OpenRoot(j.ReportsDir)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+	path = filepath.FromSlash(path)
+	if dir := filepath.Dir(path); dir != "." {
+		if err := root.MkdirAll(dir, 0o700); err != nil {
+			return err
+		}
+	}
+	return root.WriteFile(path, content, 0o600)
+}
+
+// applyResultsFile updates the given results with the test results in the job's results file.
+func applyResultsFile(job *Job, results *score.Results) error {
+	content, err := job.ReadResultsFile()
+	if err != nil {
+		return err
+	}
+	tests, err := parseTestResults(job.resultsFormat, content)
+	if err != nil {
+		return err
+	}
+	applyTestResults(results, tests)
+	return nil
+}
+
+// testResult is the outcome of a single test found in a results file.
+type testResult struct {
+	names   []string // names that identify the test in tests.json, most specific first
+	passed  bool
+	details string
+}
+
+// parseTestResults returns the test results found in the given results file content.
+func parseTestResults(format string, content []byte) ([]testResult, error) {
+	switch format {
+	case formatJUnit:
+		return parseJUnit(content)
+	case formatTAP:
+		return parseTAP(content)
+	case formatPytestJSON:
+		return parsePytestJSON(content)
+	}
+	return nil, fmt.Errorf("unknown results format: %q", format)
+}
+
+// applyTestResults updates the scores of the expected tests with the given test results,
+// marking the tests found in the results as reported.
+// Since results files do not hold scores, a test receives its max score if it passed, and zero otherwise.
+// A test that appears several times in the results, e.g., a parameterized test, passes only if all runs passed.
+// Tests that are not expected, i.e., not listed in the assignment's tests.json, are ignored.
+func applyTestResults(results *score.Results, tests []testResult) {
+	for _, sc := range results.Scores {
+		found, passed := false, true
+		var details []string
+		for _, test := range tests {
+			if !slices.Contains(test.names, sc.GetTestName()) {
+				continue
+			}
+			found = true
+			passed = passed && test.passed
+			if test.details != "" {
+				details = append(details, test.details)
+			}
+		}
+		if !found {
+			continue
+		}
+		sc.Status = score.Score_REPORTED
+		sc.Fail()
+		if passed {
+			sc.SetPoints(float64(sc.GetMaxScore()))
+		}
+		sc.TestDetails = truncateDetails(strings.Join(details, "\n"))
+	}
+}
+
+func truncateDetails(details string) string {
+	if len(details) > maxTestDetailsSize {
+		return strings.ToValidUTF8(details[:maxTestDetailsSize], "") + "..."
+	}
+	return details
+}
+
+// junitSuite holds a JUnit XML <testsuites> or <testsuite> element.
+type junitSuite struct {
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string         `xml:"name,attr"`
+	ClassName string         `xml:"classname,attr"`
+	Failures  []junitFailure `xml:"failure"`
+	Errors    []junitFailure `xml:"error"`
+	Skipped   *struct{}      `xml:"skipped"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// parseJUnit returns the test results in the given JUnit XML report.
+// A test case is identified by <classname>.<name>, or by its name alone.
+func parseJUnit(content []byte) ([]testResult, error) {
+	var root junitSuite
+	if err := xml.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse JUnit XML: %w", err)
+	}
+	var tests []testResult
+	var collect func(suite junitSuite)
+	collect = func(suite junitSuite) {
+		for _, tc := range suite.Cases {
+			test := testResult{
+				names:  []string{tc.Name},
+				passed: len(tc.Failures) == 0 && len(tc.Errors) == 0 && tc.Skipped == nil,
+			}
+			if tc.ClassName != "" {
+				test.names = []string{tc.ClassName + "." + tc.Name, tc.Name}
+			}
+			var details []string
+			for _, failure := range slices.Concat(tc.Failures, tc.Errors) {
+				details = append(details, strings.TrimSpace(failure.Message+"\n"+failure.Text))
+			}
+			test.details = strings.Join(details, "\n")
+			tests = append(tests, test)
+		}
+		for _, s := range suite.Suites {
+			collect(s)
+		}
+	}
+	collect(root)
+	return tests, nil
+}
+
+// tapTestLine matches TAP test lines, e.g., "not ok 2 - adds numbers # SKIP no adder".
+var tapTestLine = regexp.MustCompile(`^(not )?ok\b\s*(\d*)\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(\w*).*)?$`)
+
+// parseTAP returns the test results in the given TAP output.
+// A test is identified by its description, or by its number if it has no description.
+// Skipped and TODO tests do not pass. Indented lines following a test line,
+// such as YAML diagnostics and subtests, are recorded as the test's details if the test failed.
+func parseTAP(content []byte) ([]testResult, error) {
+	var tests []testResult
+	var details []string
+	flush := func() {
+		if n := len(tests); n > 0 && !tests[n-1].passed {
+			tests[n-1].details = strings.TrimSpace(strings.Join(details, "\n"))
+		}
+		details = nil
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, maxJobFileSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line != "" && (line[0] == ' ' || line[0] == '\t') {
+			details = append(details, strings.TrimSpace(line))
+			continue
+		}
+		m := tapTestLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		flush()
+		name := m[3]
+		if name == "" {
+			name = m[2]
+		}
+		directive := strings.ToUpper(m[4])
+		tests = append(tests, testResult{
+			names:  []string{name},
+			passed: m[1] == "" && directive != "SKIP" && directive != "TODO",
+		})
+	}
+	flush()
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse TAP output: %w", err)
+	}
+	return tests, nil
+}
+
+// pytestReport holds the tests of a report produced by the pytest-json-report plugin.
+type pytestReport struct {
+	Tests []struct {
+		NodeID  string      `json:"nodeid"`
+		Outcome string      `json:"outcome"`
+		Setup   *pytestCall `json:"setup"`
+		Call    *pytestCall `json:"call"`
+	} `json:"tests"`
+}
+
+type pytestCall struct {
+	LongRepr string `json:"longrepr"`
+}
+
+// parsePytestJSON returns the test results in the given pytest-json-report file.
+// A test is identified by its node ID, e.g., tests/test_add.py::test_add, or by the test function name.
+func parsePytestJSON(content []byte) ([]testResult, error) {
+	var report pytestReport
+	if err := json.Unmarshal(content, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse pytest JSON report: %w", err)
+	}
+	tests := make([]testResult, 0, len(report.Tests))
+	for _, t := range report.Tests {
+		test := testResult{
+			names:  []string{t.NodeID},
+			passed: t.Outcome == "passed",
+		}
+		if i := strings.LastIndex(t.NodeID, "::"); i >= 0 {
+			test.names = append(test.names, t.NodeID[i+2:])
+		}
+		if !test.passed {
+			for _, call := range []*pytestCall{t.Setup, t.Call} {
+				if call != nil && call.LongRepr != "" {
+					test.details = call.LongRepr
+				}
+			}
+		}
+		tests = append(tests, test)
+	}
+	return tests, nil
+}
@@ -0,0 +1,247 @@
+package ci
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestParseRunScriptResults(t *testing.T) {
+	tests := []struct {
+		name     string
+		script   string
+		want     *jobFile
+		commands []string
+		wantErr  bool
+	}{
+		{
+			name:     "NoResults",
+			script:   "#image/quickfeed:python\necho hello\necho world",
+			commands: []string{"echo hello", "echo world"},
+		},
+		{
+			name:     "JUnit",
+			script:   "#image/quickfeed:java\n#results/junit reports/junit.xml\ngradle test",
+			want:     &jobFile{kind: "results", format: formatJUnit, path: "reports/junit.xml"},
+			commands: []string{"gradle test"},
+		},
+		{
+			name:     "TAP",
+			script:   "#image/quickfeed:c\n#results/TAP  results.tap \nmake check",
+			want:     &jobFile{kind: "results", format: formatTAP, path: "results.tap"},
+			commands: []string{"make check"},
+		},
+		{
+			name:     "PytestJSON",
+			script:   "#image/quickfeed:python\n#results/pytest-json ./out/../report.json\npytest",
+			want:     &jobFile{kind: "results", format: formatPytestJSON, path: "report.json"},
+			commands: []string{"pytest"},
+		},
+		{name: "UnknownFormat", script: "#image/quickfeed:python\n#results/xunit report.xml\npytest", wantErr: true},
+		{name: "MissingPath", script: "#image/quickfeed:python\n#results/junit\npytest", wantErr: true},
+		{name: "AbsolutePath", script: "#image/quickfeed:python\n#results/junit /tmp/report.xml\npytest", wantErr: true},
+		{name: "OutsideHome", script: "#image/quickfeed:python\n#results/junit ../report.xml\npytest", wantErr: true},
+		{name: "Duplicate", script: "#image/quickfeed:python\n#results/junit a.xml\n#results/junit b.xml\npytest", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script, err := parseRunScript(tt.script)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRunScript() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, script.results, cmp.AllowUnexported(jobFile{})); diff != "" {
+				t.Errorf("parseRunScript() results mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.commands, script.commands); diff != "" {
+				t.Errorf("parseRunScript() commands mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseJUnit(t *testing.T) {
+	const report = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="CalculatorTest" tests="4">
+    <testcase classname="lab1.CalculatorTest" name="testAdd" time="0.01"/>
+    <testcase classname="lab1.CalculatorTest" name="testDivide">
+      <failure message="expected 2 but was 3" type="AssertionError">at CalculatorTest.java:21</failure>
+    </testcase>
+    <testcase classname="lab1.CalculatorTest" name="testSubtract">
+      <skipped/>
+    </testcase>
+    <testcase classname="lab1.CalculatorTest" name="testMultiply">
+      <error message="NullPointerException"/>
+    </testcase>
+  </testsuite>
+  <testsuite name="nested">
+    <testsuite name="inner">
+      <testcase name="test_inner"/>
+    </testsuite>
+  </testsuite>
+</testsuites>`
+	got, err := parseJUnit([]byte(report))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []testResult{
+		{names: []string{"lab1.CalculatorTest.testAdd", "testAdd"}, passed: true},
+		{names: []string{"lab1.CalculatorTest.testDivide", "testDivide"}, details: "expected 2 but was 3\nat CalculatorTest.java:21"},
+		{names: []string{"lab1.CalculatorTest.testSubtract", "testSubtract"}},
+		{names: []string{"lab1.CalculatorTest.testMultiply", "testMultiply"}, details: "NullPointerException"},
+		{names: []string{"test_inner"}, passed: true},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(testResult{})); diff != "" {
+		t.Errorf("parseJUnit() mismatch (-want +got):\n%s", diff)
+	}
+
+	// a single <testsuite> root element
+	got, err = parseJUnit([]byte(`<testsuite><testcase classname="c" name="t"/></testsuite>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []testResult{{names: []string{"c.t", "t"}, passed: true}}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(testResult{})); diff != "" {
+		t.Errorf("parseJUnit() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := parseJUnit([]byte("not xml")); err == nil {
+		t.Error("parseJUnit() succeeded for invalid XML")
+	}
+}
+
+func TestParseTAP(t *testing.T) {
+	const output = `TAP version 13
+1..6
+ok 1 - test_add
+not ok 2 - test_divide
+  ---
+  message: 'expected 2, got 3'
+  ...
+ok 3 - test_subtract # SKIP not implemented
+not ok 4 - test_multiply # TODO later
+ok 5
+# diagnostics are ignored
+not ok 6 test_modulo
+    not ok 1 - subtest
+`
+	got, err := parseTAP([]byte(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []testResult{
+		{names: []string{"test_add"}, passed: true},
+		{names: []string{"test_divide"}, details: "---\nmessage: 'expected 2, got 3'\n..."},
+		{names: []string{"test_subtract"}},
+		{names: []string{"test_multiply"}},
+		{names: []string{"5"}, passed: true},
+		{names: []string{"test_modulo"}, details: "not ok 1 - subtest"},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(testResult{})); diff != "" {
+		t.Errorf("parseTAP() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParsePytestJSON(t *testing.T) {
+	const report = `{
+  "created": 1700000000.0,
+  "summary": {"passed": 1, "failed": 2, "total": 4},
+  "tests": [
+    {"nodeid": "tests/test_calc.py::test_add", "outcome": "passed", "call": {"outcome": "passed"}},
+    {"nodeid": "tests/test_calc.py::test_divide", "outcome": "failed", "call": {"outcome": "failed", "longrepr": "assert 3 == 2"}},
+    {"nodeid": "tests/test_calc.py::test_fixture", "outcome": "error", "setup": {"outcome": "failed", "longrepr": "fixture 'db' not found"}},
+    {"nodeid": "tests/test_calc.py::test_skip", "outcome": "skipped"}
+  ]
+}`
+	got, err := parsePytestJSON([]byte(report))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []testResult{
+		{names: []string{"tests/test_calc.py::test_add", "test_add"}, passed: true},
+		{names: []string{"tests/test_calc.py::test_divide", "test_divide"}, details: "assert 3 == 2"},
+		{names: []string{"tests/test_calc.py::test_fixture", "test_fixture"}, details: "fixture 'db' not found"},
+		{names: []string{"tests/test_calc.py::test_skip", "test_skip"}},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(testResult{})); diff != "" {
+		t.Errorf("parsePytestJSON() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := parsePytestJSON([]byte("{")); err == nil {
+		t.Error("parsePytestJSON() succeeded for invalid JSON")
+	}
+}
+
+func TestApplyTestResults(t *testing.T) {
+	results := &score.Results{
+		Scores: []*score.Score{
+			{TestName: "test_add", MaxScore: 10, Weight: 1, Status: score.Score_MISSING},
+			{TestName: "lab1.CalculatorTest.testDivide", MaxScore: 20, Weight: 2, Status: score.Score_MISSING},
+			{TestName: "test_param", MaxScore: 5, Weight: 1, Status: score.Score_MISSING},
+			{TestName: "test_missing", MaxScore: 5, Weight: 1, Status: score.Score_MISSING},
+		},
+	}
+	applyTestResults(results, []testResult{
+		{names: []string{"tests/test_calc.py::test_add", "test_add"}, passed: true},
+		{names: []string{"lab1.CalculatorTest.testDivide", "testDivide"}, details: strings.Repeat("x", maxTestDetailsSize+1)},
+		{names: []string{"test_param"}, passed: true},
+		{names: []string{"test_param"}, details: "failed for input 2"},
+		{names: []string{"test_unexpected"}, passed: true},
+	})
+	want := []*score.Score{
+		{TestName: "test_add", Score: 10, MaxScore: 10, Weight: 1},
+		{TestName: "lab1.CalculatorTest.testDivide", MaxScore: 20, Weight: 2, TestDetails: strings.Repeat("x", maxTestDetailsSize) + "..."},
+		{TestName: "test_param", MaxScore: 5, Weight: 1, TestDetails: "failed for input 2"},
+		{TestName: "test_missing", MaxScore: 5, Weight: 1, Status: score.Score_MISSING},
+	}
+	if diff := cmp.Diff(want, results.Scores, protocmp.Transform()); diff != "" {
+		t.Errorf("applyTestResults() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadResultsFile(t *testing.T) {
+	reportsDir, outsideDir := t.TempDir(), t.TempDir()
+	if err := os.WriteFile(filepath.Join(reportsDir, "junit.xml"), []byte("<testsuite/>"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(outsideDir, "secret.txt"), []byte("secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(outsideDir, "secret.txt"), filepath.Join(reportsDir, "outside.xml")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("junit.xml", filepath.Join(reportsDir, "inside.xml")); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		job     *Job
+		want    string
+		wantErr bool
+	}{
+		{name: "RegularFile", job: &Job{ReportsDir: reportsDir, ResultsFile: "junit.xml"}, want: "<testsuite/>"},
+		{name: "Missing", job: &Job{ReportsDir: reportsDir, ResultsFile: "missing.xml"}, wantErr: true},
+		{name: "SymlinkOutside", job: &Job{ReportsDir: reportsDir, ResultsFile: "outside.xml"}, wantErr: true},
+		{name: "SymlinkInside", job: &Job{ReportsDir: reportsDir, ResultsFile: "inside.xml"}, wantErr: true},
+		{name: "Escape", job: &Job{ReportsDir: reportsDir, ResultsFile: "../secret.txt"}, wantErr: true},
+		{name: "NoReportsDir", job: &Job{ResultsFile: "junit.xml"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.job.ReadResultsFile()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadResultsFile() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("ReadResultsFile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -18,7 +19,10 @@ import (
 )
 
 // pattern to prefix the tmp folder for quickfeed tests
-const quickfeedTestsPath = "quickfeed-tests"
+const (
+	quickfeedTestsPath   = "quickfeed-tests"
+	quickfeedReportsPath = "quickfeed-reports"
+)
 
 // RunData stores CI data
 type RunData struct {
@@ -26,10 +30,25 @@ type RunData struct {
 	Assignment *qf.Assignment
 	Repo       *qf.Repository
 	EnvVarsFn  func(secret, homeDir string) []string
+	// OutputFn, if set, receives the test output while the tests are running, without score lines.
+	OutputFn func(output string)
+	// Archive, if set, stores the complete test output once the results are recorded.
+	Archive *LogArchive
+	// Cache, if set, stores the results of test runs, and provides the results of
+	// previous test runs of the same student commit and tests.
+	Cache      *ResultsCache
 	BranchName string
 	CommitID   string
 	JobOwner   string
 	Rebuild    bool
+	// Force runs the tests even if the cache holds results for the same student commit and tests.
+	Force bool
+	// TestsBranch, if set, is the branch of the tests repository to run instead of the course's current tests.
+	TestsBranch string
+	// Solution runs the tests in solution mode against the course's reference solution, held by Repo.
+	Solution       bool
+	testsBranchDir string // clone of the tests branch
+	archived       []byte // compressed test output to be archived
 }
 
 // String returns a string representation of the run data structure.
@@ -54,7 +73,8 @@ func (r *RunData) String() string {
 // and will be mounted as '/quickfeed' inside the container. This allows the docker container
 // to run the tests on the student code and manipulate the folders as needed for a particular
 // lab assignment's test requirements. The temporary directory is deleted when the container
-// exits at the end of this method.
+// exits at the end of this method. Another temporary directory, mounted as '/quickfeed-reports',
+// holds the results, coverage and lint files written by the tests.
 func (r *RunData) RunTests(ctx context.Context, logger *zap.SugaredLogger, sc scm.SCM, runner Runner) (*score.Results, error) {
 	testsStartedCounter.WithLabelValues(r.JobOwner, r.Course.GetCode()).Inc()
 
@@ -64,6 +84,25 @@ func (r *RunData) RunTests(ctx context.Context, logger *zap.SugaredLogger, sc sc
 	}
 	defer os.RemoveAll(dstDir)
 
+	// the tests' reports are written outside the directory holding the student's code
+	reportsDir, err := os.MkdirTemp("", quickfeedReportsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(reportsDir)
+
+	if r.TestsBranch != "" {
+		// clone the tests branch outside the directory mounted in the container
+		branchDir, err := os.MkdirTemp("", quickfeedTestsPath)
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(branchDir)
+		if r.testsBranchDir, err = r.cloneTestsBranch(ctx, sc, branchDir); err != nil {
+			return nil, err
+		}
+	}
+
 	logger.Debugf("Cloning repository for %s", r)
 	if err = r.clone(ctx, sc, dstDir); err != nil {
 		return nil, err
@@ -74,8 +113,19 @@ func (r *RunData) RunTests(ctx context.Context, logger *zap.SugaredLogger, sc sc
 		return nil, err
 	}
 
+	rev := r.revisions(logger)
+	key, cacheable := r.resultsCacheKey(logger, dstDir, rev)
+	if cacheable && !r.Force {
+		if results, ok := r.Cache.Get(key); ok {
+			logger.Debugf("Reusing cached results for %s", r)
+			testsCachedCounter.WithLabelValues(r.JobOwner, r.Course.GetCode()).Inc()
+			rev.record(results)
+			return results, nil
+		}
+	}
+
 	randomSecret := rand.String()
-	job, err := r.parseTestRunnerScript(randomSecret, dstDir)
+	job, err := r.parseTestRunnerScript(randomSecret, dstDir, reportsDir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse run script for assignment %s in %s: %w", r.Assignment.GetName(), r.Repo.GetTestURL(), err)
 	}
@@ -83,7 +133,29 @@ func (r *RunData) RunTests(ctx context.Context, logger *zap.SugaredLogger, sc sc
 	defer timer(r.JobOwner, r.Course.GetCode(), testExecutionTimeGauge)()
 	logger.Debugf("Running tests for %s", r)
 	start := time.Now()
+	var (
+		outputs    []io.Writer
+		liveOutput *liveLog
+		archived   *archiveLog
+	)
+	if r.OutputFn != nil {
+		liveOutput = newLiveLog(randomSecret, r.Assignment.HiddenTests(time.Now()), r.OutputFn)
+		outputs = append(outputs, liveOutput)
+	}
+	if r.Archive != nil {
+		archived = newArchiveLog()
+		outputs = append(outputs, archived)
+	}
+	if len(outputs) > 0 {
+		job.Output = io.MultiWriter(outputs...)
+	}
 	out, err := runner.Run(ctx, job)
+	if liveOutput != nil {
+		liveOutput.Close()
+	}
+	if archived != nil {
+		r.archived = archived.Bytes()
+	}
 	if err != nil && out == "" {
 		testsFailedCounter.WithLabelValues(r.JobOwner, r.Course.GetCode()).Inc()
 		if errors.Is(err, ErrConflict) {
@@ -95,6 +167,8 @@ func (r *RunData) RunTests(ctx context.Context, logger *zap.SugaredLogger, sc sc
 		// We may reach here with a timeout error and a non-empty output
 		testsFailedWithOutputCounter.WithLabelValues(r.JobOwner, r.Course.GetCode()).Inc()
 		logger.Errorf("Test execution failed with output: %v\n%v", err, out)
+		// don't cache results of failed test executions, e.g., timeouts, since they may not be reproducible
+		cacheable = false
 	}
 
 	results, err := score.ExtractResults(out, randomSecret, time.Since(start), r.Assignment.ZeroScoreTests())
@@ -104,6 +178,38 @@ func (r *RunData) RunTests(ctx context.Context, logger *zap.SugaredLogger, sc sc
 		logger.Debugf("Session secret: %s", randomSecret)
 		logger.Errorf("Failed to extract (some) results for assignment %s for course %s: %v", r.Assignment.GetName(), r.Course.GetName(), err)
 		// don't return here; we still want partial results!
+		cacheable = false
+	}
+	if job.ResultsFile != "" {
+		if err := applyResultsFile(job, results); err != nil {
+			logger.Errorf("Failed to read results file for %s: %v", r, err)
+			results.BuildInfo.BuildLog += fmt.Sprintf("\nFailed to read test results from %s", job.ResultsFile)
+			cacheable = false
+		}
+	}
+	// the coverage and lint findings are collected before failed tests are rerun, since reruns may overwrite the files
+	if job.CoverageFile != "" {
+		if err := applyCoverageFile(job, results); err != nil {
+			logger.Errorf("Failed to read coverage file for %s: %v", r, err)
+			results.BuildInfo.BuildLog += fmt.Sprintf("\nFailed to read code coverage from %s", job.CoverageFile)
+			cacheable = false
+		}
+	}
+	if job.LintFile != "" {
+		if err := applyLintFile(job, results); err != nil {
+			logger.Errorf("Failed to read lint file for %s: %v", r, err)
+			results.BuildInfo.BuildLog += fmt.Sprintf("\nFailed to read lint findings from %s", job.LintFile)
+			cacheable = false
+		}
+	}
+	if r.Assignment.GetTestRetries() > 0 {
+		r.rerunFailedTests(ctx, logger, runner, dstDir, reportsDir, results)
+	}
+	rev.record(results)
+	if cacheable {
+		if err := r.Cache.Put(key, results); err != nil {
+			logger.Errorf("Failed to cache results for %s: %v", r, err)
+		}
 	}
 
 	testsSucceededCounter.WithLabelValues(r.JobOwner, r.Course.GetCode()).Inc()
@@ -112,6 +218,91 @@ func (r *RunData) RunTests(ctx context.Context, logger *zap.SugaredLogger, sc sc
 	return results, nil
 }
 
+// revisions identifies the versions of the course's repositories and Dockerfile used in a test run.
+type revisions struct {
+	testsCommit       string
+	assignmentsCommit string
+	dockerfileDigest  string
+}
+
+// revisions returns the current revisions of the course's tests and assignments repositories
+// and Dockerfile. The commit of a repository is empty if it cannot be determined.
+func (r *RunData) revisions(logger *zap.SugaredLogger) revisions {
+	testsCommit, err := headCommit(r.testsDir())
+	if err != nil {
+		logger.Debugf("Failed to get the commit of the tests repository for %s: %v", r, err)
+	}
+	assignmentsCommit, err := headCommit(filepath.Join(r.Course.CloneDir(), qf.AssignmentsRepo))
+	if err != nil {
+		logger.Debugf("Failed to get the commit of the assignments repository for %s: %v", r, err)
+	}
+	return revisions{
+		testsCommit:       testsCommit,
+		assignmentsCommit: assignmentsCommit,
+		dockerfileDigest:  r.Course.GetDockerfileDigest(),
+	}
+}
+
+// record records the revisions in the build info of the given results.
+func (rev revisions) record(results *score.Results) {
+	if results.BuildInfo == nil {
+		results.BuildInfo = &score.BuildInfo{}
+	}
+	results.BuildInfo.TestsCommit = rev.testsCommit
+	results.BuildInfo.AssignmentsCommit = rev.assignmentsCommit
+	results.BuildInfo.DockerfileDigest = rev.dockerfileDigest
+}
+
+// resultsCacheKey returns the key identifying the test run's results in the cache, and
+// false if results cannot be cached, e.g., because caching is not enabled.
+// The key uses the commit of the cloned student repository in the given directory,
+// since the cloned commit may be more recent than the job's commit.
+func (r *RunData) resultsCacheKey(logger *zap.SugaredLogger, dstDir string, rev revisions) (cacheKey, bool) {
+	if r.Cache == nil || rev.testsCommit == "" {
+		return cacheKey{}, false
+	}
+	commitID, err := headCommit(filepath.Join(dstDir, r.Repo.Name()))
+	if err != nil {
+		logger.Debugf("Results for %s cannot be cached: %v", r, err)
+		return cacheKey{}, false
+	}
+	return cacheKey{
+		assignmentID:        r.Assignment.GetID(),
+		commitID:            commitID,
+		testsCommitID:       rev.testsCommit,
+		assignmentsCommitID: rev.assignmentsCommit,
+		dockerfileDigest:    rev.dockerfileDigest,
+	}, true
+}
+
+// testsDir returns the directory holding the tests to run: the clone of the tests branch,
+// if the run data specifies a branch, or otherwise the course's clone of the tests repository.
+func (r *RunData) testsDir() string {
+	if r.testsBranchDir != "" {
+		return r.testsBranchDir
+	}
+	return filepath.Join(r.Course.CloneDir(), qf.TestsRepo)
+}
+
+// cloneTestsBranch clones the tests branch into the given directory and returns the clone's path.
+func (r *RunData) cloneTestsBranch(ctx context.Context, sc scm.SCM, dstDir string) (string, error) {
+	clonedTestsRepo, err := sc.Clone(ctx, &scm.CloneOptions{
+		Organization: r.Course.GetScmOrganizationName(),
+		Repository:   qf.TestsRepo,
+		DestDir:      dstDir,
+		Branch:       r.TestsBranch,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to clone branch %q of %s/%s repository: %w", r.TestsBranch, r.Course.GetScmOrganizationName(), qf.TestsRepo, err)
+	}
+	return clonedTestsRepo, nil
+}
+
+// TestsCommit returns the current commit of the course's tests repository on the QuickFeed server.
+func TestsCommit(course *qf.Course) (string, error) {
+	return headCommit(filepath.Join(course.CloneDir(), qf.TestsRepo))
+}
+
 func (r *RunData) clone(ctx context.Context, sc scm.SCM, dstDir string) error {
 	defer timer(r.JobOwner, r.Course.GetCode(), cloneTimeGauge)()
 
@@ -134,9 +325,8 @@ func (r *RunData) clone(ctx context.Context, sc scm.SCM, dstDir string) error {
 
 	// Check that all repositories contains the current assignment
 	currentAssignment := r.Assignment.GetName()
-	testsDir := filepath.Join(r.Course.CloneDir(), qf.TestsRepo)
 	assignmentDir := filepath.Join(r.Course.CloneDir(), qf.AssignmentsRepo)
-	for _, repoDir := range []string{clonedStudentRepo, testsDir, assignmentDir} {
+	for _, repoDir := range []string{clonedStudentRepo, r.testsDir(), assignmentDir} {
 		if err := hasAssignment(repoDir, currentAssignment); err != nil {
 			return err
 		}
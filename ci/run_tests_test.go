@@ -550,6 +550,76 @@ func TestRecordResultsGroupSubmitsNonGroupLab(t *testing.T) {
 	}
 }
 
+func TestRecordResultsClassifiesScores(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &qf.Course{
+		Name:              "Test",
+		Code:              "DAT320",
+		ScmOrganizationID: 1,
+	}
+	admin := qtest.CreateFakeUser(t, db)
+	qtest.CreateCourse(t, db, admin, course)
+
+	assignment := &qf.Assignment{
+		CourseID: course.GetID(),
+		Name:     "lab1",
+		Deadline: qtest.Timestamp(t, "2022-11-11T13:00:00"),
+		Order:    1,
+		ExpectedTests: []*qf.TestInfo{
+			{TestName: "TestReported", MaxScore: 10, Weight: 1},
+			{TestName: "TestMissing", MaxScore: 10, Weight: 1},
+		},
+	}
+	qtest.CreateAssignment(t, db, assignment)
+	// cached results may lack the classification
+	results := &score.Results{
+		BuildInfo: createBuildInfo(t),
+		Scores: []*score.Score{
+			{TestName: "TestReported", Score: 10, MaxScore: 10, Weight: 1},
+			{TestName: "TestUnexpected", Score: 10, MaxScore: 10, Weight: 1},
+		},
+	}
+	runData := &ci.RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo: &qf.Repository{
+			RepoType: qf.Repository_USER,
+			UserID:   admin.GetID(),
+		},
+		JobOwner: "test",
+		CommitID: "deadbeef",
+	}
+
+	submission := recordResults(t, runData, db, results, nil, false)
+	statuses := make(map[string]score.Score_Status)
+	for _, sc := range submission.GetScores() {
+		statuses[sc.GetTestName()] = sc.GetStatus()
+	}
+	want := map[string]score.Score_Status{
+		"TestReported":   score.Score_REPORTED,
+		"TestMissing":    score.Score_MISSING,
+		"TestUnexpected": score.Score_UNEXPECTED,
+	}
+	qtest.Diff(t, "score statuses mismatch", statuses, want)
+	// only the expected tests count towards the score
+	if submission.GetScore() != 50 {
+		t.Errorf("submission score = %d, want 50", submission.GetScore())
+	}
+
+	// the classification is stored with the submission's scores
+	stored, err := db.GetSubmission(&qf.Submission{ID: submission.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	storedStatuses := make(map[string]score.Score_Status)
+	for _, sc := range stored.GetScores() {
+		storedStatuses[sc.GetTestName()] = sc.GetStatus()
+	}
+	qtest.Diff(t, "stored score statuses mismatch", storedStatuses, want)
+}
+
 func recordResults(t *testing.T, runData *ci.RunData, db database.Database, results *score.Results, date *timestamppb.Timestamp, rebuild bool) *qf.Submission {
 	if date != nil {
 		results.BuildInfo.BuildDate = date
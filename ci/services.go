@@ -0,0 +1,57 @@
+package ci
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// serviceReadyTimeout is the maximum time to wait for a job's services to become ready.
+const serviceReadyTimeout = 2 * time.Minute
+
+// serviceNameRegexp matches valid service names; service names are used as hostnames.
+var serviceNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// Service describes a sidecar service container for a job, e.g., a database.
+// Services are declared by #service/ directives in run scripts, such as:
+//
+//	#service/postgres:16 POSTGRES_PASSWORD=secret
+//
+// The service is reachable from the job's container using the service's name as hostname.
+type Service struct {
+	// Name is the service's hostname, derived from the image name, e.g., postgres.
+	Name string
+	// Image names the image to use for the service.
+	Image string
+	// Env is a list of environment variables to set for the service.
+	Env []string
+}
+
+// parseService parses the argument of a #service/ directive, e.g., "postgres:16 POSTGRES_PASSWORD=secret".
+func parseService(directive string) (Service, error) {
+	fields := strings.Fields(directive)
+	if len(fields) == 0 {
+		return Service{}, fmt.Errorf("no service image specified")
+	}
+	image := fields[0]
+	// derive the name from the last path element of the image, without tag or digest
+	name, _, _ := strings.Cut(path.Base(strings.ToLower(image)), "@")
+	name, _, _ = strings.Cut(name, ":")
+	if !serviceNameRegexp.MatchString(name) {
+		return Service{}, fmt.Errorf("invalid service name %q for image %s", name, image)
+	}
+	for _, env := range fields[1:] {
+		if !strings.Contains(env, "=") {
+			return Service{}, fmt.Errorf("invalid environment variable for service %s: %s", name, env)
+		}
+	}
+	return Service{Name: name, Image: image, Env: fields[1:]}, nil
+}
+
+// HostEnv returns the environment variable holding the service's hostname
+// for the job's container, e.g., SERVICE_POSTGRES_HOST=postgres.
+func (s Service) HostEnv() string {
+	return "SERVICE_" + strings.ToUpper(strings.ReplaceAll(s.Name, "-", "_")) + "_HOST=" + s.Name
+}
@@ -0,0 +1,129 @@
+package ci
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/network"
+)
+
+func TestParseRunScriptServices(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		want    []Service
+		wantErr bool
+	}{
+		{name: "NoServices", script: "#image/quickfeed:go\n#language/go\necho hello\n"},
+		{
+			name:   "Postgres",
+			script: "#image/quickfeed:go\n#service/postgres:16 POSTGRES_PASSWORD=secret POSTGRES_DB=lab\necho hello\n",
+			want:   []Service{{Name: "postgres", Image: "postgres:16", Env: []string{"POSTGRES_PASSWORD=secret", "POSTGRES_DB=lab"}}},
+		},
+		{
+			name:   "Multiple",
+			script: "#image/quickfeed:go\n#service/redis\n#service/ghcr.io/example/mock-api@sha256:abc\necho hello\n",
+			want: []Service{
+				{Name: "redis", Image: "redis", Env: []string{}},
+				{Name: "mock-api", Image: "ghcr.io/example/mock-api@sha256:abc", Env: []string{}},
+			},
+		},
+		{name: "Duplicate", script: "#image/quickfeed:go\n#service/redis:6\n#service/redis:7\necho hello\n", wantErr: true},
+		{name: "NoImage", script: "#image/quickfeed:go\n#service/\necho hello\n", wantErr: true},
+		{name: "InvalidName", script: "#image/quickfeed:go\n#service/my_db\necho hello\n", wantErr: true},
+		{name: "InvalidEnv", script: "#image/quickfeed:go\n#service/postgres secret\necho hello\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script, err := parseRunScript(tt.script)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRunScript() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, script.services); diff != "" {
+				t.Errorf("parseRunScript() services mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceHostEnv(t *testing.T) {
+	service := Service{Name: "mock-api", Image: "ghcr.io/example/mock-api"}
+	if got, want := service.HostEnv(), "SERVICE_MOCK_API_HOST=mock-api"; got != want {
+		t.Errorf("HostEnv() = %q, want %q", got, want)
+	}
+}
+
+func TestServiceReady(t *testing.T) {
+	const networkName = "quickfeed-job"
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	openPort := tcpPort(t, listener.Addr())
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedPort := tcpPort(t, closed.Addr())
+	closed.Close()
+
+	running := func(health *container.Health, ports ...network.Port) container.InspectResponse {
+		exposed := network.PortSet{}
+		for _, port := range ports {
+			exposed[port] = struct{}{}
+		}
+		return container.InspectResponse{
+			State:  &container.State{Status: container.StateRunning, Running: true, Health: health},
+			Config: &container.Config{ExposedPorts: exposed},
+			NetworkSettings: &container.NetworkSettings{
+				Networks: map[string]*network.EndpointSettings{
+					networkName: {IPAddress: netip.MustParseAddr("127.0.0.1")},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name      string
+		container container.InspectResponse
+		want      bool
+		wantErr   bool
+	}{
+		{name: "Created", container: container.InspectResponse{State: &container.State{Status: container.StateCreated}}},
+		{name: "Exited", container: container.InspectResponse{State: &container.State{Status: container.StateExited, ExitCode: 1}}, wantErr: true},
+		{name: "Starting", container: running(&container.Health{Status: container.Starting})},
+		{name: "Healthy", container: running(&container.Health{Status: container.Healthy}), want: true},
+		{name: "Unhealthy", container: running(&container.Health{Status: container.Unhealthy}), wantErr: true},
+		{name: "NoExposedPorts", container: running(nil), want: true},
+		{name: "PortOpen", container: running(nil, openPort), want: true},
+		{name: "PortClosed", container: running(nil, openPort, closedPort)},
+		{name: "UDPPortIgnored", container: running(nil, network.MustParsePort("53/udp")), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := serviceReady(tt.container, networkName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("serviceReady() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("serviceReady() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+// tcpPort returns the container port for the given listener address.
+func tcpPort(t *testing.T, addr net.Addr) network.Port {
+	t.Helper()
+	port, ok := network.PortFrom(uint16(addr.(*net.TCPAddr).Port), network.TCP)
+	if !ok {
+		t.Fatalf("invalid port for %s", addr)
+	}
+	return port
+}
@@ -0,0 +1,41 @@
+package ci
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// solutionRepo returns the repository holding the course's reference solution.
+// The repository belongs to the same organization as the given tests repository.
+func solutionRepo(course *qf.Course, testsRepo *qf.Repository) *qf.Repository {
+	repoURL := testsRepo.GetHTMLURL()
+	return &qf.Repository{
+		ScmOrganizationID: testsRepo.GetScmOrganizationID(),
+		HTMLURL:           repoURL[:strings.LastIndex(repoURL, "/")+1] + course.SolutionRepo(),
+	}
+}
+
+// reportSolution records on the given solution job the score obtained by the reference solution,
+// the tests that did not obtain their max score, and the expected tests that reported no score.
+// The results are not recorded.
+func reportSolution(job *qf.Job, results *score.Results) {
+	job.Score = results.Sum()
+	job.TestsCommit = results.GetBuildInfo().GetTestsCommit()
+	job.MissingTests = results.MissingTests()
+	job.FailingTests = failingTests(results.Scores)
+}
+
+// failingTests returns the names of the tests that reported a score below their max score.
+func failingTests(scores []*score.Score) []string {
+	var failing []string
+	for _, sc := range scores {
+		if sc.GetStatus() == score.Score_REPORTED && !testPassed(sc) {
+			failing = append(failing, sc.GetTestName())
+		}
+	}
+	slices.Sort(failing)
+	return failing
+}
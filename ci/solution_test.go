@@ -0,0 +1,55 @@
+package ci
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+func TestSolutionRepo(t *testing.T) {
+	testsRepo := &qf.Repository{ScmOrganizationID: 1, HTMLURL: "https://github.com/dat320/tests"}
+	tests := []struct {
+		course *qf.Course
+		want   string
+	}{
+		{course: &qf.Course{SolutionBranch: "solution"}, want: qf.AssignmentsRepo},
+		{course: &qf.Course{SolutionRepository: "solutions"}, want: "solutions"},
+	}
+	for _, tt := range tests {
+		repo := solutionRepo(tt.course, testsRepo)
+		if repo.Name() != tt.want {
+			t.Errorf("solutionRepo(%v).Name() = %q, want %q", tt.course, repo.Name(), tt.want)
+		}
+		if repo.GetScmOrganizationID() != testsRepo.GetScmOrganizationID() {
+			t.Errorf("solutionRepo(%v).ScmOrganizationID = %d, want %d", tt.course, repo.GetScmOrganizationID(), testsRepo.GetScmOrganizationID())
+		}
+	}
+}
+
+func TestReportSolution(t *testing.T) {
+	results := &score.Results{
+		BuildInfo: &score.BuildInfo{TestsCommit: "abc123"},
+		Scores: []*score.Score{
+			{TestName: "TestPassing", Score: 10, MaxScore: 10, Weight: 1},
+			{TestName: "TestFailing", Score: 5, MaxScore: 10, Weight: 1},
+			{TestName: "TestMissing", Score: 0, MaxScore: 10, Weight: 1, Status: score.Score_MISSING},
+			{TestName: "TestUnexpected", Score: 0, MaxScore: 10, Weight: 1, Status: score.Score_UNEXPECTED},
+		},
+	}
+	job := &qf.Job{Solution: true}
+	reportSolution(job, results)
+	if job.GetScore() != 50 {
+		t.Errorf("reportSolution() score = %d, want 50", job.GetScore())
+	}
+	if job.GetTestsCommit() != "abc123" {
+		t.Errorf("reportSolution() tests commit = %q, want %q", job.GetTestsCommit(), "abc123")
+	}
+	if diff := cmp.Diff([]string{"TestFailing"}, job.GetFailingTests()); diff != "" {
+		t.Errorf("reportSolution() failing tests mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"TestMissing"}, job.GetMissingTests()); diff != "" {
+		t.Errorf("reportSolution() missing tests mismatch (-want +got):\n%s", diff)
+	}
+}
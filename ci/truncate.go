@@ -13,6 +13,14 @@ const truncateMsg = `
 
 `
 
+// truncatedLog returns the log output, truncated if it exceeds the maximum log size.
+func truncatedLog(stdout *bytes.Buffer) string {
+	if stdout.Len() > maxLogSize+lastSegmentSize {
+		return truncateLog(stdout, maxLogSize, lastSegmentSize, maxToScan)
+	}
+	return stdout.String()
+}
+
 // truncateLog returns the log output truncated at the nearest line before the truncate point.
 // The returned log includes score lines found in the middle segment unless the middle segment's size exceeds max.
 // The returned log also includes the last segment of size given by last.
@@ -81,11 +81,6 @@ func runTests(logger *zap.SugaredLogger, client scm.SCM, destDir string) {
 		JobOwner: studentRepo(),
 		CommitID: "dummy",
 	}
-	if !cli.Clone.Docker {
-		runData.EnvVarsFn = func(secret, home string) []string {
-			return ci.EnvVars(secret, home, runData.Repo.Name(), runData.Assignment.GetName())
-		}
-	}
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
 	defer cancel()
 	results, err := runData.RunTests(ctx, logger, client, runner(logger))
@@ -107,6 +102,7 @@ func runner(logger *zap.SugaredLogger) ci.Runner {
 		check(err)
 		return runner
 	}
+	// the local runner maps the container paths in the environment to its working directory
 	return &ci.Local{}
 }
 
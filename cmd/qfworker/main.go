@@ -0,0 +1,70 @@
+// qfworker runs test jobs dispatched by a QuickFeed server configured
+// with remote workers, using the worker host's local container engine,
+// selected by the QUICKFEED_RUNNER environment variable (docker or podman).
+//
+// Usage:
+//
+//	QUICKFEED_RUNNER_TOKEN=<token> qfworker -http.addr :8090
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/ci/remote"
+	"github.com/quickfeed/quickfeed/ci/remote/remotepb/remotepbconnect"
+	"github.com/quickfeed/quickfeed/internal/env"
+	"github.com/quickfeed/quickfeed/internal/qlog"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func main() {
+	addr := flag.String("http.addr", ":8090", "address to listen on for jobs from the QuickFeed server")
+	flag.Parse()
+
+	token := env.RunnerToken()
+	if token == "" {
+		log.Fatal("QUICKFEED_RUNNER_TOKEN must be set")
+	}
+	logger, err := qlog.Zap()
+	if err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+	runner, err := ci.NewContainerCI(logger.Sugar())
+	if err != nil {
+		log.Fatalf("Failed to set up %s client: %v", env.RunnerEngine(), err)
+	}
+	defer runner.Close()
+
+	router := http.NewServeMux()
+	router.Handle(remotepbconnect.NewRunnerServiceHandler(remote.NewWorker(logger.Sugar(), runner, token)))
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           h2c.NewHandler(router, &http2.Server{}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutDownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutDownCtx); err != nil {
+			log.Printf("Graceful shutdown failed: %v", err)
+		}
+	}()
+
+	log.Printf("QuickFeed worker listening on %s", *addr)
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Printf("Worker failed: %v", err)
+	}
+}
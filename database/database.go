@@ -1,6 +1,9 @@
 package database
 
-import "github.com/quickfeed/quickfeed/qf"
+import (
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+)
 
 // Database contains methods for manipulating the database.
 type Database interface {
@@ -105,6 +108,10 @@ type Database interface {
 	GetLastSubmissions(courseID uint64, query *qf.Submission) ([]*qf.Submission, error)
 	// GetSubmissions returns all submissions matching the query.
 	GetSubmissions(*qf.Submission) ([]*qf.Submission, error)
+	// GetStaleSubmissions returns the submissions for the given assignment that were not graded with the given tests commit.
+	GetStaleSubmissions(assignmentID uint64, testsCommit string) ([]*qf.Submission, error)
+	// GetAssignmentScores returns the test scores of the recorded submissions for the given assignment.
+	GetAssignmentScores(assignmentID uint64) ([]*score.Score, error)
 	// GetCourseSubmissions returns the latest course submissions of the requested submission type.
 	GetCourseSubmissions(request *qf.SubmissionRequest) (*qf.CourseSubmissions, error)
 	// UpdateSubmission updates the specified submission with approved or not approved.
@@ -151,4 +158,27 @@ type Database interface {
 	CreateAssignmentFeedback(*qf.AssignmentFeedback, uint64) error
 	// GetAssignmentFeedback returns a list of assignment feedback for the given course
 	GetAssignmentFeedback(query *qf.CourseRequest) (*qf.AssignmentFeedbacks, error)
+
+	// CreateJob creates a new queued test run job.
+	CreateJob(*qf.Job) error
+	// GetJob returns the job with the given ID.
+	GetJob(jobID uint64) (*qf.Job, error)
+	// GetJobs returns all jobs matching the given query.
+	GetJobs(query *qf.Job) ([]*qf.Job, error)
+	// UpdateJob updates the status, error, score and flipped tests of the given job.
+	UpdateJob(*qf.Job) error
+	// ClaimJob marks the oldest queued job as running and returns it.
+	// Returns gorm.ErrRecordNotFound if there are no queued jobs.
+	ClaimJob() (*qf.Job, error)
+	// RequeueRunningJobs marks all running jobs as queued and returns the number of requeued jobs.
+	// This is used to restart jobs that were interrupted by a server restart or crash.
+	RequeueRunningJobs() (int64, error)
+	// CancelQueuedJobs marks queued jobs matching the given query as cancelled and returns the cancelled jobs.
+	CancelQueuedJobs(query *qf.Job) ([]*qf.Job, error)
+	// CreateRebuild creates a new rebuild record.
+	CreateRebuild(*qf.Rebuild) error
+	// GetRebuild returns the rebuild with the given ID.
+	GetRebuild(rebuildID uint64) (*qf.Rebuild, error)
+	// GetRebuilds returns the given number of most recent rebuilds for the given course, most recent first.
+	GetRebuilds(courseID uint64, limit int) ([]*qf.Rebuild, error)
 }
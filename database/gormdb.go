@@ -72,6 +72,8 @@ func NewGormDB(path string, logger *zap.Logger) (*GormDB, error) {
 		&qf.Issue{},
 		&qf.Task{},
 		&qf.PullRequest{},
+		&qf.Job{},
+		&qf.Rebuild{},
 		&score.BuildInfo{},
 		&score.Score{},
 	); err != nil {
@@ -35,15 +35,24 @@ func (db *GormDB) CreateAssignment(assignment *qf.Assignment) error {
 			Order:    assignment.GetOrder(),
 		}).
 		Assign(map[string]interface{}{
-			"name":              assignment.GetName(),
-			"order":             assignment.GetOrder(),
-			"deadline":          assignment.GetDeadline().AsTime(),
-			"auto_approve":      assignment.GetAutoApprove(),
-			"score_limit":       assignment.GetScoreLimit(),
-			"is_group_lab":      assignment.GetIsGroupLab(),
-			"reviewers":         assignment.GetReviewers(),
-			"container_timeout": assignment.GetContainerTimeout(),
-			"tasks":             assignment.GetTasks(),
+			"name":               assignment.GetName(),
+			"order":              assignment.GetOrder(),
+			"deadline":           assignment.GetDeadline().AsTime(),
+			"auto_approve":       assignment.GetAutoApprove(),
+			"score_limit":        assignment.GetScoreLimit(),
+			"is_group_lab":       assignment.GetIsGroupLab(),
+			"reviewers":          assignment.GetReviewers(),
+			"container_timeout":  assignment.GetContainerTimeout(),
+			"memory_limit":       assignment.GetMemoryLimit(),
+			"cpu_limit":          assignment.GetCpuLimit(),
+			"pids_limit":         assignment.GetPidsLimit(),
+			"file_size_limit":    assignment.GetFileSizeLimit(),
+			"test_retries":       assignment.GetTestRetries(),
+			"coverage_threshold": assignment.GetCoverageThreshold(),
+			"coverage_weight":    assignment.GetCoverageWeight(),
+			"lint_penalty":       assignment.GetLintPenalty(),
+			"max_lint_penalty":   assignment.GetMaxLintPenalty(),
+			"tasks":              assignment.GetTasks(),
 		}).Omit("Tasks").FirstOrCreate(assignment).Error
 }
 
@@ -110,16 +119,25 @@ func (db *GormDB) UpdateAssignments(assignments []*qf.Assignment) error {
 			if err := tx.Model(v).Where(&qf.Assignment{
 				ID: assignment.GetID(),
 			}).Select("*").Updates(&qf.Assignment{
-				ID:               v.GetID(),
-				CourseID:         v.GetCourseID(),
-				Name:             v.GetName(),
-				Deadline:         v.GetDeadline(),
-				AutoApprove:      v.GetAutoApprove(),
-				Order:            v.GetOrder(),
-				IsGroupLab:       v.GetIsGroupLab(),
-				ScoreLimit:       v.GetScoreLimit(),
-				Reviewers:        v.GetReviewers(),
-				ContainerTimeout: v.GetContainerTimeout(),
+				ID:                v.GetID(),
+				CourseID:          v.GetCourseID(),
+				Name:              v.GetName(),
+				Deadline:          v.GetDeadline(),
+				AutoApprove:       v.GetAutoApprove(),
+				Order:             v.GetOrder(),
+				IsGroupLab:        v.GetIsGroupLab(),
+				ScoreLimit:        v.GetScoreLimit(),
+				Reviewers:         v.GetReviewers(),
+				ContainerTimeout:  v.GetContainerTimeout(),
+				MemoryLimit:       v.GetMemoryLimit(),
+				CpuLimit:          v.GetCpuLimit(),
+				PidsLimit:         v.GetPidsLimit(),
+				FileSizeLimit:     v.GetFileSizeLimit(),
+				TestRetries:       v.GetTestRetries(),
+				CoverageThreshold: v.GetCoverageThreshold(),
+				CoverageWeight:    v.GetCoverageWeight(),
+				LintPenalty:       v.GetLintPenalty(),
+				MaxLintPenalty:    v.GetMaxLintPenalty(),
 				// Submissions:       v.GetSubmissions(),
 				Tasks:             v.GetTasks(),
 				GradingBenchmarks: v.GetGradingBenchmarks(),
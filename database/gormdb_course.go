@@ -188,7 +188,20 @@ func (db *GormDB) GetCourseTeachers(query *qf.Course) ([]*qf.User, error) {
 
 // UpdateCourse updates course information.
 func (db *GormDB) UpdateCourse(course *qf.Course) error {
-	return db.conn.Model(&qf.Course{}).
-		Where(&qf.Course{ID: course.GetID()}).
-		Updates(course).Error
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&qf.Course{}).
+			Where(&qf.Course{ID: course.GetID()}).
+			Updates(course).Error; err != nil {
+			return err
+		}
+		// Updates ignores zero values; update the settings separately such that they can be disabled.
+		return tx.Model(&qf.Course{}).
+			Where(&qf.Course{ID: course.GetID()}).
+			Updates(map[string]any{
+				"auto_rebuild":        course.GetAutoRebuild(),
+				"solution_repository": course.GetSolutionRepository(),
+				"solution_branch":     course.GetSolutionBranch(),
+				"leaderboard":         course.GetLeaderboard(),
+			}).Error
+	})
 }
@@ -238,14 +238,19 @@ func TestGormDBUpdateCourse(t *testing.T) {
 		Tag:               "Spring",
 		DockerfileDigest:  "0x123abc",
 		ScmOrganizationID: 1234,
+		AutoRebuild:       true,
+		SolutionBranch:    "solution",
+		Leaderboard:       true,
 	}
+	// the updated course disables automatic rebuilds and leaderboards, and moves the solution to another repository
 	wantCourse := &qf.Course{
-		Name:              "Test Course Edit",
-		Code:              "DAT100-1",
-		Year:              2018,
-		Tag:               "Autumn",
-		DockerfileDigest:  "0x123def",
-		ScmOrganizationID: 12345,
+		Name:               "Test Course Edit",
+		Code:               "DAT100-1",
+		Year:               2018,
+		Tag:                "Autumn",
+		DockerfileDigest:   "0x123def",
+		ScmOrganizationID:  12345,
+		SolutionRepository: "solutions",
 	}
 
 	db, cleanup := qtest.TestDB(t)
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// ErrJobAlreadyClaimed is returned by ClaimJob if another worker claimed the job first.
+var ErrJobAlreadyClaimed = errors.New("job already claimed by another worker")
+
+// CreateJob creates a new queued test run job.
+func (db *GormDB) CreateJob(job *qf.Job) error {
+	now := timestamppb.Now()
+	job.Status = qf.Job_QUEUED
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	return db.conn.Create(job).Error
+}
+
+// GetJob returns the job with the given ID.
+func (db *GormDB) GetJob(jobID uint64) (*qf.Job, error) {
+	var job qf.Job
+	if err := db.conn.First(&job, jobID).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// GetJobs returns all jobs matching the given query, ordered by creation.
+func (db *GormDB) GetJobs(query *qf.Job) ([]*qf.Job, error) {
+	var jobs []*qf.Job
+	if err := db.conn.Where(query).Order("id").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// UpdateJob updates the status, error, score, and the flipped, failing and missing tests of the given job.
+func (db *GormDB) UpdateJob(job *qf.Job) error {
+	job.UpdatedAt = timestamppb.Now()
+	// Select is needed to also update zero values, e.g., the QUEUED status and an empty error.
+	return db.conn.Model(job).Select("Status", "Error", "Score", "NowPassing", "NowFailing", "TestsCommit", "FailingTests", "MissingTests", "UpdatedAt").Updates(job).Error
+}
+
+// ClaimJob marks the oldest queued job as running and returns it.
+// Returns gorm.ErrRecordNotFound if there are no queued jobs,
+// and ErrJobAlreadyClaimed if the oldest queued job was claimed concurrently.
+func (db *GormDB) ClaimJob() (*qf.Job, error) {
+	var job qf.Job
+	err := db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("status = ?", qf.Job_QUEUED).Order("id").First(&job).Error; err != nil {
+			return err
+		}
+		job.Status = qf.Job_RUNNING
+		job.UpdatedAt = timestamppb.Now()
+		// only claim the job if it is still queued, i.e., not claimed concurrently by another worker
+		claim := tx.Model(&qf.Job{}).Where("id = ? AND status = ?", job.GetID(), qf.Job_QUEUED).
+			Select("Status", "UpdatedAt").Updates(&qf.Job{Status: job.GetStatus(), UpdatedAt: job.GetUpdatedAt()})
+		if claim.Error != nil {
+			return claim.Error
+		}
+		if claim.RowsAffected == 0 {
+			return ErrJobAlreadyClaimed
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// RequeueRunningJobs marks all running jobs as queued and returns the number of requeued jobs.
+func (db *GormDB) RequeueRunningJobs() (int64, error) {
+	tx := db.conn.Model(&qf.Job{}).Where("status = ?", qf.Job_RUNNING).
+		Select("Status", "UpdatedAt").
+		Updates(&qf.Job{Status: qf.Job_QUEUED, UpdatedAt: timestamppb.Now()})
+	return tx.RowsAffected, tx.Error
+}
+
+// CancelQueuedJobs marks queued jobs matching the given query as cancelled and returns the cancelled jobs.
+func (db *GormDB) CancelQueuedJobs(query *qf.Job) ([]*qf.Job, error) {
+	var jobs []*qf.Job
+	err := db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(query).Where("status = ?", qf.Job_QUEUED).Order("id").Find(&jobs).Error; err != nil {
+			return err
+		}
+		now := timestamppb.Now()
+		for _, job := range jobs {
+			job.Status = qf.Job_CANCELLED
+			job.UpdatedAt = now
+			if err := tx.Model(job).Select("Status", "UpdatedAt").Updates(job).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// CreateRebuild creates a new rebuild record.
+func (db *GormDB) CreateRebuild(rebuild *qf.Rebuild) error {
+	rebuild.CreatedAt = timestamppb.Now()
+	return db.conn.Create(rebuild).Error
+}
+
+// GetRebuild returns the rebuild with the given ID.
+func (db *GormDB) GetRebuild(rebuildID uint64) (*qf.Rebuild, error) {
+	var rebuild qf.Rebuild
+	if err := db.conn.First(&rebuild, rebuildID).Error; err != nil {
+		return nil, err
+	}
+	return &rebuild, nil
+}
+
+// GetRebuilds returns the given number of most recent rebuilds for the given course, most recent first.
+func (db *GormDB) GetRebuilds(courseID uint64, limit int) ([]*qf.Rebuild, error) {
+	var rebuilds []*qf.Rebuild
+	if err := db.conn.Where(&qf.Rebuild{CourseID: courseID}).Order("id desc").Limit(limit).Find(&rebuilds).Error; err != nil {
+		return nil, err
+	}
+	return rebuilds, nil
+}
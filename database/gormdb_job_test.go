@@ -0,0 +1,157 @@
+package database_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gorm.io/gorm"
+)
+
+func TestGormDBClaimJob(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	if _, err := db.ClaimJob(); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("ClaimJob() on empty queue: got error %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+
+	jobs := []*qf.Job{
+		{AssignmentID: 1, RepositoryID: 1, CommitID: "abc", JobOwner: "student1"},
+		{AssignmentID: 1, RepositoryID: 2, CommitID: "def", JobOwner: "student2"},
+	}
+	for _, job := range jobs {
+		if err := db.CreateJob(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Jobs must be claimed in the order they were created.
+	for _, want := range jobs {
+		got, err := db.ClaimJob()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want.Status = qf.Job_RUNNING
+		qtest.Diff(t, "ClaimJob() mismatch", got, want, protocmp.Transform(), protocmp.IgnoreFields(&qf.Job{}, "UpdatedAt"))
+	}
+	if _, err := db.ClaimJob(); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("ClaimJob() with only running jobs: got error %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+}
+
+func TestGormDBRequeueRunningJobs(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	for range 3 {
+		if err := db.CreateJob(&qf.Job{AssignmentID: 1, RepositoryID: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	running, err := db.ClaimJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	finished, err := db.ClaimJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	finished.Status = qf.Job_FAILED
+	finished.Error = "test execution failed"
+	if err := db.UpdateJob(finished); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a server restart: only the running job should be requeued.
+	requeued, err := db.RequeueRunningJobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requeued != 1 {
+		t.Errorf("RequeueRunningJobs() = %d, want 1", requeued)
+	}
+	wantStatus := map[uint64]qf.Job_Status{
+		running.GetID():  qf.Job_QUEUED,
+		finished.GetID(): qf.Job_FAILED,
+		3:                qf.Job_QUEUED,
+	}
+	for id, want := range wantStatus {
+		job, err := db.GetJob(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.GetStatus() != want {
+			t.Errorf("GetJob(%d).Status = %v, want %v", id, job.GetStatus(), want)
+		}
+	}
+	job, err := db.GetJob(finished.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.GetError() != finished.GetError() {
+		t.Errorf("GetJob(%d).Error = %q, want %q", finished.GetID(), job.GetError(), finished.GetError())
+	}
+}
+
+func TestGormDBCancelQueuedJobs(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	for _, rebuildID := range []uint64{1, 1, 1, 2} {
+		if err := db.CreateJob(&qf.Job{AssignmentID: 1, RepositoryID: 1, RebuildID: rebuildID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	running, err := db.ClaimJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cancelled, err := db.CancelQueuedJobs(&qf.Job{RebuildID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cancelled) != 2 {
+		t.Fatalf("CancelQueuedJobs() returned %d jobs, want 2", len(cancelled))
+	}
+	wantStatus := map[uint64]qf.Job_Status{
+		running.GetID(): qf.Job_RUNNING,
+		2:               qf.Job_CANCELLED,
+		3:               qf.Job_CANCELLED,
+		4:               qf.Job_QUEUED, // different rebuild
+	}
+	for id, want := range wantStatus {
+		job, err := db.GetJob(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.GetStatus() != want {
+			t.Errorf("GetJob(%d).Status = %v, want %v", id, job.GetStatus(), want)
+		}
+	}
+}
+
+func TestGormDBGetRebuilds(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	for _, courseID := range []uint64{1, 2, 1, 1} {
+		if err := db.CreateRebuild(&qf.Rebuild{CourseID: courseID, AssignmentID: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rebuilds, err := db.GetRebuilds(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []uint64
+	for _, rebuild := range rebuilds {
+		got = append(got, rebuild.GetID())
+	}
+	if diff := cmp.Diff([]uint64{4, 3}, got); diff != "" {
+		t.Errorf("GetRebuilds() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -224,6 +224,38 @@ func (db *GormDB) GetSubmissions(query *qf.Submission) ([]*qf.Submission, error)
 	return submissions, nil
 }
 
+// GetStaleSubmissions returns the submissions for the given assignment that were not graded with the given
+// commit of the tests repository, including submissions with no recorded commit. The submissions' build info
+// is included without the build log.
+func (db *GormDB) GetStaleSubmissions(assignmentID uint64, testsCommit string) ([]*qf.Submission, error) {
+	if _, err := db.GetAssignment(&qf.Assignment{ID: assignmentID}); err != nil {
+		return nil, err
+	}
+	var submissions []*qf.Submission
+	if err := db.conn.
+		Preload("BuildInfo", func(tx *gorm.DB) *gorm.DB { return tx.Omit("build_log") }).
+		Joins("LEFT JOIN build_infos ON build_infos.submission_id = submissions.id").
+		Where("submissions.assignment_id = ?", assignmentID).
+		Where("build_infos.tests_commit IS NULL OR build_infos.tests_commit <> ?", testsCommit).
+		Find(&submissions).Error; err != nil {
+		return nil, err
+	}
+	return submissions, nil
+}
+
+// GetAssignmentScores returns the test scores of the recorded submissions for the given assignment.
+func (db *GormDB) GetAssignmentScores(assignmentID uint64) ([]*score.Score, error) {
+	var scores []*score.Score
+	if err := db.conn.
+		Joins("JOIN submissions ON submissions.id = scores.submission_id").
+		Where("submissions.assignment_id = ?", assignmentID).
+		Order("scores.id").
+		Find(&scores).Error; err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
 // UpdateSubmission updates submission with the given approved status.
 func (db *GormDB) UpdateSubmission(query *qf.Submission) error {
 	// We need to use FullSaveAssociations to save the nested grades
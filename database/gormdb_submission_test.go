@@ -824,3 +824,81 @@ func TestSubmissionGradesAfterGroupUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestGormDBGetStaleSubmissions(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	user, course, assignment := qtest.SetupCourseAssignment(t, db)
+	oldUser := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, oldUser, course)
+	unknownUser := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, unknownUser, course)
+
+	const testsCommit = "b2c3d4"
+	for userID, buildInfo := range map[uint64]*score.BuildInfo{
+		user.GetID():        {BuildLog: "current", TestsCommit: testsCommit},
+		oldUser.GetID():     {BuildLog: "old", TestsCommit: "a1b2c3", AssignmentsCommit: "ffff", DockerfileDigest: "abcd"},
+		unknownUser.GetID(): {BuildLog: "graded before tests commits were recorded"},
+	} {
+		qtest.CreateSubmission(t, db, &qf.Submission{
+			AssignmentID: assignment.GetID(),
+			UserID:       userID,
+			BuildInfo:    buildInfo,
+		})
+	}
+
+	submissions, err := db.GetStaleSubmissions(assignment.GetID(), testsCommit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[uint64]*score.BuildInfo)
+	for _, submission := range submissions {
+		got[submission.GetUserID()] = submission.GetBuildInfo()
+	}
+	want := map[uint64]*score.BuildInfo{
+		oldUser.GetID():     {TestsCommit: "a1b2c3", AssignmentsCommit: "ffff", DockerfileDigest: "abcd"},
+		unknownUser.GetID(): {},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform(), protocmp.IgnoreFields(&score.BuildInfo{}, "ID", "SubmissionID", "BuildDate", "SubmissionDate")); diff != "" {
+		t.Errorf("GetStaleSubmissions() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := db.GetStaleSubmissions(assignment.GetID()+1, testsCommit); err == nil {
+		t.Error("GetStaleSubmissions() succeeded for unknown assignment")
+	}
+}
+
+func TestGormDBGetAssignmentScores(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	user, course, assignment := qtest.SetupCourseAssignment(t, db)
+	otherAssignment := &qf.Assignment{CourseID: course.GetID(), Name: "lab2", Order: 2}
+	qtest.CreateAssignment(t, db, otherAssignment)
+
+	qtest.CreateSubmission(t, db, &qf.Submission{
+		AssignmentID: assignment.GetID(),
+		UserID:       user.GetID(),
+		Scores: []*score.Score{
+			{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1, Flaky: true},
+			{TestName: "TestB", Score: 0, MaxScore: 10, Weight: 1, Status: score.Score_MISSING},
+		},
+	})
+	// scores of other assignments are not included
+	qtest.CreateSubmission(t, db, &qf.Submission{
+		AssignmentID: otherAssignment.GetID(),
+		UserID:       user.GetID(),
+		Scores:       []*score.Score{{TestName: "TestC", Score: 10, MaxScore: 10, Weight: 1}},
+	})
+
+	scores, err := db.GetAssignmentScores(assignment.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*score.Score{
+		{TestName: "TestA", Score: 10, MaxScore: 10, Weight: 1, Flaky: true},
+		{TestName: "TestB", Score: 0, MaxScore: 10, Weight: 1, Status: score.Score_MISSING},
+	}
+	if diff := cmp.Diff(want, scores, protocmp.Transform(), protocmp.IgnoreFields(&score.Score{}, "ID", "SubmissionID")); diff != "" {
+		t.Errorf("GetAssignmentScores() mismatch (-want +got):\n%s", diff)
+	}
+}
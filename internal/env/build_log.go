@@ -0,0 +1,43 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	buildLogPath      = "QUICKFEED_BUILD_LOG_PATH"
+	buildLogRetention = "QUICKFEED_BUILD_LOG_RETENTION"
+
+	defaultBuildLogRetention = 90 // days
+)
+
+// BuildLogPath returns the directory in which the complete build logs of test runs are archived,
+// obtained from the QUICKFEED_BUILD_LOG_PATH environment variable. Defaults to $QUICKFEED/build-logs.
+func BuildLogPath() string {
+	if path := os.Getenv(buildLogPath); path != "" {
+		return os.ExpandEnv(path)
+	}
+	return Root("build-logs")
+}
+
+// BuildLogRetention returns the duration to keep archived build logs, obtained from
+// the QUICKFEED_BUILD_LOG_RETENTION environment variable given in days. Defaults to 90 days.
+// A retention of zero days keeps archived build logs forever.
+func BuildLogRetention() (time.Duration, error) {
+	return retention(buildLogRetention, defaultBuildLogRetention)
+}
+
+// retention returns the duration given in days by the named environment variable, or the default days.
+func retention(name string, defaultDays int) (time.Duration, error) {
+	days := defaultDays
+	if value := os.Getenv(name); value != "" {
+		var err error
+		if days, err = strconv.Atoi(value); err != nil || days < 0 {
+			return 0, fmt.Errorf("invalid %s: %q is not a number of days", name, value)
+		}
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/quickfeed/quickfeed/internal/env"
@@ -242,3 +243,76 @@ func TestWhitelist(t *testing.T) {
 		}
 	}
 }
+
+func TestRunnerEngine(t *testing.T) {
+	tests := []struct {
+		engine string
+		want   string
+	}{
+		{engine: "", want: env.DockerEngine},
+		{engine: "podman", want: env.PodmanEngine},
+		{engine: " Podman ", want: env.PodmanEngine},
+		{engine: "docker", want: env.DockerEngine},
+	}
+	for _, tt := range tests {
+		t.Setenv("QUICKFEED_RUNNER", tt.engine)
+		if got := env.RunnerEngine(); got != tt.want {
+			t.Errorf("RunnerEngine() = %q, want %q (QUICKFEED_RUNNER=%q)", got, tt.want, tt.engine)
+		}
+	}
+}
+
+func TestPodmanHost(t *testing.T) {
+	t.Setenv("QUICKFEED_PODMAN_HOST", "")
+	t.Setenv("XDG_RUNTIME_DIR", "/run/user/1234")
+	if got, want := env.PodmanHost(), "unix:///run/user/1234/podman/podman.sock"; got != want {
+		t.Errorf("PodmanHost() = %q, want %q", got, want)
+	}
+	t.Setenv("QUICKFEED_PODMAN_HOST", "unix:///tmp/podman.sock")
+	if got, want := env.PodmanHost(), "unix:///tmp/podman.sock"; got != want {
+		t.Errorf("PodmanHost() = %q, want %q", got, want)
+	}
+}
+
+func TestBuildLogPath(t *testing.T) {
+	t.Setenv("QUICKFEED_BUILD_LOG_PATH", "$HOME/logs")
+	t.Setenv("HOME", "/home/qf")
+	if got, want := env.BuildLogPath(), "/home/qf/logs"; got != want {
+		t.Errorf("BuildLogPath() = %q, want %q", got, want)
+	}
+}
+
+func TestBuildLogRetention(t *testing.T) {
+	tests := []struct {
+		retention string
+		want      time.Duration
+		wantErr   bool
+	}{
+		{retention: "", want: 90 * 24 * time.Hour},
+		{retention: "7", want: 7 * 24 * time.Hour},
+		{retention: "0", want: 0},
+		{retention: "-1", wantErr: true},
+		{retention: "1w", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Setenv("QUICKFEED_BUILD_LOG_RETENTION", tt.retention)
+		got, err := env.BuildLogRetention()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("BuildLogRetention() error = %v, wantErr %t (QUICKFEED_BUILD_LOG_RETENTION=%q)", err, tt.wantErr, tt.retention)
+		}
+		if got != tt.want {
+			t.Errorf("BuildLogRetention() = %v, want %v (QUICKFEED_BUILD_LOG_RETENTION=%q)", got, tt.want, tt.retention)
+		}
+	}
+}
+
+func TestResultsCacheRetention(t *testing.T) {
+	t.Setenv("QUICKFEED_RESULTS_CACHE_RETENTION", "")
+	if got, err := env.ResultsCacheRetention(); err != nil || got != 30*24*time.Hour {
+		t.Errorf("ResultsCacheRetention() = %v, %v, want %v", got, err, 30*24*time.Hour)
+	}
+	t.Setenv("QUICKFEED_RESULTS_CACHE_RETENTION", "x")
+	if _, err := env.ResultsCacheRetention(); err == nil {
+		t.Error("ResultsCacheRetention() succeeded for invalid retention")
+	}
+}
@@ -0,0 +1,29 @@
+package env
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	resultsCachePath      = "QUICKFEED_RESULTS_CACHE_PATH"
+	resultsCacheRetention = "QUICKFEED_RESULTS_CACHE_RETENTION"
+
+	defaultResultsCacheRetention = 30 // days
+)
+
+// ResultsCachePath returns the directory in which the results of test runs are cached,
+// obtained from the QUICKFEED_RESULTS_CACHE_PATH environment variable. Defaults to $QUICKFEED/results-cache.
+func ResultsCachePath() string {
+	if path := os.Getenv(resultsCachePath); path != "" {
+		return os.ExpandEnv(path)
+	}
+	return Root("results-cache")
+}
+
+// ResultsCacheRetention returns the duration to keep cached test results, obtained from
+// the QUICKFEED_RESULTS_CACHE_RETENTION environment variable given in days. Defaults to 30 days.
+// A retention of zero days keeps cached results forever.
+func ResultsCacheRetention() (time.Duration, error) {
+	return retention(resultsCacheRetention, defaultResultsCacheRetention)
+}
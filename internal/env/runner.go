@@ -0,0 +1,63 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	runnerEngine  = "QUICKFEED_RUNNER"
+	podmanHost    = "QUICKFEED_PODMAN_HOST"
+	runnerWorkers = "QUICKFEED_RUNNER_WORKERS"
+	runnerToken   = "QUICKFEED_RUNNER_TOKEN" // skipcq: SCT-A000
+)
+
+// Supported container engines for running tests.
+const (
+	DockerEngine = "docker"
+	PodmanEngine = "podman"
+)
+
+// RunnerEngine returns the container engine used to run tests, obtained from
+// the QUICKFEED_RUNNER environment variable. Defaults to docker.
+func RunnerEngine() string {
+	engine := strings.ToLower(strings.TrimSpace(os.Getenv(runnerEngine)))
+	if engine == "" {
+		return DockerEngine
+	}
+	return engine
+}
+
+// PodmanHost returns the address of the Podman API socket, obtained from the
+// QUICKFEED_PODMAN_HOST environment variable. Defaults to the current user's
+// rootless Podman socket, e.g., unix:///run/user/1000/podman/podman.sock.
+func PodmanHost() string {
+	if host := os.Getenv(podmanHost); host != "" {
+		return host
+	}
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		runtimeDir = fmt.Sprintf("/run/user/%d", os.Getuid())
+	}
+	return "unix://" + runtimeDir + "/podman/podman.sock"
+}
+
+// RunnerWorkers returns the URLs of the remote workers used to run tests,
+// obtained from the comma-separated QUICKFEED_RUNNER_WORKERS environment variable.
+// If no workers are specified, tests are run on the QuickFeed server's host.
+func RunnerWorkers() []string {
+	var workers []string
+	for url := range strings.SplitSeq(os.Getenv(runnerWorkers), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			workers = append(workers, url)
+		}
+	}
+	return workers
+}
+
+// RunnerToken returns the token used to authenticate the QuickFeed server
+// with remote workers, obtained from the QUICKFEED_RUNNER_TOKEN environment variable.
+func RunnerToken() string {
+	return os.Getenv(runnerToken)
+}
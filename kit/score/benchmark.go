@@ -0,0 +1,104 @@
+package score
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+// Threshold defines how a benchmark metric is scored. A metric value at or below
+// the full threshold obtains full points, and a value at or above the zero threshold
+// obtains no points. Values in between obtain points in proportion to how close they
+// are to the full threshold.
+type Threshold struct {
+	metric string
+	value  func(testing.BenchmarkResult) float64
+	full   float64
+	zero   float64
+}
+
+// NsPerOp returns a threshold for the time per operation of a benchmark.
+func NsPerOp(full, zero time.Duration) Threshold {
+	return Threshold{
+		metric: "ns/op",
+		value:  nsPerOp,
+		full:   float64(full.Nanoseconds()),
+		zero:   float64(zero.Nanoseconds()),
+	}
+}
+
+// AllocsPerOp returns a threshold for the number of allocations per operation of a benchmark.
+func AllocsPerOp(full, zero int64) Threshold {
+	return Threshold{
+		metric: "allocs/op",
+		value:  func(r testing.BenchmarkResult) float64 { return float64(r.AllocsPerOp()) },
+		full:   float64(full),
+		zero:   float64(zero),
+	}
+}
+
+// BytesPerOp returns a threshold for the number of bytes allocated per operation of a benchmark.
+func BytesPerOp(full, zero int64) Threshold {
+	return Threshold{
+		metric: "B/op",
+		value:  func(r testing.BenchmarkResult) float64 { return float64(r.AllocedBytesPerOp()) },
+		full:   float64(full),
+		zero:   float64(zero),
+	}
+}
+
+// fraction returns the fraction of the points obtained by the given benchmark result.
+func (th Threshold) fraction(result testing.BenchmarkResult) float64 {
+	v := th.value(result)
+	switch {
+	case v <= th.full:
+		return 1
+	case v >= th.zero:
+		return 0
+	}
+	return (th.zero - v) / (th.zero - th.full)
+}
+
+// Benchmark records the metrics of the given benchmark result in the score object, and sets
+// the score according to the given thresholds. Each threshold contributes an equal share of
+// the max score. Without thresholds, only the metrics are recorded. The benchmark result is
+// typically obtained by running a benchmark function from within a test with testing.Benchmark:
+//
+//	func TestCachePerformance(t *testing.T) {
+//	    sc := score.Max()
+//	    defer sc.Print(t)
+//	    result := testing.Benchmark(BenchmarkCache)
+//	    sc.Benchmark(t, result, score.NsPerOp(100*time.Nanosecond, time.Microsecond), score.AllocsPerOp(0, 10))
+//	}
+//
+// If the benchmark failed, the score is set to zero and the test fails.
+func (s *Score) Benchmark(t *testing.T, result testing.BenchmarkResult, thresholds ...Threshold) {
+	t.Helper()
+	if result.N == 0 {
+		s.Fail()
+		s.TestDetails += "benchmark failed\n"
+		t.Error("benchmark failed")
+		return
+	}
+	s.NsPerOp = nsPerOp(result)
+	s.AllocsPerOp = uint64(result.AllocsPerOp())
+	s.BytesPerOp = uint64(result.AllocedBytesPerOp())
+	if len(thresholds) == 0 {
+		return
+	}
+	var total float64
+	for _, th := range thresholds {
+		f := th.fraction(result)
+		if f < 1 {
+			s.TestDetails += fmt.Sprintf("%s: %.0f (full score at %.0f or less, zero score at %.0f or more)\n", th.metric, th.value(result), th.full, th.zero)
+		}
+		total += f
+	}
+	s.Score = int32(math.Round(float64(s.GetMaxScore()) * total / float64(len(thresholds))))
+}
+
+// nsPerOp returns the time per operation of the benchmark result in nanoseconds.
+func nsPerOp(result testing.BenchmarkResult) float64 {
+	return float64(result.T.Nanoseconds()) / float64(result.N)
+}
@@ -0,0 +1,36 @@
+package score
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBenchmark(t *testing.T) {
+	// 1000 operations taking 2µs, 4 allocations and 512 bytes each
+	result := testing.BenchmarkResult{N: 1000, T: 2 * time.Millisecond, MemAllocs: 4000, MemBytes: 512000}
+	tests := []struct {
+		name       string
+		thresholds []Threshold
+		wantScore  int32
+	}{
+		{name: "NoThresholds", thresholds: nil, wantScore: 10},
+		{name: "FullTime", thresholds: []Threshold{NsPerOp(2*time.Microsecond, 4*time.Microsecond)}, wantScore: 10},
+		{name: "HalfTime", thresholds: []Threshold{NsPerOp(time.Microsecond, 3*time.Microsecond)}, wantScore: 5},
+		{name: "ZeroTime", thresholds: []Threshold{NsPerOp(time.Microsecond, 2*time.Microsecond)}, wantScore: 0},
+		{name: "FullTimeZeroAllocs", thresholds: []Threshold{NsPerOp(3*time.Microsecond, 4*time.Microsecond), AllocsPerOp(0, 4)}, wantScore: 5},
+		{name: "QuarterBytes", thresholds: []Threshold{BytesPerOp(128, 640)}, wantScore: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := &Score{TestName: tt.name, MaxScore: 10, Weight: 1, Score: 10}
+			sc.Benchmark(t, result, tt.thresholds...)
+			if sc.GetScore() != tt.wantScore {
+				t.Errorf("Benchmark() score = %d, want %d", sc.GetScore(), tt.wantScore)
+			}
+			if sc.GetNsPerOp() != 2000 || sc.GetAllocsPerOp() != 4 || sc.GetBytesPerOp() != 512 {
+				t.Errorf("Benchmark() metrics = (%v ns/op, %d allocs/op, %d B/op), want (2000 ns/op, 4 allocs/op, 512 B/op)",
+					sc.GetNsPerOp(), sc.GetAllocsPerOp(), sc.GetBytesPerOp())
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package score
+
+// Percent returns the percentage of covered statements or lines in all packages,
+// or zero if no statements or lines were found.
+func (c *Coverage) Percent() float64 {
+	if c.GetTotal() == 0 {
+		return 0
+	}
+	return 100 * float64(c.GetCovered()) / float64(c.GetTotal())
+}
+
+// Percent returns the percentage of covered statements or lines in the package,
+// or zero if no statements or lines were found.
+func (p *PackageCoverage) Percent() float64 {
+	if p.GetTotal() == 0 {
+		return 0
+	}
+	return 100 * float64(p.GetCovered()) / float64(p.GetTotal())
+}
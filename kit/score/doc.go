@@ -17,6 +17,20 @@
 //	TaskWeight[i]   = Weight[i] / TotalWeight
 //	TotalScore      = sum(TaskScore[i]*TaskWeight[i]), gives {0 < TotalScore < 1}
 //
+// Tests may be placed in nested score groups, each with its own weight. The score of
+// a group is computed from its tests and subgroups with the formulas above, and the
+// group then counts as a single test with the group's weight in the enclosing group.
+// Groups are added with score.AddGroup(), using a slash-separated path for nested groups,
+// and tests are added to a group with score.AddToGroup() or score.AddSubToGroup().
+// For example, to give TestParser's subtests together the same weight as TestLexer:
+//
+//	func init() {
+//	    score.Add(TestLexer, 10, 1)
+//	    score.AddGroup("parser", 1)
+//	    score.AddSubToGroup(TestParser, "Expressions", "parser", 10, 2)
+//	    score.AddSubToGroup(TestParser, "Statements", "parser", 10, 1)
+//	}
+//
 // QuickFeed expects that tests are initialized in the init() method before test execution.
 // This is done via the score.Add() method or the score.AddSub() method as shown below.
 // Add() is used for regular tests, and AddSub() is used for subtests with individual scores.
@@ -82,5 +96,29 @@
 //	    }
 //	}
 //
+// A score object may also obtain partial points with sc.IncByPoints() and sc.DecByPoints(),
+// e.g., sc.IncByPoints(0.5). The partial points are recorded in the Points field, while
+// the Score field holds the points rounded down.
+//
+// The score printed by sc.Print(t) includes the test's execution time in microseconds,
+// measured from when the score object was obtained with score.Max() or score.Min().
+// If the environment variable SCORE_MEMORY is set, the number of bytes allocated
+// while the test ran is also included; allocations by tests running in parallel
+// are counted as well. A test may instead set sc.ExecTime or sc.Memory itself,
+// e.g., to only measure the code under test. Tests written in other languages may
+// report the same metrics in the ExecTime and Memory fields of their JSON score line.
+//
+// To grade performance, a test may run a benchmark function with testing.Benchmark and
+// use sc.Benchmark() to score the result against teacher-defined thresholds for
+// the time, allocations, or bytes allocated per operation. The benchmark metrics
+// are recorded with the score, and may be used to rank submissions on a leaderboard.
+//
+//	func TestCachePerformance(t *testing.T) {
+//	    sc := score.Max()
+//	    defer sc.Print(t)
+//	    result := testing.Benchmark(BenchmarkCache)
+//	    sc.Benchmark(t, result, score.NsPerOp(100*time.Nanosecond, time.Microsecond))
+//	}
+//
 // Please see package score/testdata/sequence for other usage examples.
 package score
@@ -0,0 +1,59 @@
+package score
+
+import (
+	"os"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// If the environment variable SCORE_MEMORY is set to a non-empty value,
+// the number of bytes allocated while a test runs is recorded in its score.
+const memoryEnvName = "SCORE_MEMORY"
+
+// testStart holds the time at which a test obtained its score object,
+// and the number of bytes allocated at that time if memory is measured.
+type testStart struct {
+	time  time.Time
+	alloc uint64
+}
+
+// started maps score objects obtained from a registry to the start of their tests.
+// Tests may run in parallel, hence the sync.Map.
+var started sync.Map // map[*Score]testStart
+
+// start records the start of the test that obtained the score object,
+// and clears the metrics of an earlier run of the test.
+func (s *Score) start() {
+	s.ExecTime, s.Memory = 0, 0
+	st := testStart{time: time.Now()}
+	if os.Getenv(memoryEnvName) != "" {
+		st.alloc = totalAlloc()
+	}
+	started.Store(s, st)
+}
+
+// stop records the execution time of the test and, if measured, the number of bytes
+// allocated since the test started. Metrics set by the test itself are not replaced.
+func (s *Score) stop() {
+	v, ok := started.LoadAndDelete(s)
+	if !ok {
+		// the score object was not obtained from a registry
+		return
+	}
+	st := v.(testStart)
+	if s.GetExecTime() == 0 {
+		s.ExecTime = time.Since(st.time).Microseconds()
+	}
+	if s.GetMemory() == 0 && os.Getenv(memoryEnvName) != "" {
+		// allocations by tests running in parallel are included
+		s.Memory = totalAlloc() - st.alloc
+	}
+}
+
+// totalAlloc returns the cumulative number of bytes allocated on the heap.
+func totalAlloc() uint64 {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return m.TotalAlloc
+}
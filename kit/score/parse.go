@@ -3,6 +3,7 @@ package score
 import (
 	"encoding/json"
 	"errors"
+	"slices"
 	"strings"
 
 	"github.com/quickfeed/quickfeed/kit/internal/test"
@@ -14,6 +15,8 @@ var (
 	ErrScoreInterval    = errors.New("score must be in the interval [0, MaxScore]")
 	ErrMaxScore         = errors.New("max score must be greater than 0")
 	ErrWeight           = errors.New("weight must be greater than 0")
+	ErrGroupWeights     = errors.New("group weights must be greater than 0, and given for each nested group")
+	ErrExecTime         = errors.New("execution time must not be negative")
 	ErrEmptyTestName    = errors.New("test name must be specified")
 	ErrSecret           = errors.New("secret field must match expected secret")
 	ErrSuppressedSecret = errors.New("error suppressed to avoid revealing secret")
@@ -58,6 +61,16 @@ func (sc *Score) isValid(secret string) error {
 	if sc.GetScore() < 0 || sc.GetScore() > sc.GetMaxScore() {
 		return test.ErrMsg(tName, ErrScoreInterval.Error())
 	}
+	if sc.GetPoints() < 0 || sc.GetPoints() > float64(sc.GetMaxScore()) {
+		return test.ErrMsg(tName, ErrScoreInterval.Error())
+	}
+	if weights := sc.GetGroupWeights(); len(weights) > 0 && len(weights) != len(sc.groupPath()) ||
+		slices.ContainsFunc(weights, func(w int32) bool { return w <= 0 }) {
+		return test.ErrMsg(tName, ErrGroupWeights.Error())
+	}
+	if sc.GetExecTime() < 0 {
+		return test.ErrMsg(tName, ErrExecTime.Error())
+	}
 	if sc.GetSecret() != secret {
 		return test.ErrMsg(tName, ErrSecret.Error())
 	}
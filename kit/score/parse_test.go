@@ -106,6 +106,30 @@ var scoreValidTests = []struct {
 		},
 		want: ErrScoreInterval,
 	},
+	{
+		name: "BadPoints",
+		in: []*Score{
+			{TestName: "BadPoints", Secret: theSecret, Weight: 10, MaxScore: 100, Points: -0.5},
+			{TestName: "BadPoints", Secret: theSecret, Weight: 10, MaxScore: 100, Score: 100, Points: 100.5},
+		},
+		want: ErrScoreInterval,
+	},
+	{
+		name: "BadGroupWeights",
+		in: []*Score{
+			{TestName: "BadGroupWeights", Secret: theSecret, Weight: 10, MaxScore: 100, Group: "a/b", GroupWeights: []int32{1}},
+			{TestName: "BadGroupWeights", Secret: theSecret, Weight: 10, MaxScore: 100, Group: "a", GroupWeights: []int32{0}},
+			{TestName: "BadGroupWeights", Secret: theSecret, Weight: 10, MaxScore: 100, GroupWeights: []int32{1}},
+		},
+		want: ErrGroupWeights,
+	},
+	{
+		name: "BadExecTime",
+		in: []*Score{
+			{TestName: "BadExecTime", Secret: theSecret, Weight: 10, MaxScore: 100, Score: 0, ExecTime: -1},
+		},
+		want: ErrExecTime,
+	},
 	{
 		name: "BadSecret",
 		in: []*Score{
@@ -125,6 +149,9 @@ var scoreValidTests = []struct {
 			{TestName: "GoodScoreS", Secret: theSecret, Weight: 10, MaxScore: 100, Score: 10},
 			{TestName: "GoodScoreS", Secret: theSecret, Weight: 10, MaxScore: 100, Score: 50},
 			{TestName: "GoodScoreS", Secret: theSecret, Weight: 10, MaxScore: 100, Score: 100},
+			{TestName: "GoodScoreP", Secret: theSecret, Weight: 10, MaxScore: 100, Score: 50, Points: 50.5},
+			{TestName: "GoodScoreG", Secret: theSecret, Weight: 10, MaxScore: 100, Group: "a/b", GroupWeights: []int32{1, 2}},
+			{TestName: "GoodScoreG", Secret: theSecret, Weight: 10, MaxScore: 100, Group: "a/b"},
 		},
 		want: nil,
 	},
@@ -158,11 +185,16 @@ func clone(src []*Score) []*Score {
 			continue
 		}
 		dst[i] = &Score{
-			Secret:   sc.Secret,
-			TestName: sc.TestName,
-			Score:    sc.Score,
-			MaxScore: sc.MaxScore,
-			Weight:   sc.Weight,
+			Secret:       sc.Secret,
+			TestName:     sc.TestName,
+			Score:        sc.Score,
+			MaxScore:     sc.MaxScore,
+			Weight:       sc.Weight,
+			ExecTime:     sc.ExecTime,
+			Memory:       sc.Memory,
+			Points:       sc.Points,
+			Group:        sc.Group,
+			GroupWeights: sc.GroupWeights,
 		}
 	}
 	return dst
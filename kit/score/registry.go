@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
+	"strings"
 
 	"github.com/quickfeed/quickfeed/kit/internal/test"
 	"github.com/quickfeed/quickfeed/kit/sh"
@@ -15,12 +17,14 @@ import (
 type registry struct {
 	testNames []string          // testNames in registration order
 	scores    map[string]*Score // map from TestName to score object
+	groups    map[string]int32  // map from group path to the group's weight
 }
 
 func NewRegistry() *registry { // skipcq: RVV-B0011
 	return &registry{
 		testNames: make([]string, 0),
 		scores:    make(map[string]*Score),
+		groups:    make(map[string]int32),
 	}
 }
 
@@ -75,7 +79,7 @@ func (s *registry) PrintTestInfo(sorted ...bool) {
 //
 // Will panic if the test has already been registered or if max or weight is non-positive.
 func (s *registry) Add(testFn any, max, weight int) {
-	s.internalAdd(test.Name(testFn), "", max, weight)
+	s.internalAdd(test.Name(testFn), "", "", max, weight)
 }
 
 // AddWithTask test with given taskName, max score and weight to the registry.
@@ -83,7 +87,7 @@ func (s *registry) Add(testFn any, max, weight int) {
 //
 // Will panic if the test has already been registered or if max or weight is non-positive.
 func (s *registry) AddWithTask(testFn any, taskName string, max, weight int) {
-	s.internalAdd(test.Name(testFn), taskName, max, weight)
+	s.internalAdd(test.Name(testFn), taskName, "", max, weight)
 }
 
 // AddSub test with given max score and weight to the registry.
@@ -93,7 +97,7 @@ func (s *registry) AddWithTask(testFn any, taskName string, max, weight int) {
 // Will panic if the test has already been registered or if max or weight is non-positive.
 func (s *registry) AddSub(testFn any, subTestName string, max, weight int) {
 	tstName := fmt.Sprintf("%s/%s", test.Name(testFn), subTestName)
-	s.internalAdd(tstName, "", max, weight)
+	s.internalAdd(tstName, "", "", max, weight)
 }
 
 // AddSubWithTask test with given taskName, max score and weight to the registry.
@@ -104,7 +108,69 @@ func (s *registry) AddSub(testFn any, subTestName string, max, weight int) {
 // Will panic if the test has already been registered or if max or weight is non-positive.
 func (s *registry) AddSubWithTask(testFn any, subTestName, taskName string, max, weight int) {
 	tstName := fmt.Sprintf("%s/%s", test.Name(testFn), subTestName)
-	s.internalAdd(tstName, taskName, max, weight)
+	s.internalAdd(tstName, taskName, "", max, weight)
+}
+
+// AddGroup adds a score group with the given weight to the registry. Tests added to the group
+// are first aggregated into the group's score, using the weights of the tests, and the group's
+// score then counts toward the total score with the group's weight. Groups may be nested by
+// using a slash-separated group path, e.g., "parser/lexer"; the enclosing group must be added first.
+//
+// Will panic if the group path is invalid, if the group has already been added,
+// if the enclosing group hasn't been added, or if weight is non-positive.
+func (s *registry) AddGroup(group string, weight int) {
+	if group == "" || slices.Contains(strings.Split(group, "/"), "") {
+		panic(test.ErrMsg(group, ErrInvalidScoreGroup.Error()))
+	}
+	if _, found := s.groups[group]; found {
+		panic(test.ErrMsg(group, ErrDuplicateScoreGroup.Error()))
+	}
+	if i := strings.LastIndex(group, "/"); i >= 0 {
+		if _, found := s.groups[group[:i]]; !found {
+			panic(test.ErrMsg(group[:i], ErrUnknownScoreGroup.Error()))
+		}
+	}
+	if weight < 1 {
+		panic(test.ErrMsg(group, ErrWeight.Error()))
+	}
+	s.groups[group] = int32(weight)
+}
+
+// AddToGroup test with given group, max score and weight to the registry.
+// This function is identical to Add, with the addition of adding the test to a group added with AddGroup.
+//
+// Will panic if the test has already been registered, if the group hasn't been added,
+// or if max or weight is non-positive.
+func (s *registry) AddToGroup(testFn any, group string, max, weight int) {
+	s.internalAdd(test.Name(testFn), "", group, max, weight)
+}
+
+// AddSubToGroup test with given group, max score and weight to the registry.
+// This function is identical to AddSub, with the addition of adding the subtest to a group added with AddGroup.
+// This can be used to weight a test's subtests relative to each other, with the test as the group.
+//
+// Will panic if the test has already been registered, if the group hasn't been added,
+// or if max or weight is non-positive.
+func (s *registry) AddSubToGroup(testFn any, subTestName, group string, max, weight int) {
+	tstName := fmt.Sprintf("%s/%s", test.Name(testFn), subTestName)
+	s.internalAdd(tstName, "", group, max, weight)
+}
+
+// Hide marks the registered test as hidden. The name and details of a hidden test are
+// not shown to students until the assignment's deadline has passed, but its score still
+// counts toward the total score. Hide must be called after the test has been added.
+//
+// Will panic with unknown score test, if the test hasn't been added.
+func (s *registry) Hide(testFn any) {
+	s.internalHide(test.Name(testFn))
+}
+
+// HideSub marks the registered subtest as hidden. This function is identical to Hide,
+// but should be used for subtests registered with AddSub or AddSubWithTask.
+//
+// Will panic with unknown score test, if the subtest hasn't been added.
+func (s *registry) HideSub(testFn any, subTestName string) {
+	s.internalHide(fmt.Sprintf("%s/%s", test.Name(testFn), subTestName))
 }
 
 // Max returns a score object with Score equal to MaxScore.
@@ -114,7 +180,7 @@ func (s *registry) AddSubWithTask(testFn any, subTestName, taskName string, max,
 func (s *registry) Max() *Score {
 	testName := test.CallerName()
 	sc := s.get(testName)
-	sc.Score = sc.GetMaxScore()
+	sc.setPoints(float64(sc.GetMaxScore()))
 	return sc
 }
 
@@ -134,7 +200,7 @@ func (s *registry) Min() *Score {
 // Will panic with unknown score test, if the test hasn't been added.
 func (s *registry) MaxByName(testName string) *Score {
 	sc := s.get(testName)
-	sc.Score = sc.GetMaxScore()
+	sc.setPoints(float64(sc.GetMaxScore()))
 	return sc
 }
 
@@ -148,12 +214,15 @@ func (s *registry) MinByName(testName string) *Score {
 }
 
 var (
-	ErrDuplicateScoreTest = errors.New("duplicate score test")
-	ErrUnauthorizedLookup = errors.New("unauthorized lookup")
-	ErrUnknownScoreTest   = errors.New("unknown score test")
+	ErrDuplicateScoreTest  = errors.New("duplicate score test")
+	ErrDuplicateScoreGroup = errors.New("duplicate score group")
+	ErrInvalidScoreGroup   = errors.New("invalid score group")
+	ErrUnauthorizedLookup  = errors.New("unauthorized lookup")
+	ErrUnknownScoreTest    = errors.New("unknown score test")
+	ErrUnknownScoreGroup   = errors.New("unknown score group")
 )
 
-func (s *registry) internalAdd(testName, taskName string, max, weight int) {
+func (s *registry) internalAdd(testName, taskName, group string, max, weight int) {
 	if _, found := s.scores[testName]; found {
 		panic(test.ErrMsg(testName, ErrDuplicateScoreTest.Error()))
 	}
@@ -164,23 +233,51 @@ func (s *registry) internalAdd(testName, taskName string, max, weight int) {
 		panic(test.ErrMsg(testName, ErrWeight.Error()))
 	}
 	sc := &Score{
-		Secret:   sessionSecret,
-		TestName: testName,
-		TaskName: taskName,
-		MaxScore: int32(max),
-		Weight:   int32(weight),
+		Secret:       sessionSecret,
+		TestName:     testName,
+		TaskName:     taskName,
+		MaxScore:     int32(max),
+		Weight:       int32(weight),
+		Group:        group,
+		GroupWeights: s.groupWeights(group),
 	}
 	// record the TestName in separate slice to preserve registration order
 	s.testNames = append(s.testNames, testName)
 	s.scores[testName] = sc
 }
 
+// groupWeights returns the weights of the given group and its enclosing groups, outermost first.
+func (s *registry) groupWeights(group string) []int32 {
+	if group == "" {
+		return nil
+	}
+	var weights []int32
+	parts := strings.Split(group, "/")
+	for i := range parts {
+		weight, ok := s.groups[strings.Join(parts[:i+1], "/")]
+		if !ok {
+			panic(test.ErrMsg(group, ErrUnknownScoreGroup.Error()))
+		}
+		weights = append(weights, weight)
+	}
+	return weights
+}
+
+func (s *registry) internalHide(testName string) {
+	sc, ok := s.scores[testName]
+	if !ok {
+		panic(test.ErrMsg(testName, ErrUnknownScoreTest.Error()))
+	}
+	sc.Hidden = true
+}
+
 func (s *registry) get(testName string) *Score {
 	if !test.IsCaller(testName) {
 		// Only the registered Test function can call the lookup functions
 		panic(test.ErrMsg(testName, ErrUnauthorizedLookup.Error()))
 	}
 	if sc, ok := s.scores[testName]; ok {
+		sc.start()
 		return sc
 	}
 	panic(test.ErrMsg(testName, ErrUnknownScoreTest.Error()))
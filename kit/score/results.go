@@ -44,10 +44,11 @@ func (r *Results) toScoreSlice() []*Score {
 func (r *Results) addScore(sc *Score) {
 	testName := sc.GetTestName()
 	if current, found := r.scoreMap[testName]; found {
-		if current.GetScore() != 0 {
+		if current.points() != 0 {
 			// We reach here only if a second non-zero score is found for the same test.
 			// Mark it as faulty with -1.
 			sc.Score = -1
+			sc.Points = 0
 			sc.TestDetails = "(duplicate)"
 		}
 	} else {
@@ -86,42 +87,38 @@ func (r *Results) Sum() uint32 {
 // The total is a grade in the range 0-100.
 // This method must only be called after Validate has returned nil.
 func (r *Results) TaskSum(taskName string) uint32 {
-	total, _ := r.internalSum(taskName)
-	return uint32(math.Round(total * 100))
+	return uint32(math.Round(r.TaskFraction(taskName) * 100))
 }
 
-// internalSum returns the total score and total weight of the recorded scores for the given task.
-// The values are in the range 0-1.
-func (r *Results) internalSum(taskName string) (float64, float64) {
-	totalWeight := float64(0)
-	var maxScore, score, weight []float64
+// Fraction returns the total score of the recorded scores as a fraction in the range 0-1.
+// Unlike Sum, the total is not rounded.
+func (r *Results) Fraction() float64 {
+	return r.TaskFraction("")
+}
+
+// TaskFraction returns the total score of the recorded scores for the given task
+// as a fraction in the range 0-1. Scores in nested score groups are first aggregated
+// into their group's score, which then counts toward the enclosing group's score
+// with the group's weight.
+func (r *Results) TaskFraction(taskName string) float64 {
+	return r.scoreTree(taskName).fraction()
+}
+
+// scoreTree returns the tree of the recorded scores for the given task;
+// all recorded scores are included if taskName is empty.
+func (r *Results) scoreTree(taskName string) *scoreNode {
+	var scores []*Score
 	for _, ts := range r.Scores {
 		if taskName != "" && taskName != ts.GetTaskName() {
 			continue
 		}
-		// If the score is negative, it means that the test is faulty (e.g. duplicate).
-		// We need to set the score to zero to avoid certain edge cases where
-		// the total score would end up being -1 or lower. If not, the total score
-		// would end up being uint32(-1) = 4294967295. See issue #975
-		testScore := max(ts.GetScore(), 0)
-		totalWeight += float64(ts.GetWeight())
-		weight = append(weight, float64(ts.GetWeight()))
-		score = append(score, float64(testScore))
-		maxScore = append(maxScore, float64(ts.GetMaxScore()))
-	}
-	total := float64(0)
-	for i := 0; i < len(score); i++ {
-		if score[i] > maxScore[i] {
-			score[i] = maxScore[i]
+		if ts.GetStatus() == Score_UNEXPECTED {
+			// tests that are not expected do not count towards the total score
+			continue
 		}
-		total += weightedScore(score[i], maxScore[i], weight[i], totalWeight)
+		scores = append(scores, ts)
 	}
-	return total, totalWeight
-}
-
-// weightedScore returns the weighted score of a given test.
-func weightedScore(score, maxScore, weight, totalWeight float64) float64 {
-	return (score / maxScore) * (weight / totalWeight)
+	return newScoreTree(scores)
 }
 
 // parseErrors encountered during test execution.
@@ -142,14 +139,17 @@ func (pe parseErrors) Error() string {
 
 // ExtractResults returns the results from a test execution extracted from the given out string.
 // The provided zeroScoreTests must contain a zero score value for all tests that are expected
-// to be present in the results.
+// to be present in the results. The scores are classified as reported, missing or unexpected;
+// the zero scores of expected tests that do not report a score are marked as missing.
 func ExtractResults(out, secret string, execTime time.Duration, zeroScoreTests []*Score) (*Results, error) {
 	var filteredLog []string
 	errs := make(parseErrors, 0)
 	results := newResults()
 
-	// first, add all expected tests (assumed to already have zero scores)
+	// first, add all expected tests (assumed to already have zero scores);
+	// the expected tests are missing until they report a score
 	for _, expectedTest := range zeroScoreTests {
+		expectedTest.Status = Score_MISSING
 		results.addScore(expectedTest)
 	}
 
@@ -162,12 +162,14 @@ func ExtractResults(out, secret string, execTime time.Duration, zeroScoreTests [
 				errs = append(errs, fmt.Errorf("failed on line '%s': %w", line, err))
 				continue
 			}
-			// only add the score if it's in the expected tests
+			// scores for tests that are not expected are kept, but not counted in the total
+			sc.Status = Score_UNEXPECTED
 			if slices.ContainsFunc(zeroScoreTests, func(expected *Score) bool {
 				return expected.GetTestName() == sc.GetTestName()
 			}) {
-				results.addScore(sc)
+				sc.Status = Score_REPORTED
 			}
+			results.addScore(sc)
 		} else if line != "" { // include only non-empty lines
 			// the filtered log without JSON score strings
 			filteredLog = append(filteredLog, line)
@@ -188,6 +190,59 @@ func ExtractResults(out, secret string, execTime time.Duration, zeroScoreTests [
 	return res, nil
 }
 
+// Classify classifies the scores relative to the given expected tests. Scores for tests that
+// are not expected are marked as unexpected, and expected tests without a score are added
+// with a zero score marked as missing. Scores for expected tests that are hidden are marked
+// as hidden. The scores extracted by ExtractResults are already classified; Classify leaves
+// them unchanged, unless the expected tests have changed.
+func (r *Results) Classify(expectedTests []*Score) {
+	expected := make(map[string]*Score)
+	for _, expectedTest := range expectedTests {
+		expected[expectedTest.GetTestName()] = expectedTest
+	}
+	recorded := make(map[string]bool)
+	for _, sc := range r.Scores {
+		recorded[sc.GetTestName()] = true
+		expectedTest, ok := expected[sc.GetTestName()]
+		switch {
+		case !ok:
+			sc.Status = Score_UNEXPECTED
+		case sc.GetStatus() == Score_UNEXPECTED:
+			sc.Status = Score_REPORTED
+		}
+		if expectedTest.GetHidden() {
+			sc.Hidden = true
+		}
+	}
+	for _, expectedTest := range expectedTests {
+		if !recorded[expectedTest.GetTestName()] {
+			expectedTest.Score = 0
+			expectedTest.Status = Score_MISSING
+			r.Scores = append(r.Scores, expectedTest)
+		}
+	}
+}
+
+// MissingTests returns the names of the expected tests that did not report a score.
+func (r *Results) MissingTests() []string {
+	return r.testsWithStatus(Score_MISSING)
+}
+
+// UnexpectedTests returns the names of the tests that reported a score, but are not expected.
+func (r *Results) UnexpectedTests() []string {
+	return r.testsWithStatus(Score_UNEXPECTED)
+}
+
+func (r *Results) testsWithStatus(status Score_Status) []string {
+	var names []string
+	for _, sc := range r.Scores {
+		if sc.GetStatus() == status {
+			names = append(names, sc.GetTestName())
+		}
+	}
+	return names
+}
+
 // GetBuildInfo returns the build info for the results object after nil check.
 func (r *Results) GetBuildInfo() *BuildInfo {
 	if r != nil && r.BuildInfo != nil {
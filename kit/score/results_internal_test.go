@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"strings"
 	"testing"
 
@@ -370,6 +371,83 @@ func TestTaskSum(t *testing.T) {
 	}
 }
 
+func TestGroupSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		scores   []*Score
+		wantFrac float64
+		wantSum  uint32
+	}{
+		{
+			name: "PartialPoints",
+			scores: []*Score{
+				{TestName: "A", Score: 1, Points: 1.5, MaxScore: 2, Weight: 1},
+				{TestName: "B", Score: 0, Points: 0.5, MaxScore: 2, Weight: 1},
+			},
+			wantFrac: 0.5,
+			wantSum:  50,
+		},
+		{
+			// without groups, the total weight of B's subtests is 3 times the weight of A
+			name: "NoGroups",
+			scores: []*Score{
+				{TestName: "A", Score: 1, MaxScore: 1, Weight: 1},
+				{TestName: "B/1", Score: 0, MaxScore: 1, Weight: 1},
+				{TestName: "B/2", Score: 0, MaxScore: 1, Weight: 1},
+				{TestName: "B/3", Score: 1, MaxScore: 1, Weight: 1},
+			},
+			wantFrac: 0.5,
+			wantSum:  50,
+		},
+		{
+			// with B's subtests in a group, B and A have equal weight
+			name: "Groups",
+			scores: []*Score{
+				{TestName: "A", Score: 1, MaxScore: 1, Weight: 1},
+				{TestName: "B/1", Group: "B", GroupWeights: []int32{1}, Score: 0, MaxScore: 1, Weight: 1},
+				{TestName: "B/2", Group: "B", GroupWeights: []int32{1}, Score: 0, MaxScore: 1, Weight: 1},
+				{TestName: "B/3", Group: "B", GroupWeights: []int32{1}, Score: 1, MaxScore: 1, Weight: 1},
+			},
+			wantFrac: 2.0 / 3.0,
+			wantSum:  67,
+		},
+		{
+			// task (weight 3) -> test (weight 1 each) -> subtests
+			name: "NestedGroups",
+			scores: []*Score{
+				{TestName: "A", Score: 1, MaxScore: 1, Weight: 1},
+				{TestName: "B/1", Group: "task/B", GroupWeights: []int32{3, 1}, Score: 1, MaxScore: 1, Weight: 3},
+				{TestName: "B/2", Group: "task/B", GroupWeights: []int32{3, 1}, Score: 0, MaxScore: 1, Weight: 1},
+				{TestName: "C", Group: "task", GroupWeights: []int32{3}, Score: 1, Points: 1.5, MaxScore: 3, Weight: 1},
+			},
+			// task = (0.75 + 0.5) / 2 = 0.625; total = (1 + 3*0.625) / 4
+			wantFrac: 0.71875,
+			wantSum:  72,
+		},
+		{
+			name: "UnexpectedTestInGroup",
+			scores: []*Score{
+				{TestName: "A", Group: "g", GroupWeights: []int32{1}, Score: 1, MaxScore: 1, Weight: 1},
+				{TestName: "B", Group: "g", GroupWeights: []int32{1}, Score: 0, MaxScore: 1, Weight: 1, Status: Score_UNEXPECTED},
+				{TestName: "C", Score: 0, MaxScore: 1, Weight: 1},
+			},
+			wantFrac: 0.5,
+			wantSum:  50,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := newResults(tt.scores...)
+			if got := results.Fraction(); math.Abs(got-tt.wantFrac) > 1e-9 {
+				t.Errorf("Fraction() = %g, expected %g", got, tt.wantFrac)
+			}
+			if got := results.Sum(); got != tt.wantSum {
+				t.Errorf("Sum() = %d, expected %d", got, tt.wantSum)
+			}
+		})
+	}
+}
+
 func TestValidate(t *testing.T) {
 	validateScores := []struct {
 		desc    string
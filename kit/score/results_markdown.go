@@ -23,16 +23,20 @@ func (r *Results) MarkdownComment(taskLocalName string, scoreLimit uint32) strin
 | :-------- | ----: | -----: | ---------: |
 `
 
-	total, totalWeight := r.internalSum(taskLocalName)
+	tree := r.scoreTree(taskLocalName)
+	shares := make(map[*Score]float64)
+	tree.shares(1, func(sc *Score, share float64) {
+		shares[sc] = share
+	})
 	for _, sc := range r.Scores {
 		if sc.GetTaskName() != taskLocalName {
 			continue
 		}
-		weightedScore := sc.weightedScore(totalWeight)
-		body += fmt.Sprintf("| %s | %d/%d | %d | %.1f%% |\n",
-			sc.GetTestName(), sc.GetScore(), sc.GetMaxScore(), sc.GetWeight(), weightedScore*100)
+		weightedScore := sc.Fraction() * shares[sc]
+		body += fmt.Sprintf("| %s | %g/%d | %d | %.1f%% |\n",
+			sc.GetTestName(), sc.points(), sc.GetMaxScore(), sc.GetWeight(), weightedScore*100)
 	}
-	body += fmt.Sprintf("| **Total** | | | **%.1f%%** |\n\n", total*100)
+	body += fmt.Sprintf("| **Total** | | | **%.1f%%** |\n\n", tree.fraction()*100)
 	body += fmt.Sprintf("Reviewers are assigned once the total score reaches %d%%.\n", scoreLimit)
 	return body
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/quickfeed/quickfeed/kit/score"
 )
 
@@ -79,6 +80,29 @@ Here are some more logs for the student.
 	}
 }
 
+func TestExtractResultsMissingTests(t *testing.T) {
+	out := `here is some output in the log.
+
+{"Secret":"59fd5fe1c4f741604c1beeab875b9c789d2a7c73","TestName":"Gradle","Score":0,"MaxScore":100,"Weight":1}
+`
+
+	expectedTests := []*score.Score{
+		{TestName: "Gradle", Score: 0, MaxScore: 100, Weight: 1},
+		{TestName: "JoGo", Score: 0, MaxScore: 100, Weight: 1},
+	}
+	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10, expectedTests)
+	if err != nil {
+		// err may contain multiple errors
+		t.Fatal(err)
+	}
+	if len(res.Scores) != 2 {
+		t.Fatalf("ExtractResult() expected 2 Score entries, got %d: %+v", len(res.Scores), res.Scores)
+	}
+	if missing := res.MissingTests(); len(missing) != 1 || missing[0] != "JoGo" {
+		t.Errorf("ExtractResult() expected missing test JoGo, got %v", missing)
+	}
+}
+
 func TestExtractResultsWithMultipleZeroScoreLines(t *testing.T) {
 	out := `
     {"Secret":"59fd5fe1c4f741604c1beeab875b9c789d2a7c73","TestName":"Gradle","Score":0,"MaxScore":100,"Weight":1}
@@ -214,6 +238,28 @@ func TestExtractResultsExecTime(t *testing.T) {
 	}
 }
 
+func TestExtractResultsTestMetrics(t *testing.T) {
+	out := `{"Secret":"59fd5fe1c4f741604c1beeab875b9c789d2a7c73","TestName":"TestFast","Score":1,"MaxScore":1,"Weight":1,"ExecTime":125}
+{"Secret":"59fd5fe1c4f741604c1beeab875b9c789d2a7c73","TestName":"TestSlow","Score":1,"MaxScore":1,"Weight":1,"ExecTime":2500000,"Memory":4096}
+`
+	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotExecTimes []int64
+	var gotMemory []uint64
+	for _, sc := range res.Scores {
+		gotExecTimes = append(gotExecTimes, sc.GetExecTime())
+		gotMemory = append(gotMemory, sc.GetMemory())
+	}
+	if diff := cmp.Diff([]int64{125, 2500000}, gotExecTimes); diff != "" {
+		t.Errorf("ExtractResults() ExecTime mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]uint64{0, 4096}, gotMemory); diff != "" {
+		t.Errorf("ExtractResults() Memory mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestExtractResultsWithExpectedTests(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -222,22 +268,28 @@ func TestExtractResultsWithExpectedTests(t *testing.T) {
 		expectedTests []*score.Score
 		wantTestNames []string
 		wantScores    []int32
+		wantStatuses  []score.Score_Status
+		wantSum       uint32
 	}{
 		{
 			name:          "NilExpectedTests",
 			out:           `{"Secret":"secret","TestName":"TestA","Score":80,"MaxScore":100,"Weight":1}`,
 			secret:        "secret",
 			expectedTests: nil,
-			wantTestNames: []string{},
-			wantScores:    []int32{},
+			wantTestNames: []string{"TestA"},
+			wantScores:    []int32{80},
+			wantStatuses:  []score.Score_Status{score.Score_UNEXPECTED},
+			wantSum:       0, // unexpected tests are not counted
 		},
 		{
 			name:          "EmptyExpectedTests",
 			out:           `{"Secret":"secret","TestName":"TestA","Score":80,"MaxScore":100,"Weight":1}`,
 			secret:        "secret",
 			expectedTests: []*score.Score{},
-			wantTestNames: []string{},
-			wantScores:    []int32{},
+			wantTestNames: []string{"TestA"},
+			wantScores:    []int32{80},
+			wantStatuses:  []score.Score_Status{score.Score_UNEXPECTED},
+			wantSum:       0, // unexpected tests are not counted
 		},
 		{
 			name:          "AllPresent",
@@ -246,6 +298,8 @@ func TestExtractResultsWithExpectedTests(t *testing.T) {
 			expectedTests: []*score.Score{{TestName: "TestA", MaxScore: 100, Weight: 1}, {TestName: "TestB", MaxScore: 50, Weight: 2}},
 			wantTestNames: []string{"TestA", "TestB"},
 			wantScores:    []int32{80, 40},
+			wantStatuses:  []score.Score_Status{score.Score_REPORTED, score.Score_REPORTED},
+			wantSum:       80,
 		},
 		{
 			name:          "MissingTest",
@@ -254,14 +308,18 @@ func TestExtractResultsWithExpectedTests(t *testing.T) {
 			expectedTests: []*score.Score{{TestName: "TestA", MaxScore: 100, Weight: 1}, {TestName: "TestB", MaxScore: 50, Weight: 2}},
 			wantTestNames: []string{"TestA", "TestB"},
 			wantScores:    []int32{80, 0}, // TestB should have score 0
+			wantStatuses:  []score.Score_Status{score.Score_REPORTED, score.Score_MISSING},
+			wantSum:       27,
 		},
 		{
-			name:          "UnexpectedTestFiltered",
+			name:          "UnexpectedTestNotCounted",
 			out:           `{"Secret":"secret","TestName":"TestA","Score":80,"MaxScore":100,"Weight":1}` + "\n" + `{"Secret":"secret","TestName":"TestX","Score":90,"MaxScore":100,"Weight":1}`,
 			secret:        "secret",
 			expectedTests: []*score.Score{{TestName: "TestA", MaxScore: 100, Weight: 1}},
-			wantTestNames: []string{"TestA"},
-			wantScores:    []int32{80}, // TestX should be filtered out
+			wantTestNames: []string{"TestA", "TestX"},
+			wantScores:    []int32{80, 90},
+			wantStatuses:  []score.Score_Status{score.Score_REPORTED, score.Score_UNEXPECTED},
+			wantSum:       80, // TestX should not be counted
 		},
 		{
 			name:          "EmptyOutput",
@@ -270,6 +328,8 @@ func TestExtractResultsWithExpectedTests(t *testing.T) {
 			expectedTests: []*score.Score{{TestName: "TestA", MaxScore: 100, Weight: 1}, {TestName: "TestB", MaxScore: 50, Weight: 2}},
 			wantTestNames: []string{"TestA", "TestB"},
 			wantScores:    []int32{0, 0}, // All tests should have score 0
+			wantStatuses:  []score.Score_Status{score.Score_MISSING, score.Score_MISSING},
+			wantSum:       0,
 		},
 	}
 
@@ -284,22 +344,91 @@ func TestExtractResultsWithExpectedTests(t *testing.T) {
 				t.Errorf("Expected %d scores, got %d", len(test.wantTestNames), len(results.Scores))
 			}
 
-			// Check test names and scores
-			scoreMap := make(map[string]int32)
+			// Check test names, scores and statuses
+			scoreMap := make(map[string]*score.Score)
 			for _, score := range results.Scores {
-				scoreMap[score.GetTestName()] = score.GetScore()
+				scoreMap[score.GetTestName()] = score
 			}
 
 			for i, wantTestName := range test.wantTestNames {
-				gotScore, found := scoreMap[wantTestName]
+				got, found := scoreMap[wantTestName]
 				if !found {
 					t.Errorf("Expected test %s not found in results", wantTestName)
 					continue
 				}
-				if gotScore != test.wantScores[i] {
-					t.Errorf("Test %s: expected score %d, got %d", wantTestName, test.wantScores[i], gotScore)
+				if got.GetScore() != test.wantScores[i] {
+					t.Errorf("Test %s: expected score %d, got %d", wantTestName, test.wantScores[i], got.GetScore())
+				}
+				if got.GetStatus() != test.wantStatuses[i] {
+					t.Errorf("Test %s: expected status %v, got %v", wantTestName, test.wantStatuses[i], got.GetStatus())
 				}
 			}
+			if sum := results.Sum(); sum != test.wantSum {
+				t.Errorf("Sum() = %d, want %d", sum, test.wantSum)
+			}
 		})
 	}
 }
+
+func TestClassify(t *testing.T) {
+	results := &score.Results{
+		Scores: []*score.Score{
+			{TestName: "TestA", Score: 80, MaxScore: 100, Weight: 1},
+			{TestName: "TestX", Score: 90, MaxScore: 100, Weight: 1},
+		},
+	}
+	expectedTests := []*score.Score{
+		{TestName: "TestA", MaxScore: 100, Weight: 1},
+		{TestName: "TestB", MaxScore: 100, Weight: 1},
+	}
+	results.Classify(expectedTests)
+
+	statuses := make(map[string]score.Score_Status)
+	for _, sc := range results.Scores {
+		statuses[sc.GetTestName()] = sc.GetStatus()
+	}
+	want := map[string]score.Score_Status{
+		"TestA": score.Score_REPORTED,
+		"TestB": score.Score_MISSING,
+		"TestX": score.Score_UNEXPECTED,
+	}
+	if diff := cmp.Diff(want, statuses); diff != "" {
+		t.Errorf("Classify() mismatch (-want +got):\n%s", diff)
+	}
+	if sum := results.Sum(); sum != 40 {
+		t.Errorf("Sum() = %d, want 40", sum)
+	}
+	// classifying again leaves the scores unchanged
+	results.Classify(expectedTests)
+	if len(results.Scores) != 3 {
+		t.Errorf("Classify() twice: got %d scores, want 3", len(results.Scores))
+	}
+}
+
+func TestClassifyHidden(t *testing.T) {
+	results := &score.Results{
+		Scores: []*score.Score{
+			{TestName: "TestA", Score: 80, MaxScore: 100, Weight: 1},
+			{TestName: "TestEdgeCases", Score: 90, MaxScore: 100, Weight: 1},
+		},
+	}
+	expectedTests := []*score.Score{
+		{TestName: "TestA", MaxScore: 100, Weight: 1},
+		{TestName: "TestEdgeCases", MaxScore: 100, Weight: 1, Hidden: true},
+		{TestName: "TestHardcoding", MaxScore: 100, Weight: 1, Hidden: true},
+	}
+	results.Classify(expectedTests)
+
+	hidden := make(map[string]bool)
+	for _, sc := range results.Scores {
+		hidden[sc.GetTestName()] = sc.GetHidden()
+	}
+	want := map[string]bool{
+		"TestA":          false,
+		"TestEdgeCases":  true,
+		"TestHardcoding": true,
+	}
+	if diff := cmp.Diff(want, hidden); diff != "" {
+		t.Errorf("Classify() hidden mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -12,54 +12,81 @@ import (
 
 // Fail sets Score to zero.
 func (s *Score) Fail() {
-	s.Score = 0
+	s.setPoints(0)
 }
 
 // Inc increments score if score is less than MaxScore.
 func (s *Score) Inc() {
-	if s.GetScore() < s.GetMaxScore() {
-		s.Score++
-	}
+	s.IncByPoints(1)
 }
 
 // IncBy increments score n times or until score equals MaxScore.
 func (s *Score) IncBy(n int) {
-	m := int32(n)
-	if s.GetScore()+m < s.GetMaxScore() {
-		s.Score += m
-	} else {
-		s.Score = s.GetMaxScore()
-	}
+	s.IncByPoints(float64(n))
 }
 
 // Dec decrements score if score is greater than zero.
 func (s *Score) Dec() {
-	if s.GetScore() > 0 {
-		s.Score--
-	}
+	s.DecByPoints(1)
 }
 
 // DecBy decrements score n times or until Score equals zero.
 func (s *Score) DecBy(n int) {
-	m := int32(n)
-	if s.GetScore()-m > 0 {
-		s.Score -= m
-	} else {
-		s.Score = 0
+	s.DecByPoints(float64(n))
+}
+
+// IncByPoints increments score by the given points, which may be fractional, or until score equals MaxScore.
+func (s *Score) IncByPoints(points float64) {
+	s.setPoints(s.points() + points)
+}
+
+// DecByPoints decrements score by the given points, which may be fractional, or until score equals zero.
+func (s *Score) DecByPoints(points float64) {
+	s.setPoints(s.points() - points)
+}
+
+// SetPoints sets score to the given points, which may be fractional, limited to the interval [0, MaxScore].
+func (s *Score) SetPoints(points float64) {
+	s.setPoints(points)
+}
+
+// setPoints sets Points to the given points, limited to the interval [0, MaxScore], and Score to the
+// points rounded down. Points is only set if the score has been fractional, such that the scores
+// of tests that only obtain whole points are unchanged.
+func (s *Score) setPoints(points float64) {
+	points = min(max(points, 0), float64(s.GetMaxScore()))
+	if s.GetPoints() != 0 || points != math.Trunc(points) {
+		s.Points = points
+	}
+	s.Score = int32(math.Floor(points))
+}
+
+// points returns the score obtained, including partial points.
+func (s *Score) points() float64 {
+	if s.GetPoints() != 0 {
+		return s.GetPoints()
+	}
+	return float64(s.GetScore())
+}
+
+// Fraction returns the fraction of MaxScore obtained, including partial points, in the range 0-1.
+func (s *Score) Fraction() float64 {
+	if s.GetMaxScore() <= 0 {
+		return 0
 	}
+	return min(max(s.points(), 0), float64(s.GetMaxScore())) / float64(s.GetMaxScore())
 }
 
 // Normalize the score to the given maxScore.
 func (s *Score) Normalize(maxScore int) {
 	f := float64(maxScore) / float64(s.GetMaxScore())
+	s.MaxScore = int32(maxScore)
+	if s.GetPoints() != 0 {
+		s.setPoints(s.GetPoints() * f)
+		return
+	}
 	normScore := float64(s.GetScore()) * f
 	s.Score = int32(math.Round(normScore))
-	s.MaxScore = int32(maxScore)
-}
-
-// weightedScore returns the weighted score for this test score.
-func (s *Score) weightedScore(totalWeight float64) float64 {
-	return weightedScore(float64(s.GetScore()), float64(s.GetMaxScore()), float64(s.GetWeight()), totalWeight)
 }
 
 // Equal returns true if s equals other. Ignores the Secret field.
@@ -74,10 +101,12 @@ func (s *Score) Equal(other *Score) bool {
 // RelativeScore returns a string with the following format:
 // "TestName: score = x/y = s".
 func (s *Score) RelativeScore() string {
-	return fmt.Sprintf("%s: score = %d/%d = %.1f", s.GetTestName(), s.GetScore(), s.GetMaxScore(), float32(s.GetScore())/float32(s.GetMaxScore()))
+	return fmt.Sprintf("%s: score = %g/%d = %.1f", s.GetTestName(), s.points(), s.GetMaxScore(), s.Fraction())
 }
 
 // Print prints a JSON representation of the score that can be picked up by QuickFeed.
+// The score includes the execution time of the test, measured from when the score
+// object was obtained from the registry, unless the test has set ExecTime itself.
 // To ensure that panic message and stack trace is printed, this method must be called via defer.
 // If a test panics, the score will be set to zero, and a panic message will be emitted.
 // Note that, if subtests are used, each subtest must defer call the PanicHandler method
@@ -88,6 +117,7 @@ func (s *Score) Print(t *testing.T, msg ...string) {
 		s.internalFail(t)
 		printPanicMessage(s.GetTestName(), msg[0], r)
 	}
+	s.stop()
 	// We rely on JSON score objects to start on a new line, since otherwise
 	// scanning long student generated output lines can be costly.
 	fmt.Println()
@@ -116,7 +146,7 @@ func (s *Score) PanicHandler(t *testing.T, msg ...string) {
 // internalFail resets the score to zero and fails the provided test.
 func (s *Score) internalFail(t *testing.T) {
 	// reset score for panicked test functions
-	s.Score = 0
+	s.setPoints(0)
 	// fail the test
 	t.Fail()
 }
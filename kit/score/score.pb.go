@@ -23,18 +23,79 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Status classifies a score relative to the assignment's expected tests.
+type Score_Status int32
+
+const (
+	Score_REPORTED   Score_Status = 0 // the expected test reported its score
+	Score_MISSING    Score_Status = 1 // the expected test did not report a score, e.g., due to a panic or timeout
+	Score_UNEXPECTED Score_Status = 2 // the test reported a score, but is not an expected test; not counted in the total
+)
+
+// Enum value maps for Score_Status.
+var (
+	Score_Status_name = map[int32]string{
+		0: "REPORTED",
+		1: "MISSING",
+		2: "UNEXPECTED",
+	}
+	Score_Status_value = map[string]int32{
+		"REPORTED":   0,
+		"MISSING":    1,
+		"UNEXPECTED": 2,
+	}
+)
+
+func (x Score_Status) Enum() *Score_Status {
+	p := new(Score_Status)
+	*p = x
+	return p
+}
+
+func (x Score_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Score_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_kit_score_score_proto_enumTypes[0].Descriptor()
+}
+
+func (Score_Status) Type() protoreflect.EnumType {
+	return &file_kit_score_score_proto_enumTypes[0]
+}
+
+func (x Score_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Score_Status.Descriptor instead.
+func (Score_Status) EnumDescriptor() ([]byte, []int) {
+	return file_kit_score_score_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // Score give the score for a single test named TestName.
 type Score struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	SubmissionID  uint64                 `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty" gorm:"foreignKey:ID"`
-	Secret        string                 `protobuf:"bytes,3,opt,name=Secret,proto3" json:"Secret,omitempty" gorm:"-"`  // the unique identifier for a scoring session
-	TestName      string                 `protobuf:"bytes,4,opt,name=TestName,proto3" json:"TestName,omitempty"`       // name of the test
-	TaskName      string                 `protobuf:"bytes,5,opt,name=TaskName,proto3" json:"TaskName,omitempty"`       // name of task this score belongs to
-	Score         int32                  `protobuf:"varint,6,opt,name=Score,proto3" json:"Score,omitempty"`            // the score obtained
-	MaxScore      int32                  `protobuf:"varint,7,opt,name=MaxScore,proto3" json:"MaxScore,omitempty"`      // max score possible to get on this specific test
-	Weight        int32                  `protobuf:"varint,8,opt,name=Weight,proto3" json:"Weight,omitempty"`          // the weight of this test; used to compute final grade
-	TestDetails   string                 `protobuf:"bytes,9,opt,name=TestDetails,proto3" json:"TestDetails,omitempty"` // if populated, the frontend may display these details
+	Secret        string                 `protobuf:"bytes,3,opt,name=Secret,proto3" json:"Secret,omitempty" gorm:"-"`                                              // the unique identifier for a scoring session
+	TestName      string                 `protobuf:"bytes,4,opt,name=TestName,proto3" json:"TestName,omitempty"`                                                   // name of the test
+	TaskName      string                 `protobuf:"bytes,5,opt,name=TaskName,proto3" json:"TaskName,omitempty"`                                                   // name of task this score belongs to
+	Score         int32                  `protobuf:"varint,6,opt,name=Score,proto3" json:"Score,omitempty"`                                                        // the score obtained
+	MaxScore      int32                  `protobuf:"varint,7,opt,name=MaxScore,proto3" json:"MaxScore,omitempty"`                                                  // max score possible to get on this specific test
+	Weight        int32                  `protobuf:"varint,8,opt,name=Weight,proto3" json:"Weight,omitempty"`                                                      // the weight of this test; used to compute final grade
+	TestDetails   string                 `protobuf:"bytes,9,opt,name=TestDetails,proto3" json:"TestDetails,omitempty"`                                             // if populated, the frontend may display these details
+	Status        Score_Status           `protobuf:"varint,10,opt,name=status,proto3,enum=score.Score_Status" json:"status,omitempty"`                             // whether the test reported its score
+	Flaky         bool                   `protobuf:"varint,11,opt,name=Flaky,proto3" json:"Flaky,omitempty"`                                                       // the test failed, but passed when rerun
+	ExecTime      int64                  `protobuf:"varint,12,opt,name=ExecTime,proto3" json:"ExecTime,omitempty"`                                                 // execution time of the test in microseconds, if reported
+	Memory        uint64                 `protobuf:"varint,13,opt,name=Memory,proto3" json:"Memory,omitempty"`                                                     // bytes allocated while the test ran, if reported
+	NsPerOp       float64                `protobuf:"fixed64,14,opt,name=NsPerOp,proto3" json:"NsPerOp,omitempty"`                                                  // nanoseconds per operation of the test's benchmark, if reported
+	AllocsPerOp   uint64                 `protobuf:"varint,15,opt,name=AllocsPerOp,proto3" json:"AllocsPerOp,omitempty"`                                           // allocations per operation of the test's benchmark, if reported
+	BytesPerOp    uint64                 `protobuf:"varint,16,opt,name=BytesPerOp,proto3" json:"BytesPerOp,omitempty"`                                             // bytes allocated per operation of the test's benchmark, if reported
+	Hidden        bool                   `protobuf:"varint,17,opt,name=Hidden,proto3" json:"Hidden,omitempty"`                                                     // the test's name and details are hidden from students until the assignment's deadline
+	Points        float64                `protobuf:"fixed64,18,opt,name=Points,proto3" json:"Points,omitempty"`                                                    // the score obtained, including partial points; if zero, Score is the score obtained
+	Group         string                 `protobuf:"bytes,19,opt,name=Group,proto3" json:"Group,omitempty"`                                                        // slash-separated path of the nested score groups the test belongs to, if any
+	GroupWeights  []int32                `protobuf:"varint,20,rep,packed,name=GroupWeights,proto3" json:"GroupWeights,omitempty" gorm:"serializer:json;type:text"` // weights of the groups in Group, outermost first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -132,6 +193,83 @@ func (x *Score) GetTestDetails() string {
 	return ""
 }
 
+func (x *Score) GetStatus() Score_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Score_REPORTED
+}
+
+func (x *Score) GetFlaky() bool {
+	if x != nil {
+		return x.Flaky
+	}
+	return false
+}
+
+func (x *Score) GetExecTime() int64 {
+	if x != nil {
+		return x.ExecTime
+	}
+	return 0
+}
+
+func (x *Score) GetMemory() uint64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Score) GetNsPerOp() float64 {
+	if x != nil {
+		return x.NsPerOp
+	}
+	return 0
+}
+
+func (x *Score) GetAllocsPerOp() uint64 {
+	if x != nil {
+		return x.AllocsPerOp
+	}
+	return 0
+}
+
+func (x *Score) GetBytesPerOp() uint64 {
+	if x != nil {
+		return x.BytesPerOp
+	}
+	return 0
+}
+
+func (x *Score) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Score) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Score) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Score) GetGroupWeights() []int32 {
+	if x != nil {
+		return x.GroupWeights
+	}
+	return nil
+}
+
 // BuildInfo holds build data for an assignment's test execution.
 type BuildInfo struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -141,8 +279,15 @@ type BuildInfo struct {
 	ExecTime       int64                  `protobuf:"varint,4,opt,name=ExecTime,proto3" json:"ExecTime,omitempty"`
 	BuildDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=BuildDate,proto3" json:"BuildDate,omitempty" gorm:"serializer:timestamp;type:datetime"`
 	SubmissionDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=SubmissionDate,proto3" json:"SubmissionDate,omitempty" gorm:"serializer:timestamp;type:datetime"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Revisions of the course's repositories and Dockerfile used to produce the scores.
+	TestsCommit       string         `protobuf:"bytes,7,opt,name=TestsCommit,proto3" json:"TestsCommit,omitempty"`                                     // commit of the tests repository
+	AssignmentsCommit string         `protobuf:"bytes,8,opt,name=AssignmentsCommit,proto3" json:"AssignmentsCommit,omitempty"`                         // commit of the assignments repository
+	DockerfileDigest  string         `protobuf:"bytes,9,opt,name=DockerfileDigest,proto3" json:"DockerfileDigest,omitempty"`                           // digest of the course's Dockerfile; empty if the course has none
+	Coverage          *Coverage      `protobuf:"bytes,10,opt,name=Coverage,proto3" json:"Coverage,omitempty" gorm:"serializer:json;type:text"`         // code coverage of the tests, if collected
+	LintFindings      []*LintFinding `protobuf:"bytes,11,rep,name=LintFindings,proto3" json:"LintFindings,omitempty" gorm:"serializer:json;type:text"` // findings of the linters, if collected
+	LintDeduction     uint32         `protobuf:"varint,12,opt,name=LintDeduction,proto3" json:"LintDeduction,omitempty"`                               // percentage points deducted from the score for the lint findings
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *BuildInfo) Reset() {
@@ -217,11 +362,268 @@ func (x *BuildInfo) GetSubmissionDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *BuildInfo) GetTestsCommit() string {
+	if x != nil {
+		return x.TestsCommit
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetAssignmentsCommit() string {
+	if x != nil {
+		return x.AssignmentsCommit
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetDockerfileDigest() string {
+	if x != nil {
+		return x.DockerfileDigest
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetCoverage() *Coverage {
+	if x != nil {
+		return x.Coverage
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetLintFindings() []*LintFinding {
+	if x != nil {
+		return x.LintFindings
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetLintDeduction() uint32 {
+	if x != nil {
+		return x.LintDeduction
+	}
+	return 0
+}
+
+// Coverage holds the code coverage collected from a test execution.
+type Coverage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packages      []*PackageCoverage     `protobuf:"bytes,1,rep,name=Packages,proto3" json:"Packages,omitempty"`
+	Covered       uint64                 `protobuf:"varint,2,opt,name=Covered,proto3" json:"Covered,omitempty"` // number of covered statements or lines in all packages
+	Total         uint64                 `protobuf:"varint,3,opt,name=Total,proto3" json:"Total,omitempty"`     // number of statements or lines in all packages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coverage) Reset() {
+	*x = Coverage{}
+	mi := &file_kit_score_score_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coverage) ProtoMessage() {}
+
+func (x *Coverage) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_score_score_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coverage.ProtoReflect.Descriptor instead.
+func (*Coverage) Descriptor() ([]byte, []int) {
+	return file_kit_score_score_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Coverage) GetPackages() []*PackageCoverage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *Coverage) GetCovered() uint64 {
+	if x != nil {
+		return x.Covered
+	}
+	return 0
+}
+
+func (x *Coverage) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// PackageCoverage holds the code coverage of a single package or directory.
+type PackageCoverage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Package       string                 `protobuf:"bytes,1,opt,name=Package,proto3" json:"Package,omitempty"`
+	Covered       uint64                 `protobuf:"varint,2,opt,name=Covered,proto3" json:"Covered,omitempty"` // number of covered statements or lines
+	Total         uint64                 `protobuf:"varint,3,opt,name=Total,proto3" json:"Total,omitempty"`     // number of statements or lines
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackageCoverage) Reset() {
+	*x = PackageCoverage{}
+	mi := &file_kit_score_score_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackageCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageCoverage) ProtoMessage() {}
+
+func (x *PackageCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_score_score_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageCoverage.ProtoReflect.Descriptor instead.
+func (*PackageCoverage) Descriptor() ([]byte, []int) {
+	return file_kit_score_score_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PackageCoverage) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *PackageCoverage) GetCovered() uint64 {
+	if x != nil {
+		return x.Covered
+	}
+	return 0
+}
+
+func (x *PackageCoverage) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// LintFinding is a single finding reported by a linter or static analysis tool.
+type LintFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"` // slash-separated path of the file, relative to the home directory if possible
+	Line          uint32                 `protobuf:"varint,2,opt,name=Line,proto3" json:"Line,omitempty"`
+	Column        uint32                 `protobuf:"varint,3,opt,name=Column,proto3" json:"Column,omitempty"`
+	Tool          string                 `protobuf:"bytes,4,opt,name=Tool,proto3" json:"Tool,omitempty"`         // the linter that reported the finding, e.g., errcheck
+	Rule          string                 `protobuf:"bytes,5,opt,name=Rule,proto3" json:"Rule,omitempty"`         // the rule that was violated, if reported
+	Severity      string                 `protobuf:"bytes,6,opt,name=Severity,proto3" json:"Severity,omitempty"` // the severity of the finding, e.g., error or warning, if reported
+	Message       string                 `protobuf:"bytes,7,opt,name=Message,proto3" json:"Message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LintFinding) Reset() {
+	*x = LintFinding{}
+	mi := &file_kit_score_score_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LintFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintFinding) ProtoMessage() {}
+
+func (x *LintFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_kit_score_score_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintFinding.ProtoReflect.Descriptor instead.
+func (*LintFinding) Descriptor() ([]byte, []int) {
+	return file_kit_score_score_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LintFinding) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *LintFinding) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *LintFinding) GetColumn() uint32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *LintFinding) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *LintFinding) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *LintFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *LintFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_kit_score_score_proto protoreflect.FileDescriptor
 
 const file_kit_score_score_proto_rawDesc = "" +
 	"\n" +
-	"\x15kit/score/score.proto\x12\x05score\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0epatch/go.proto\"\xa5\x02\n" +
+	"\x15kit/score/score.proto\x12\x05score\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0epatch/go.proto\"\xc0\x05\n" +
 	"\x05Score\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12?\n" +
 	"\fSubmissionID\x18\x02 \x01(\x04B\x1bʵ\x03\x17\xa2\x01\x14gorm:\"foreignKey:ID\"R\fSubmissionID\x12'\n" +
@@ -231,14 +633,56 @@ const file_kit_score_score_proto_rawDesc = "" +
 	"\x05Score\x18\x06 \x01(\x05R\x05Score\x12\x1a\n" +
 	"\bMaxScore\x18\a \x01(\x05R\bMaxScore\x12\x16\n" +
 	"\x06Weight\x18\b \x01(\x05R\x06Weight\x12 \n" +
-	"\vTestDetails\x18\t \x01(\tR\vTestDetails\"\xf6\x02\n" +
+	"\vTestDetails\x18\t \x01(\tR\vTestDetails\x12+\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x13.score.Score.StatusR\x06status\x12\x14\n" +
+	"\x05Flaky\x18\v \x01(\bR\x05Flaky\x12\x1a\n" +
+	"\bExecTime\x18\f \x01(\x03R\bExecTime\x12\x16\n" +
+	"\x06Memory\x18\r \x01(\x04R\x06Memory\x12\x18\n" +
+	"\aNsPerOp\x18\x0e \x01(\x01R\aNsPerOp\x12 \n" +
+	"\vAllocsPerOp\x18\x0f \x01(\x04R\vAllocsPerOp\x12\x1e\n" +
+	"\n" +
+	"BytesPerOp\x18\x10 \x01(\x04R\n" +
+	"BytesPerOp\x12\x16\n" +
+	"\x06Hidden\x18\x11 \x01(\bR\x06Hidden\x12\x16\n" +
+	"\x06Points\x18\x12 \x01(\x01R\x06Points\x12\x14\n" +
+	"\x05Group\x18\x13 \x01(\tR\x05Group\x12K\n" +
+	"\fGroupWeights\x18\x14 \x03(\x05B'ʵ\x03#\xa2\x01 gorm:\"serializer:json;type:text\"R\fGroupWeights\"3\n" +
+	"\x06Status\x12\f\n" +
+	"\bREPORTED\x10\x00\x12\v\n" +
+	"\aMISSING\x10\x01\x12\x0e\n" +
+	"\n" +
+	"UNEXPECTED\x10\x02\"\xcf\x05\n" +
 	"\tBuildInfo\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12?\n" +
 	"\fSubmissionID\x18\x02 \x01(\x04B\x1bʵ\x03\x17\xa2\x01\x14gorm:\"foreignKey:ID\"R\fSubmissionID\x12\x1a\n" +
 	"\bBuildLog\x18\x03 \x01(\tR\bBuildLog\x12\x1a\n" +
 	"\bExecTime\x18\x04 \x01(\x03R\bExecTime\x12j\n" +
 	"\tBuildDate\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB0ʵ\x03,\xa2\x01)gorm:\"serializer:timestamp;type:datetime\"R\tBuildDate\x12t\n" +
-	"\x0eSubmissionDate\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB0ʵ\x03,\xa2\x01)gorm:\"serializer:timestamp;type:datetime\"R\x0eSubmissionDateB*Z(github.com/quickfeed/quickfeed/kit/scoreb\x06proto3"
+	"\x0eSubmissionDate\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB0ʵ\x03,\xa2\x01)gorm:\"serializer:timestamp;type:datetime\"R\x0eSubmissionDate\x12 \n" +
+	"\vTestsCommit\x18\a \x01(\tR\vTestsCommit\x12,\n" +
+	"\x11AssignmentsCommit\x18\b \x01(\tR\x11AssignmentsCommit\x12*\n" +
+	"\x10DockerfileDigest\x18\t \x01(\tR\x10DockerfileDigest\x12T\n" +
+	"\bCoverage\x18\n" +
+	" \x01(\v2\x0f.score.CoverageB'ʵ\x03#\xa2\x01 gorm:\"serializer:json;type:text\"R\bCoverage\x12_\n" +
+	"\fLintFindings\x18\v \x03(\v2\x12.score.LintFindingB'ʵ\x03#\xa2\x01 gorm:\"serializer:json;type:text\"R\fLintFindings\x12$\n" +
+	"\rLintDeduction\x18\f \x01(\rR\rLintDeduction\"n\n" +
+	"\bCoverage\x122\n" +
+	"\bPackages\x18\x01 \x03(\v2\x16.score.PackageCoverageR\bPackages\x12\x18\n" +
+	"\aCovered\x18\x02 \x01(\x04R\aCovered\x12\x14\n" +
+	"\x05Total\x18\x03 \x01(\x04R\x05Total\"[\n" +
+	"\x0fPackageCoverage\x12\x18\n" +
+	"\aPackage\x18\x01 \x01(\tR\aPackage\x12\x18\n" +
+	"\aCovered\x18\x02 \x01(\x04R\aCovered\x12\x14\n" +
+	"\x05Total\x18\x03 \x01(\x04R\x05Total\"\xab\x01\n" +
+	"\vLintFinding\x12\x12\n" +
+	"\x04File\x18\x01 \x01(\tR\x04File\x12\x12\n" +
+	"\x04Line\x18\x02 \x01(\rR\x04Line\x12\x16\n" +
+	"\x06Column\x18\x03 \x01(\rR\x06Column\x12\x12\n" +
+	"\x04Tool\x18\x04 \x01(\tR\x04Tool\x12\x12\n" +
+	"\x04Rule\x18\x05 \x01(\tR\x04Rule\x12\x1a\n" +
+	"\bSeverity\x18\x06 \x01(\tR\bSeverity\x12\x18\n" +
+	"\aMessage\x18\a \x01(\tR\aMessageB*Z(github.com/quickfeed/quickfeed/kit/scoreb\x06proto3"
 
 var (
 	file_kit_score_score_proto_rawDescOnce sync.Once
@@ -252,20 +696,29 @@ func file_kit_score_score_proto_rawDescGZIP() []byte {
 	return file_kit_score_score_proto_rawDescData
 }
 
-var file_kit_score_score_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_kit_score_score_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_kit_score_score_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_kit_score_score_proto_goTypes = []any{
-	(*Score)(nil),                 // 0: score.Score
-	(*BuildInfo)(nil),             // 1: score.BuildInfo
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(Score_Status)(0),             // 0: score.Score.Status
+	(*Score)(nil),                 // 1: score.Score
+	(*BuildInfo)(nil),             // 2: score.BuildInfo
+	(*Coverage)(nil),              // 3: score.Coverage
+	(*PackageCoverage)(nil),       // 4: score.PackageCoverage
+	(*LintFinding)(nil),           // 5: score.LintFinding
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
 }
 var file_kit_score_score_proto_depIdxs = []int32{
-	2, // 0: score.BuildInfo.BuildDate:type_name -> google.protobuf.Timestamp
-	2, // 1: score.BuildInfo.SubmissionDate:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 0: score.Score.status:type_name -> score.Score.Status
+	6, // 1: score.BuildInfo.BuildDate:type_name -> google.protobuf.Timestamp
+	6, // 2: score.BuildInfo.SubmissionDate:type_name -> google.protobuf.Timestamp
+	3, // 3: score.BuildInfo.Coverage:type_name -> score.Coverage
+	5, // 4: score.BuildInfo.LintFindings:type_name -> score.LintFinding
+	4, // 5: score.Coverage.Packages:type_name -> score.PackageCoverage
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_kit_score_score_proto_init() }
@@ -278,13 +731,14 @@ func file_kit_score_score_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kit_score_score_proto_rawDesc), len(file_kit_score_score_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_kit_score_score_proto_goTypes,
 		DependencyIndexes: file_kit_score_score_proto_depIdxs,
+		EnumInfos:         file_kit_score_score_proto_enumTypes,
 		MessageInfos:      file_kit_score_score_proto_msgTypes,
 	}.Build()
 	File_kit_score_score_proto = out.File
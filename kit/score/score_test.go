@@ -3,9 +3,11 @@ package score_test
 import (
 	"encoding/json"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/quickfeed/quickfeed/kit/score"
@@ -157,3 +159,147 @@ func parseTestDetails(details string) []string {
 	}
 	return lines
 }
+
+var metricsRegistry = score.NewRegistry()
+
+func init() {
+	metricsRegistry.Add(TestPrintMetrics, 1, 1)
+}
+
+var allocated []byte
+
+func TestPrintMetrics(t *testing.T) {
+	t.Setenv("SCORE_MEMORY", "1")
+	r, cleanup := redirectStdout(t)
+	defer cleanup()
+
+	printed := func(sc *score.Score) *score.Score {
+		t.Helper()
+		sc.Print(t)
+		out := make([]byte, 1024)
+		n, err := r.Read(out)
+		if err != nil {
+			t.Fatalf("Failed to read from pipe: %v", err)
+		}
+		parsedScore := &score.Score{}
+		if err := json.Unmarshal(out[:n], parsedScore); err != nil {
+			t.Fatalf("Failed to unmarshal score: %v", err)
+		}
+		return parsedScore
+	}
+
+	sc := metricsRegistry.Max()
+	allocated = make([]byte, 1<<20)
+	time.Sleep(2 * time.Millisecond)
+	got := printed(sc)
+	if got.GetExecTime() < 2000 {
+		t.Errorf("ExecTime = %dµs, expected at least 2000µs", got.GetExecTime())
+	}
+	if got.GetMemory() < 1<<20 {
+		t.Errorf("Memory = %d bytes, expected at least %d bytes", got.GetMemory(), 1<<20)
+	}
+
+	// metrics set by the test are not replaced
+	sc = metricsRegistry.Max()
+	sc.ExecTime = 42
+	got = printed(sc)
+	if got.GetExecTime() != 42 {
+		t.Errorf("ExecTime = %dµs, expected 42µs", got.GetExecTime())
+	}
+}
+
+var hiddenRegistry = score.NewRegistry()
+
+func init() {
+	hiddenRegistry.Add(TestHide, 1, 1)
+	hiddenRegistry.Hide(TestHide)
+}
+
+func TestHide(t *testing.T) {
+	sc := hiddenRegistry.Max()
+	if !sc.GetHidden() {
+		t.Error("Hidden = false, expected true")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("HideSub() of unknown subtest did not panic")
+		}
+	}()
+	hiddenRegistry.HideSub(TestHide, "unknown")
+}
+
+func TestPoints(t *testing.T) {
+	sc := &score.Score{TestName: t.Name(), MaxScore: 4, Weight: 1}
+	sc.Inc()
+	if sc.GetPoints() != 0 {
+		t.Errorf("Inc(): Points = %g, expected 0 for whole points", sc.GetPoints())
+	}
+	sc.IncByPoints(0.5)
+	sc.Inc()
+	if sc.GetScore() != 2 || sc.GetPoints() != 2.5 {
+		t.Errorf("IncByPoints(0.5): Score, Points = %d, %g, expected 2, 2.5", sc.GetScore(), sc.GetPoints())
+	}
+	if got := sc.Fraction(); got != 0.625 {
+		t.Errorf("Fraction() = %g, expected 0.625", got)
+	}
+	if got, want := sc.RelativeScore(), t.Name()+": score = 2.5/4 = 0.6"; got != want {
+		t.Errorf("RelativeScore() = %q, expected %q", got, want)
+	}
+	sc.IncByPoints(10)
+	if sc.GetScore() != 4 || sc.GetPoints() != 4 {
+		t.Errorf("IncByPoints(10): Score, Points = %d, %g, expected 4, 4", sc.GetScore(), sc.GetPoints())
+	}
+	sc.DecByPoints(0.25)
+	if sc.GetScore() != 3 || sc.GetPoints() != 3.75 {
+		t.Errorf("DecByPoints(0.25): Score, Points = %d, %g, expected 3, 3.75", sc.GetScore(), sc.GetPoints())
+	}
+	sc.Fail()
+	if sc.GetScore() != 0 || sc.GetPoints() != 0 || sc.Fraction() != 0 {
+		t.Errorf("Fail(): Score, Points = %d, %g, expected 0, 0", sc.GetScore(), sc.GetPoints())
+	}
+	sc.SetPoints(-1)
+	if sc.GetScore() != 0 || sc.GetPoints() != 0 {
+		t.Errorf("SetPoints(-1): Score, Points = %d, %g, expected 0, 0", sc.GetScore(), sc.GetPoints())
+	}
+}
+
+var groupRegistry = score.NewRegistry()
+
+func init() {
+	groupRegistry.AddGroup("parser", 3)
+	groupRegistry.AddGroup("parser/lexer", 2)
+	groupRegistry.AddToGroup(TestAddGroup, "parser/lexer", 10, 1)
+	groupRegistry.AddSubToGroup(TestAddGroup, "Tokens", "parser", 5, 4)
+}
+
+func TestAddGroup(t *testing.T) {
+	sc := groupRegistry.Max()
+	if sc.GetGroup() != "parser/lexer" || !slices.Equal(sc.GetGroupWeights(), []int32{3, 2}) {
+		t.Errorf("Group, GroupWeights = %q, %v, expected %q, %v", sc.GetGroup(), sc.GetGroupWeights(), "parser/lexer", []int32{3, 2})
+	}
+	sc = groupRegistry.MaxByName(t.Name() + "/Tokens")
+	if sc.GetGroup() != "parser" || !slices.Equal(sc.GetGroupWeights(), []int32{3}) {
+		t.Errorf("Group, GroupWeights = %q, %v, expected %q, %v", sc.GetGroup(), sc.GetGroupWeights(), "parser", []int32{3})
+	}
+
+	tests := []struct {
+		name string
+		add  func()
+	}{
+		{name: "DuplicateGroup", add: func() { groupRegistry.AddGroup("parser", 1) }},
+		{name: "UnknownEnclosingGroup", add: func() { groupRegistry.AddGroup("printer/format", 1) }},
+		{name: "InvalidGroup", add: func() { groupRegistry.AddGroup("parser/", 1) }},
+		{name: "ZeroWeight", add: func() { groupRegistry.AddGroup("printer", 0) }},
+		{name: "UnknownGroup", add: func() { groupRegistry.AddSubToGroup(TestAddGroup, "Printer", "printer", 1, 1) }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", test.name)
+				}
+			}()
+			test.add()
+		})
+	}
+}
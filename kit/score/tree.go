@@ -0,0 +1,93 @@
+package score
+
+import "strings"
+
+// scoreNode is a node in the tree of scores used to compute the total score.
+// The leaves hold the test scores, and the inner nodes hold score groups,
+// whose scores are the weighted average of their children's scores.
+type scoreNode struct {
+	name     string
+	weight   float64
+	score    *Score // nil for score groups
+	children []*scoreNode
+}
+
+// newScoreTree returns a tree of the given scores, where the scores are placed
+// in the nested score groups given by their Group path. Groups appear in the
+// order of their first score.
+func newScoreTree(scores []*Score) *scoreNode {
+	root := &scoreNode{weight: 1}
+	for _, sc := range scores {
+		node := root
+		for depth, name := range sc.groupPath() {
+			node = node.group(name, sc.groupWeight(depth))
+		}
+		node.children = append(node.children, &scoreNode{
+			name:   sc.GetTestName(),
+			weight: float64(sc.GetWeight()),
+			score:  sc,
+		})
+	}
+	return root
+}
+
+// group returns the child group with the given name, adding it with the given weight if not found.
+func (n *scoreNode) group(name string, weight float64) *scoreNode {
+	for _, child := range n.children {
+		if child.score == nil && child.name == name {
+			return child
+		}
+	}
+	child := &scoreNode{name: name, weight: weight}
+	n.children = append(n.children, child)
+	return child
+}
+
+// fraction returns the score of the node as a fraction in the range 0-1.
+func (n *scoreNode) fraction() float64 {
+	if n.score != nil {
+		return n.score.Fraction()
+	}
+	total, totalWeight := float64(0), float64(0)
+	for _, child := range n.children {
+		total += child.fraction() * child.weight
+		totalWeight += child.weight
+	}
+	if totalWeight == 0 {
+		return 0
+	}
+	return total / totalWeight
+}
+
+// shares calls fn for each test score below the node with the score's share of the node's score,
+// i.e., the fraction of the node's score that the test contributes if it obtains its max score.
+func (n *scoreNode) shares(share float64, fn func(sc *Score, share float64)) {
+	if n.score != nil {
+		fn(n.score, share)
+		return
+	}
+	totalWeight := float64(0)
+	for _, child := range n.children {
+		totalWeight += child.weight
+	}
+	for _, child := range n.children {
+		child.shares(share*child.weight/totalWeight, fn)
+	}
+}
+
+// groupPath returns the names of the nested score groups the test belongs to, outermost first.
+func (s *Score) groupPath() []string {
+	if s.GetGroup() == "" {
+		return nil
+	}
+	return strings.Split(s.GetGroup(), "/")
+}
+
+// groupWeight returns the weight of the score group at the given depth of the test's group path.
+// Groups without a weight, e.g., of tests written in other languages that only report a group path, have weight 1.
+func (s *Score) groupWeight(depth int) float64 {
+	if weights := s.GetGroupWeights(); depth < len(weights) && weights[depth] > 0 {
+		return float64(weights[depth])
+	}
+	return 1
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"time"
 
 	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/ci/remote"
 	"github.com/quickfeed/quickfeed/database"
 	"github.com/quickfeed/quickfeed/doc"
 	"github.com/quickfeed/quickfeed/internal/env"
@@ -138,9 +140,9 @@ func initWebServer(dbFile, public string) (http.Handler, func(), error) {
 		return nil, q.cleanup, fmt.Errorf("failed to connect to database: %v", err)
 	}
 
-	q.runner, err = ci.NewDockerCI(q.logger.Sugar())
+	q.runner, err = newRunner(q.logger.Sugar())
 	if err != nil {
-		return nil, q.cleanup, fmt.Errorf("failed to set up docker client: %v", err)
+		return nil, q.cleanup, err
 	}
 
 	tm, err := auth.NewTokenManager(q.db)
@@ -156,20 +158,54 @@ func initWebServer(dbFile, public string) (http.Handler, func(), error) {
 	qfService := web.NewQuickFeedService(q.logger, q.db, scmMgr, q.runner, tm)
 	// Register HTTP endpoints and webhooks
 	router := qfService.RegisterRouter(os.Getenv("QUICKFEED_WEBHOOK_SECRET"), public)
+	q.service = qfService
+	retention, err := env.BuildLogRetention()
+	if err != nil {
+		return nil, q.cleanup, err
+	}
+	qfService.ArchiveBuildLogs(ci.NewLogArchive(env.BuildLogPath(), retention))
+	cacheRetention, err := env.ResultsCacheRetention()
+	if err != nil {
+		return nil, q.cleanup, err
+	}
+	qfService.CacheResults(ci.NewResultsCache(env.ResultsCachePath(), cacheRetention))
+	if err := qfService.StartJobQueue(); err != nil {
+		return nil, q.cleanup, err
+	}
 
 	return h2c.NewHandler(router, &http2.Server{}), q.cleanup, nil
 }
 
+// newRunner returns a runner that dispatches tests to the remote workers specified
+// in the environment, or runs tests using the local container engine, e.g., docker.
+func newRunner(logger *zap.SugaredLogger) (ci.Runner, error) {
+	if workers := env.RunnerWorkers(); len(workers) > 0 {
+		log.Printf("Running tests on remote workers: %v", workers)
+		return remote.NewRunner(logger, env.RunnerToken(), workers...)
+	}
+	log.Printf("Running tests using %s", env.RunnerEngine())
+	runner, err := ci.NewContainerCI(logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up %s client: %v", env.RunnerEngine(), err)
+	}
+	return runner, nil
+}
+
 type quickfeed struct {
-	logger *zap.Logger
-	db     *database.GormDB
-	runner *ci.Docker
+	logger  *zap.Logger
+	db      *database.GormDB
+	runner  ci.Runner
+	service *web.QuickFeedService
 }
 
 func (q *quickfeed) cleanup() {
 	var err error
-	if q.runner != nil {
-		if e := q.runner.Close(); e != nil {
+	if q.service != nil {
+		// stop the job queue before closing the runner and database used by its workers
+		q.service.StopJobQueue()
+	}
+	if closer, ok := q.runner.(io.Closer); ok {
+		if e := closer.Close(); e != nil {
 			err = fmt.Errorf("failed to close runner: %w", e)
 		}
 	}
@@ -0,0 +1,340 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file ci/remote/remotepb/remote.proto (package remote, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file ci/remote/remotepb/remote.proto.
+ */
+export const file_ci_remote_remotepb_remote: GenFile = /*@__PURE__*/
+  fileDesc("Ch9jaS9yZW1vdGUvcmVtb3RlcGIvcmVtb3RlLnByb3RvEgZyZW1vdGUiowQKA0pvYhIMCgRuYW1lGAEgASgJEg0KBWltYWdlGAIgASgJEhAKCGxhbmd1YWdlGAMgASgJEjMKDGJ1aWxkQ29udGV4dBgEIAMoCzIdLnJlbW90ZS5Kb2IuQnVpbGRDb250ZXh0RW50cnkSIgoHYmluZERpchgFIAEoCzIRLnJlbW90ZS5EaXJlY3RvcnkSKQoOcmVhZE9ubHlNb3VudHMYBiADKAsyES5yZW1vdGUuRGlyZWN0b3J5EgsKA2VudhgHIAMoCRIQCghjb21tYW5kcxgIIAMoCRIeCgZsaW1pdHMYCSABKAsyDi5yZW1vdGUuTGltaXRzEiAKB25ldHdvcmsYCiABKAsyDy5yZW1vdGUuTmV0d29yaxIhCghzZXJ2aWNlcxgLIAMoCzIPLnJlbW90ZS5TZXJ2aWNlEhIKCmxpdmVPdXRwdXQYDCABKAgSEwoLcmVzdWx0c0ZpbGUYDSABKAkSLQoJY2FjaGVEaXJzGA4gAygLMhoucmVtb3RlLkpvYi5DYWNoZURpcnNFbnRyeRIUCgxjb3ZlcmFnZUZpbGUYDyABKAkSEAoIbGludEZpbGUYECABKAkaMwoRQnVpbGRDb250ZXh0RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARowCg5DYWNoZURpcnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjMKB1NlcnZpY2USDAoEbmFtZRgBIAEoCRINCgVpbWFnZRgCIAEoCRILCgNlbnYYAyADKAkiJgoHTmV0d29yaxIMCgRtb2RlGAEgASgJEg0KBWFsbG93GAIgAygJIkoKBkxpbWl0cxIOCgZtZW1vcnkYASABKAMSEAoIbmFub0NQVXMYAiABKAMSDAoEcGlkcxgDIAEoAxIQCghmaWxlU2l6ZRgEIAEoAyI4CglEaXJlY3RvcnkSDgoGdGFyZ2V0GAEgASgJEhsKBWZpbGVzGAIgAygLMgwucmVtb3RlLkZpbGUiMwoERmlsZRIMCgRwYXRoGAEgASgJEg8KB2NvbnRlbnQYAiABKAwSDAoEbW9kZRgDIAEoDSJpCgZPdXRwdXQSDgoGb3V0cHV0GAEgASgMEhIKCmxpdmVPdXRwdXQYAiABKAwSEwoLcmVzdWx0c0ZpbGUYAyABKAwSFAoMY292ZXJhZ2VGaWxlGAQgASgMEhAKCGxpbnRGaWxlGAUgASgMMjcKDVJ1bm5lclNlcnZpY2USJgoDUnVuEgsucmVtb3RlLkpvYhoOLnJlbW90ZS5PdXRwdXQiADABQjNaMWdpdGh1Yi5jb20vcXVpY2tmZWVkL3F1aWNrZmVlZC9jaS9yZW1vdGUvcmVtb3RlcGJiBnByb3RvMw");
+
+/**
+ * Job is a self-contained bundle of a ci.Job. It includes the content of
+ * the host directories used by the job, since these are not available on the worker.
+ *
+ * @generated from message remote.Job
+ */
+export type Job = Message<"remote.Job"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string image = 2;
+   */
+  image: string;
+
+  /**
+   * @generated from field: string language = 3;
+   */
+  language: string;
+
+  /**
+   * @generated from field: map<string, string> buildContext = 4;
+   */
+  buildContext: { [key: string]: string };
+
+  /**
+   * content of the directory bound to the container's /quickfeed directory
+   *
+   * @generated from field: remote.Directory bindDir = 5;
+   */
+  bindDir?: Directory;
+
+  /**
+   * @generated from field: repeated remote.Directory readOnlyMounts = 6;
+   */
+  readOnlyMounts: Directory[];
+
+  /**
+   * @generated from field: repeated string env = 7;
+   */
+  env: string[];
+
+  /**
+   * @generated from field: repeated string commands = 8;
+   */
+  commands: string[];
+
+  /**
+   * @generated from field: remote.Limits limits = 9;
+   */
+  limits?: Limits;
+
+  /**
+   * @generated from field: remote.Network network = 10;
+   */
+  network?: Network;
+
+  /**
+   * @generated from field: repeated remote.Service services = 11;
+   */
+  services: Service[];
+
+  /**
+   * forward the job's output while the job is running
+   *
+   * @generated from field: bool liveOutput = 12;
+   */
+  liveOutput: boolean;
+
+  /**
+   * slash-separated path of the results file, relative to the reports directory
+   *
+   * @generated from field: string resultsFile = 13;
+   */
+  resultsFile: string;
+
+  /**
+   * container path -> host cache directory, relative to the worker's home directory
+   *
+   * @generated from field: map<string, string> cacheDirs = 14;
+   */
+  cacheDirs: { [key: string]: string };
+
+  /**
+   * slash-separated path of the coverage file, relative to the reports directory
+   *
+   * @generated from field: string coverageFile = 15;
+   */
+  coverageFile: string;
+
+  /**
+   * slash-separated path of the lint file, relative to the reports directory
+   *
+   * @generated from field: string lintFile = 16;
+   */
+  lintFile: string;
+};
+
+/**
+ * Describes the message remote.Job.
+ * Use `create(JobSchema)` to create a new message.
+ */
+export const JobSchema: GenMessage<Job> = /*@__PURE__*/
+  messageDesc(file_ci_remote_remotepb_remote, 0);
+
+/**
+ * Service describes a sidecar service container for the job; see ci.Service.
+ *
+ * @generated from message remote.Service
+ */
+export type Service = Message<"remote.Service"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string image = 2;
+   */
+  image: string;
+
+  /**
+   * @generated from field: repeated string env = 3;
+   */
+  env: string[];
+};
+
+/**
+ * Describes the message remote.Service.
+ * Use `create(ServiceSchema)` to create a new message.
+ */
+export const ServiceSchema: GenMessage<Service> = /*@__PURE__*/
+  messageDesc(file_ci_remote_remotepb_remote, 1);
+
+/**
+ * Network holds the job's network policy; see ci.NetworkPolicy.
+ *
+ * @generated from message remote.Network
+ */
+export type Network = Message<"remote.Network"> & {
+  /**
+   * @generated from field: string mode = 1;
+   */
+  mode: string;
+
+  /**
+   * @generated from field: repeated string allow = 2;
+   */
+  allow: string[];
+};
+
+/**
+ * Describes the message remote.Network.
+ * Use `create(NetworkSchema)` to create a new message.
+ */
+export const NetworkSchema: GenMessage<Network> = /*@__PURE__*/
+  messageDesc(file_ci_remote_remotepb_remote, 2);
+
+/**
+ * Limits holds the job's resource limits; zero values mean no limit.
+ *
+ * @generated from message remote.Limits
+ */
+export type Limits = Message<"remote.Limits"> & {
+  /**
+   * bytes
+   *
+   * @generated from field: int64 memory = 1;
+   */
+  memory: bigint;
+
+  /**
+   * CPU quota in units of 1e-9 CPUs
+   *
+   * @generated from field: int64 nanoCPUs = 2;
+   */
+  nanoCPUs: bigint;
+
+  /**
+   * maximum number of processes
+   *
+   * @generated from field: int64 pids = 3;
+   */
+  pids: bigint;
+
+  /**
+   * maximum size of files written, in bytes
+   *
+   * @generated from field: int64 fileSize = 4;
+   */
+  fileSize: bigint;
+};
+
+/**
+ * Describes the message remote.Limits.
+ * Use `create(LimitsSchema)` to create a new message.
+ */
+export const LimitsSchema: GenMessage<Limits> = /*@__PURE__*/
+  messageDesc(file_ci_remote_remotepb_remote, 3);
+
+/**
+ * Directory holds the regular files of a host directory.
+ *
+ * @generated from message remote.Directory
+ */
+export type Directory = Message<"remote.Directory"> & {
+  /**
+   * mount point inside the container; not used for the bind directory
+   *
+   * @generated from field: string target = 1;
+   */
+  target: string;
+
+  /**
+   * @generated from field: repeated remote.File files = 2;
+   */
+  files: File[];
+};
+
+/**
+ * Describes the message remote.Directory.
+ * Use `create(DirectorySchema)` to create a new message.
+ */
+export const DirectorySchema: GenMessage<Directory> = /*@__PURE__*/
+  messageDesc(file_ci_remote_remotepb_remote, 4);
+
+/**
+ * @generated from message remote.File
+ */
+export type File = Message<"remote.File"> & {
+  /**
+   * slash-separated path relative to the directory
+   *
+   * @generated from field: string path = 1;
+   */
+  path: string;
+
+  /**
+   * @generated from field: bytes content = 2;
+   */
+  content: Uint8Array;
+
+  /**
+   * permission bits
+   *
+   * @generated from field: uint32 mode = 3;
+   */
+  mode: number;
+};
+
+/**
+ * Describes the message remote.File.
+ * Use `create(FileSchema)` to create a new message.
+ */
+export const FileSchema: GenMessage<File> = /*@__PURE__*/
+  messageDesc(file_ci_remote_remotepb_remote, 5);
+
+/**
+ * Output is a chunk of the job's output.
+ * Chunks may split multi-byte characters; hence, bytes are used instead of string.
+ *
+ * @generated from message remote.Output
+ */
+export type Output = Message<"remote.Output"> & {
+  /**
+   * @generated from field: bytes output = 1;
+   */
+  output: Uint8Array;
+
+  /**
+   * output forwarded while the job is running; also included in the final output
+   *
+   * @generated from field: bytes liveOutput = 2;
+   */
+  liveOutput: Uint8Array;
+
+  /**
+   * content of the job's results file, if any, sent when the job is done
+   *
+   * @generated from field: bytes resultsFile = 3;
+   */
+  resultsFile: Uint8Array;
+
+  /**
+   * content of the job's coverage file, if any, sent when the job is done
+   *
+   * @generated from field: bytes coverageFile = 4;
+   */
+  coverageFile: Uint8Array;
+
+  /**
+   * content of the job's lint file, if any, sent when the job is done
+   *
+   * @generated from field: bytes lintFile = 5;
+   */
+  lintFile: Uint8Array;
+};
+
+/**
+ * Describes the message remote.Output.
+ * Use `create(OutputSchema)` to create a new message.
+ */
+export const OutputSchema: GenMessage<Output> = /*@__PURE__*/
+  messageDesc(file_ci_remote_remotepb_remote, 6);
+
+/**
+ * RunnerService executes test jobs on remote worker machines.
+ *
+ * @generated from service remote.RunnerService
+ */
+export const RunnerService: GenService<{
+  /**
+   * Run executes the given job and streams the job's output back to the caller.
+   *
+   * @generated from rpc remote.RunnerService.Run
+   */
+  run: {
+    methodKind: "server_streaming";
+    input: typeof JobSchema;
+    output: typeof OutputSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_ci_remote_remotepb_remote, 0);
+
@@ -2,8 +2,8 @@
 // @generated from file kit/score/score.proto (package score, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file kit/score/score.proto.
  */
 export const file_kit_score_score: GenFile = /*@__PURE__*/
-  fileDesc("ChVraXQvc2NvcmUvc2NvcmUucHJvdG8SBXNjb3JlItEBCgVTY29yZRIKCgJJRBgBIAEoBBIxCgxTdWJtaXNzaW9uSUQYAiABKARCG8q1AxeiARRnb3JtOiJmb3JlaWduS2V5OklEIhIfCgZTZWNyZXQYAyABKAlCD8q1AwuiAQhnb3JtOiItIhIQCghUZXN0TmFtZRgEIAEoCRIQCghUYXNrTmFtZRgFIAEoCRINCgVTY29yZRgGIAEoBRIQCghNYXhTY29yZRgHIAEoBRIOCgZXZWlnaHQYCCABKAUSEwoLVGVzdERldGFpbHMYCSABKAkitQIKCUJ1aWxkSW5mbxIKCgJJRBgBIAEoBBIxCgxTdWJtaXNzaW9uSUQYAiABKARCG8q1AxeiARRnb3JtOiJmb3JlaWduS2V5OklEIhIQCghCdWlsZExvZxgDIAEoCRIQCghFeGVjVGltZRgEIAEoAxJfCglCdWlsZERhdGUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQjDKtQMsogEpZ29ybToic2VyaWFsaXplcjp0aW1lc3RhbXA7dHlwZTpkYXRldGltZSISZAoOU3VibWlzc2lvbkRhdGUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQjDKtQMsogEpZ29ybToic2VyaWFsaXplcjp0aW1lc3RhbXA7dHlwZTpkYXRldGltZSJCKlooZ2l0aHViLmNvbS9xdWlja2ZlZWQvcXVpY2tmZWVkL2tpdC9zY29yZWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+  fileDesc("ChVraXQvc2NvcmUvc2NvcmUucHJvdG8SBXNjb3JlIoQECgVTY29yZRIKCgJJRBgBIAEoBBIxCgxTdWJtaXNzaW9uSUQYAiABKARCG8q1AxeiARRnb3JtOiJmb3JlaWduS2V5OklEIhIfCgZTZWNyZXQYAyABKAlCD8q1AwuiAQhnb3JtOiItIhIQCghUZXN0TmFtZRgEIAEoCRIQCghUYXNrTmFtZRgFIAEoCRINCgVTY29yZRgGIAEoBRIQCghNYXhTY29yZRgHIAEoBRIOCgZXZWlnaHQYCCABKAUSEwoLVGVzdERldGFpbHMYCSABKAkSIwoGc3RhdHVzGAogASgOMhMuc2NvcmUuU2NvcmUuU3RhdHVzEg0KBUZsYWt5GAsgASgIEhAKCEV4ZWNUaW1lGAwgASgDEg4KBk1lbW9yeRgNIAEoBBIPCgdOc1Blck9wGA4gASgBEhMKC0FsbG9jc1Blck9wGA8gASgEEhIKCkJ5dGVzUGVyT3AYECABKAQSDgoGSGlkZGVuGBEgASgIEg4KBlBvaW50cxgSIAEoARINCgVHcm91cBgTIAEoCRI9CgxHcm91cFdlaWdodHMYFCADKAVCJ8q1AyOiASBnb3JtOiJzZXJpYWxpemVyOmpzb247dHlwZTp0ZXh0IiIzCgZTdGF0dXMSDAoIUkVQT1JURUQQABILCgdNSVNTSU5HEAESDgoKVU5FWFBFQ1RFRBACIrUECglCdWlsZEluZm8SCgoCSUQYASABKAQSMQoMU3VibWlzc2lvbklEGAIgASgEQhvKtQMXogEUZ29ybToiZm9yZWlnbktleTpJRCISEAoIQnVpbGRMb2cYAyABKAkSEAoIRXhlY1RpbWUYBCABKAMSXwoJQnVpbGREYXRlGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIwyrUDLKIBKWdvcm06InNlcmlhbGl6ZXI6dGltZXN0YW1wO3R5cGU6ZGF0ZXRpbWUiEmQKDlN1Ym1pc3Npb25EYXRlGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIwyrUDLKIBKWdvcm06InNlcmlhbGl6ZXI6dGltZXN0YW1wO3R5cGU6ZGF0ZXRpbWUiEhMKC1Rlc3RzQ29tbWl0GAcgASgJEhkKEUFzc2lnbm1lbnRzQ29tbWl0GAggASgJEhgKEERvY2tlcmZpbGVEaWdlc3QYCSABKAkSSgoIQ292ZXJhZ2UYCiABKAsyDy5zY29yZS5Db3ZlcmFnZUInyrUDI6IBIGdvcm06InNlcmlhbGl6ZXI6anNvbjt0eXBlOnRleHQiElEKDExpbnRGaW5kaW5ncxgLIAMoCzISLnNjb3JlLkxpbnRGaW5kaW5nQifKtQMjogEgZ29ybToic2VyaWFsaXplcjpqc29uO3R5cGU6dGV4dCISFQoNTGludERlZHVjdGlvbhgMIAEoDSJUCghDb3ZlcmFnZRIoCghQYWNrYWdlcxgBIAMoCzIWLnNjb3JlLlBhY2thZ2VDb3ZlcmFnZRIPCgdDb3ZlcmVkGAIgASgEEg0KBVRvdGFsGAMgASgEIkIKD1BhY2thZ2VDb3ZlcmFnZRIPCgdQYWNrYWdlGAEgASgJEg8KB0NvdmVyZWQYAiABKAQSDQoFVG90YWwYAyABKAQieAoLTGludEZpbmRpbmcSDAoERmlsZRgBIAEoCRIMCgRMaW5lGAIgASgNEg4KBkNvbHVtbhgDIAEoDRIMCgRUb29sGAQgASgJEgwKBFJ1bGUYBSABKAkSEAoIU2V2ZXJpdHkYBiABKAkSDwoHTWVzc2FnZRgHIAEoCUIqWihnaXRodWIuY29tL3F1aWNrZmVlZC9xdWlja2ZlZWQva2l0L3Njb3JlYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * Score give the score for a single test named TestName.
@@ -78,6 +78,83 @@ export type Score = Message<"score.Score"> & {
    * @generated from field: string TestDetails = 9;
    */
   TestDetails: string;
+
+  /**
+   * whether the test reported its score
+   *
+   * @generated from field: score.Score.Status status = 10;
+   */
+  status: Score_Status;
+
+  /**
+   * the test failed, but passed when rerun
+   *
+   * @generated from field: bool Flaky = 11;
+   */
+  Flaky: boolean;
+
+  /**
+   * execution time of the test in microseconds, if reported
+   *
+   * @generated from field: int64 ExecTime = 12;
+   */
+  ExecTime: bigint;
+
+  /**
+   * bytes allocated while the test ran, if reported
+   *
+   * @generated from field: uint64 Memory = 13;
+   */
+  Memory: bigint;
+
+  /**
+   * nanoseconds per operation of the test's benchmark, if reported
+   *
+   * @generated from field: double NsPerOp = 14;
+   */
+  NsPerOp: number;
+
+  /**
+   * allocations per operation of the test's benchmark, if reported
+   *
+   * @generated from field: uint64 AllocsPerOp = 15;
+   */
+  AllocsPerOp: bigint;
+
+  /**
+   * bytes allocated per operation of the test's benchmark, if reported
+   *
+   * @generated from field: uint64 BytesPerOp = 16;
+   */
+  BytesPerOp: bigint;
+
+  /**
+   * the test's name and details are hidden from students until the assignment's deadline
+   *
+   * @generated from field: bool Hidden = 17;
+   */
+  Hidden: boolean;
+
+  /**
+   * the score obtained, including partial points; if zero, Score is the score obtained
+   *
+   * @generated from field: double Points = 18;
+   */
+  Points: number;
+
+  /**
+   * slash-separated path of the nested score groups the test belongs to, if any
+   *
+   * @generated from field: string Group = 19;
+   */
+  Group: string;
+
+  /**
+   * weights of the groups in Group, outermost first
+   *
+   * @generated from field: repeated int32 GroupWeights = 20;
+   */
+  GroupWeights: number[];
 };
 
 /**
@@ -87,6 +164,40 @@ export type Score = Message<"score.Score"> & {
 export const ScoreSchema: GenMessage<Score> = /*@__PURE__*/
   messageDesc(file_kit_score_score, 0);
 
+/**
+ * Status classifies a score relative to the assignment's expected tests.
+ *
+ * @generated from enum score.Score.Status
+ */
+export enum Score_Status {
+  /**
+   * the expected test reported its score
+   *
+   * @generated from enum value: REPORTED = 0;
+   */
+  REPORTED = 0,
+
+  /**
+   * the expected test did not report a score, e.g., due to a panic or timeout
+   *
+   * @generated from enum value: MISSING = 1;
+   */
+  MISSING = 1,
+
+  /**
+   * the test reported a score, but is not an expected test; not counted in the total
+   *
+   * @generated from enum value: UNEXPECTED = 2;
+   */
+  UNEXPECTED = 2,
+}
+
+/**
+ * Describes the enum score.Score.Status.
+ */
+export const Score_StatusSchema: GenEnum<Score_Status> = /*@__PURE__*/
+  enumDesc(file_kit_score_score, 0, 0);
+
 /**
  * BuildInfo holds build data for an assignment's test execution.
  *
@@ -122,6 +233,50 @@ export type BuildInfo = Message<"score.BuildInfo"> & {
    * @generated from field: google.protobuf.Timestamp SubmissionDate = 6;
    */
   SubmissionDate?: Timestamp;
+
+  /**
+   * Revisions of the course's repositories and Dockerfile used to produce the scores.
+   *
+   * commit of the tests repository
+   *
+   * @generated from field: string TestsCommit = 7;
+   */
+  TestsCommit: string;
+
+  /**
+   * commit of the assignments repository
+   *
+   * @generated from field: string AssignmentsCommit = 8;
+   */
+  AssignmentsCommit: string;
+
+  /**
+   * digest of the course's Dockerfile; empty if the course has none
+   *
+   * @generated from field: string DockerfileDigest = 9;
+   */
+  DockerfileDigest: string;
+
+  /**
+   * code coverage of the tests, if collected
+   *
+   * @generated from field: score.Coverage Coverage = 10;
+   */
+  Coverage?: Coverage;
+
+  /**
+   * findings of the linters, if collected
+   *
+   * @generated from field: repeated score.LintFinding LintFindings = 11;
+   */
+  LintFindings: LintFinding[];
+
+  /**
+   * percentage points deducted from the score for the lint findings
+   *
+   * @generated from field: uint32 LintDeduction = 12;
+   */
+  LintDeduction: number;
 };
 
 /**
@@ -131,3 +286,126 @@ export type BuildInfo = Message<"score.BuildInfo"> & {
 export const BuildInfoSchema: GenMessage<BuildInfo> = /*@__PURE__*/
   messageDesc(file_kit_score_score, 1);
 
+/**
+ * Coverage holds the code coverage collected from a test execution.
+ *
+ * @generated from message score.Coverage
+ */
+export type Coverage = Message<"score.Coverage"> & {
+  /**
+   * @generated from field: repeated score.PackageCoverage Packages = 1;
+   */
+  Packages: PackageCoverage[];
+
+  /**
+   * number of covered statements or lines in all packages
+   *
+   * @generated from field: uint64 Covered = 2;
+   */
+  Covered: bigint;
+
+  /**
+   * number of statements or lines in all packages
+   *
+   * @generated from field: uint64 Total = 3;
+   */
+  Total: bigint;
+};
+
+/**
+ * Describes the message score.Coverage.
+ * Use `create(CoverageSchema)` to create a new message.
+ */
+export const CoverageSchema: GenMessage<Coverage> = /*@__PURE__*/
+  messageDesc(file_kit_score_score, 2);
+
+/**
+ * PackageCoverage holds the code coverage of a single package or directory.
+ *
+ * @generated from message score.PackageCoverage
+ */
+export type PackageCoverage = Message<"score.PackageCoverage"> & {
+  /**
+   * @generated from field: string Package = 1;
+   */
+  Package: string;
+
+  /**
+   * number of covered statements or lines
+   *
+   * @generated from field: uint64 Covered = 2;
+   */
+  Covered: bigint;
+
+  /**
+   * number of statements or lines
+   *
+   * @generated from field: uint64 Total = 3;
+   */
+  Total: bigint;
+};
+
+/**
+ * Describes the message score.PackageCoverage.
+ * Use `create(PackageCoverageSchema)` to create a new message.
+ */
+export const PackageCoverageSchema: GenMessage<PackageCoverage> = /*@__PURE__*/
+  messageDesc(file_kit_score_score, 3);
+
+/**
+ * LintFinding is a single finding reported by a linter or static analysis tool.
+ *
+ * @generated from message score.LintFinding
+ */
+export type LintFinding = Message<"score.LintFinding"> & {
+  /**
+   * slash-separated path of the file, relative to the home directory if possible
+   *
+   * @generated from field: string File = 1;
+   */
+  File: string;
+
+  /**
+   * @generated from field: uint32 Line = 2;
+   */
+  Line: number;
+
+  /**
+   * @generated from field: uint32 Column = 3;
+   */
+  Column: number;
+
+  /**
+   * the linter that reported the finding, e.g., errcheck
+   *
+   * @generated from field: string Tool = 4;
+   */
+  Tool: string;
+
+  /**
+   * the rule that was violated, if reported
+   *
+   * @generated from field: string Rule = 5;
+   */
+  Rule: string;
+
+  /**
+   * the severity of the finding, e.g., error or warning, if reported
+   *
+   * @generated from field: string Severity = 6;
+   */
+  Severity: string;
+
+  /**
+   * @generated from field: string Message = 7;
+   */
+  Message: string;
+};
+
+/**
+ * Describes the message score.LintFinding.
+ * Use `create(LintFindingSchema)` to create a new message.
+ */
+export const LintFindingSchema: GenMessage<LintFinding> = /*@__PURE__*/
+  messageDesc(file_kit_score_score, 4);
+
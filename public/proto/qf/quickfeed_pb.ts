@@ -4,16 +4,16 @@
 
 import type { GenFile, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { AssignmentFeedbackSchema, AssignmentFeedbacksSchema, AssignmentsSchema, CourseSchema, CoursesSchema, EnrollmentSchema, EnrollmentsSchema, GradeSchema, GroupSchema, GroupsSchema, ReviewSchema, SubmissionSchema, SubmissionsSchema, UserSchema, UsersSchema } from "./types_pb";
+import type { AssignmentFeedbackSchema, AssignmentFeedbacksSchema, AssignmentsSchema, BuildLogArchiveSchema, BuildLogSchema, CourseSchema, CoursesSchema, EnrollmentSchema, EnrollmentsSchema, FlakyTestsSchema, GradeSchema, GroupSchema, GroupsSchema, LeaderboardSchema, RebuildProgressSchema, RebuildSchema, RebuildSummariesSchema, RebuildSummarySchema, ReviewSchema, StaleSubmissionsSchema, SubmissionSchema, SubmissionsSchema, TestsHealthSchema, UserSchema, UsersSchema } from "./types_pb";
 import { file_qf_types } from "./types_pb";
-import type { CourseRequestSchema, CourseSubmissionsSchema, EnrollmentRequestSchema, GroupRequestSchema, RebuildRequestSchema, RepositoriesSchema, RepositoryRequestSchema, ReviewRequestSchema, SubmissionRequestSchema, VoidSchema } from "./requests_pb";
+import type { BuildLogArchiveRequestSchema, BuildLogRequestSchema, CourseRequestSchema, CourseSubmissionsSchema, EnrollmentRequestSchema, FlakyTestsRequestSchema, GroupRequestSchema, LeaderboardRequestSchema, RebuildRequestSchema, RebuildStatusRequestSchema, RepositoriesSchema, RepositoryRequestSchema, ReviewRequestSchema, StaleSubmissionsRequestSchema, SubmissionRequestSchema, VoidSchema } from "./requests_pb";
 import { file_qf_requests } from "./requests_pb";
 
 /**
  * Describes the file qf/quickfeed.proto.
  */
 export const file_qf_quickfeed: GenFile = /*@__PURE__*/
-  fileDesc("ChJxZi9xdWlja2ZlZWQucHJvdG8SAnFmMrsLChBRdWlja0ZlZWRTZXJ2aWNlEh8KB0dldFVzZXISCC5xZi5Wb2lkGggucWYuVXNlciIAEiEKCEdldFVzZXJzEggucWYuVm9pZBoJLnFmLlVzZXJzIgASIgoKVXBkYXRlVXNlchIILnFmLlVzZXIaCC5xZi5Wb2lkIgASKQoIR2V0R3JvdXASEC5xZi5Hcm91cFJlcXVlc3QaCS5xZi5Hcm91cCIAEjQKEUdldEdyb3Vwc0J5Q291cnNlEhEucWYuQ291cnNlUmVxdWVzdBoKLnFmLkdyb3VwcyIAEiUKC0NyZWF0ZUdyb3VwEgkucWYuR3JvdXAaCS5xZi5Hcm91cCIAEiUKC1VwZGF0ZUdyb3VwEgkucWYuR3JvdXAaCS5xZi5Hcm91cCIAEisKC0RlbGV0ZUdyb3VwEhAucWYuR3JvdXBSZXF1ZXN0GggucWYuVm9pZCIAEiwKCUdldENvdXJzZRIRLnFmLkNvdXJzZVJlcXVlc3QaCi5xZi5Db3Vyc2UiABIlCgpHZXRDb3Vyc2VzEggucWYuVm9pZBoLLnFmLkNvdXJzZXMiABImCgxVcGRhdGVDb3Vyc2USCi5xZi5Db3Vyc2UaCC5xZi5Wb2lkIgASNAoWVXBkYXRlQ291cnNlVmlzaWJpbGl0eRIOLnFmLkVucm9sbG1lbnQaCC5xZi5Wb2lkIgASNgoOR2V0QXNzaWdubWVudHMSES5xZi5Db3Vyc2VSZXF1ZXN0Gg8ucWYuQXNzaWdubWVudHMiABIyChFVcGRhdGVBc3NpZ25tZW50cxIRLnFmLkNvdXJzZVJlcXVlc3QaCC5xZi5Wb2lkIgASOgoOR2V0RW5yb2xsbWVudHMSFS5xZi5FbnJvbGxtZW50UmVxdWVzdBoPLnFmLkVucm9sbG1lbnRzIgASLgoQQ3JlYXRlRW5yb2xsbWVudBIOLnFmLkVucm9sbG1lbnQaCC5xZi5Wb2lkIgASMAoRVXBkYXRlRW5yb2xsbWVudHMSDy5xZi5FbnJvbGxtZW50cxoILnFmLlZvaWQiABI4Cg1HZXRTdWJtaXNzaW9uEhUucWYuU3VibWlzc2lvblJlcXVlc3QaDi5xZi5TdWJtaXNzaW9uIgASOgoOR2V0U3VibWlzc2lvbnMSFS5xZi5TdWJtaXNzaW9uUmVxdWVzdBoPLnFmLlN1Ym1pc3Npb25zIgASSAoWR2V0U3VibWlzc2lvbnNCeUNvdXJzZRIVLnFmLlN1Ym1pc3Npb25SZXF1ZXN0GhUucWYuQ291cnNlU3VibWlzc2lvbnMiABIpChBVcGRhdGVTdWJtaXNzaW9uEgkucWYuR3JhZGUaCC5xZi5Wb2lkIgASNAoSUmVidWlsZFN1Ym1pc3Npb25zEhIucWYuUmVidWlsZFJlcXVlc3QaCC5xZi5Wb2lkIgASLwoMQ3JlYXRlUmV2aWV3EhEucWYuUmV2aWV3UmVxdWVzdBoKLnFmLlJldmlldyIAEi8KDFVwZGF0ZVJldmlldxIRLnFmLlJldmlld1JlcXVlc3QaCi5xZi5SZXZpZXciABI+ChhDcmVhdGVBc3NpZ25tZW50RmVlZGJhY2sSFi5xZi5Bc3NpZ25tZW50RmVlZGJhY2saCC5xZi5Wb2lkIgASRQoVR2V0QXNzaWdubWVudEZlZWRiYWNrEhEucWYuQ291cnNlUmVxdWVzdBoXLnFmLkFzc2lnbm1lbnRGZWVkYmFja3MiABI4Cg9HZXRSZXBvc2l0b3JpZXMSES5xZi5Db3Vyc2VSZXF1ZXN0GhAucWYuUmVwb3NpdG9yaWVzIgASMAoLSXNFbXB0eVJlcG8SFS5xZi5SZXBvc2l0b3J5UmVxdWVzdBoILnFmLlZvaWQiABIwChBTdWJtaXNzaW9uU3RyZWFtEggucWYuVm9pZBoOLnFmLlN1Ym1pc3Npb24iADABQiZaIWdpdGh1Yi5jb20vcXVpY2tmZWVkL3F1aWNrZmVlZC9xZroCAGIGcHJvdG8z", [file_qf_types, file_qf_requests]);
+  fileDesc("ChJxZi9xdWlja2ZlZWQucHJvdG8SAnFmMr0QChBRdWlja0ZlZWRTZXJ2aWNlEh8KB0dldFVzZXISCC5xZi5Wb2lkGggucWYuVXNlciIAEiEKCEdldFVzZXJzEggucWYuVm9pZBoJLnFmLlVzZXJzIgASIgoKVXBkYXRlVXNlchIILnFmLlVzZXIaCC5xZi5Wb2lkIgASKQoIR2V0R3JvdXASEC5xZi5Hcm91cFJlcXVlc3QaCS5xZi5Hcm91cCIAEjQKEUdldEdyb3Vwc0J5Q291cnNlEhEucWYuQ291cnNlUmVxdWVzdBoKLnFmLkdyb3VwcyIAEiUKC0NyZWF0ZUdyb3VwEgkucWYuR3JvdXAaCS5xZi5Hcm91cCIAEiUKC1VwZGF0ZUdyb3VwEgkucWYuR3JvdXAaCS5xZi5Hcm91cCIAEisKC0RlbGV0ZUdyb3VwEhAucWYuR3JvdXBSZXF1ZXN0GggucWYuVm9pZCIAEiwKCUdldENvdXJzZRIRLnFmLkNvdXJzZVJlcXVlc3QaCi5xZi5Db3Vyc2UiABIlCgpHZXRDb3Vyc2VzEggucWYuVm9pZBoLLnFmLkNvdXJzZXMiABImCgxVcGRhdGVDb3Vyc2USCi5xZi5Db3Vyc2UaCC5xZi5Wb2lkIgASNAoWVXBkYXRlQ291cnNlVmlzaWJpbGl0eRIOLnFmLkVucm9sbG1lbnQaCC5xZi5Wb2lkIgASNgoOR2V0QXNzaWdubWVudHMSES5xZi5Db3Vyc2VSZXF1ZXN0Gg8ucWYuQXNzaWdubWVudHMiABIyChFVcGRhdGVBc3NpZ25tZW50cxIRLnFmLkNvdXJzZVJlcXVlc3QaCC5xZi5Wb2lkIgASOgoOR2V0RW5yb2xsbWVudHMSFS5xZi5FbnJvbGxtZW50UmVxdWVzdBoPLnFmLkVucm9sbG1lbnRzIgASLgoQQ3JlYXRlRW5yb2xsbWVudBIOLnFmLkVucm9sbG1lbnQaCC5xZi5Wb2lkIgASMAoRVXBkYXRlRW5yb2xsbWVudHMSDy5xZi5FbnJvbGxtZW50cxoILnFmLlZvaWQiABI4Cg1HZXRTdWJtaXNzaW9uEhUucWYuU3VibWlzc2lvblJlcXVlc3QaDi5xZi5TdWJtaXNzaW9uIgASOgoOR2V0U3VibWlzc2lvbnMSFS5xZi5TdWJtaXNzaW9uUmVxdWVzdBoPLnFmLlN1Ym1pc3Npb25zIgASSAoWR2V0U3VibWlzc2lvbnNCeUNvdXJzZRIVLnFmLlN1Ym1pc3Npb25SZXF1ZXN0GhUucWYuQ291cnNlU3VibWlzc2lvbnMiABIpChBVcGRhdGVTdWJtaXNzaW9uEgkucWYuR3JhZGUaCC5xZi5Wb2lkIgASNwoSUmVidWlsZFN1Ym1pc3Npb25zEhIucWYuUmVidWlsZFJlcXVlc3QaCy5xZi5SZWJ1aWxkIgASNQoNQ2FuY2VsUmVidWlsZBIYLnFmLlJlYnVpbGRTdGF0dXNSZXF1ZXN0GggucWYuVm9pZCIAEkAKE0dldFJlYnVpbGRTdW1tYXJpZXMSES5xZi5Db3Vyc2VSZXF1ZXN0GhQucWYuUmVidWlsZFN1bW1hcmllcyIAEkMKEUdldFJlYnVpbGRTdW1tYXJ5EhgucWYuUmVidWlsZFN0YXR1c1JlcXVlc3QaEi5xZi5SZWJ1aWxkU3VtbWFyeSIAEjYKDkdldFRlc3RzSGVhbHRoEhEucWYuQ291cnNlUmVxdWVzdBoPLnFmLlRlc3RzSGVhbHRoIgASOAoNR2V0Rmxha3lUZXN0cxIVLnFmLkZsYWt5VGVzdHNSZXF1ZXN0Gg4ucWYuRmxha3lUZXN0cyIAEjsKDkdldExlYWRlcmJvYXJkEhYucWYuTGVhZGVyYm9hcmRSZXF1ZXN0Gg8ucWYuTGVhZGVyYm9hcmQiABJKChNHZXRTdGFsZVN1Ym1pc3Npb25zEhsucWYuU3RhbGVTdWJtaXNzaW9uc1JlcXVlc3QaFC5xZi5TdGFsZVN1Ym1pc3Npb25zIgASRwoSR2V0QnVpbGRMb2dBcmNoaXZlEhoucWYuQnVpbGRMb2dBcmNoaXZlUmVxdWVzdBoTLnFmLkJ1aWxkTG9nQXJjaGl2ZSIAEi8KDENyZWF0ZVJldmlldxIRLnFmLlJldmlld1JlcXVlc3QaCi5xZi5SZXZpZXciABIvCgxVcGRhdGVSZXZpZXcSES5xZi5SZXZpZXdSZXF1ZXN0GgoucWYuUmV2aWV3IgASPgoYQ3JlYXRlQXNzaWdubWVudEZlZWRiYWNrEhYucWYuQXNzaWdubWVudEZlZWRiYWNrGggucWYuVm9pZCIAEkUKFUdldEFzc2lnbm1lbnRGZWVkYmFjaxIRLnFmLkNvdXJzZVJlcXVlc3QaFy5xZi5Bc3NpZ25tZW50RmVlZGJhY2tzIgASOAoPR2V0UmVwb3NpdG9yaWVzEhEucWYuQ291cnNlUmVxdWVzdBoQLnFmLlJlcG9zaXRvcmllcyIAEjAKC0lzRW1wdHlSZXBvEhUucWYuUmVwb3NpdG9yeVJlcXVlc3QaCC5xZi5Wb2lkIgASMAoQU3VibWlzc2lvblN0cmVhbRIILnFmLlZvaWQaDi5xZi5TdWJtaXNzaW9uIgAwARJCCg1SZWJ1aWxkU3RyZWFtEhgucWYuUmVidWlsZFN0YXR1c1JlcXVlc3QaEy5xZi5SZWJ1aWxkUHJvZ3Jlc3MiADABEjcKDkJ1aWxkTG9nU3RyZWFtEhMucWYuQnVpbGRMb2dSZXF1ZXN0GgwucWYuQnVpbGRMb2ciADABQiZaIWdpdGh1Yi5jb20vcXVpY2tmZWVkL3F1aWNrZmVlZC9xZroCAGIGcHJvdG8z", [file_qf_types, file_qf_requests]);
 
 /**
  * users //
@@ -199,13 +199,99 @@ export const QuickFeedService: GenService<{
     output: typeof VoidSchema;
   },
   /**
+   * RebuildSubmissions rebuilds a single submission, or all submissions for the assignment
+   * if no submission ID is given. Rebuilding all submissions returns immediately;
+   * use RebuildStream to follow the rebuild's progress.
+   *
    * @generated from rpc qf.QuickFeedService.RebuildSubmissions
    */
   rebuildSubmissions: {
     methodKind: "unary";
     input: typeof RebuildRequestSchema;
+    output: typeof RebuildSchema;
+  },
+  /**
+   * @generated from rpc qf.QuickFeedService.CancelRebuild
+   */
+  cancelRebuild: {
+    methodKind: "unary";
+    input: typeof RebuildStatusRequestSchema;
     output: typeof VoidSchema;
   },
+  /**
+   * GetRebuildSummaries returns summaries of the course's most recent rebuilds,
+   * including how the rebuilds changed the scores of the rebuilt submissions.
+   *
+   * @generated from rpc qf.QuickFeedService.GetRebuildSummaries
+   */
+  getRebuildSummaries: {
+    methodKind: "unary";
+    input: typeof CourseRequestSchema;
+    output: typeof RebuildSummariesSchema;
+  },
+  /**
+   * GetRebuildSummary returns the summary of the given rebuild, e.g., the report of a dry run.
+   *
+   * @generated from rpc qf.QuickFeedService.GetRebuildSummary
+   */
+  getRebuildSummary: {
+    methodKind: "unary";
+    input: typeof RebuildStatusRequestSchema;
+    output: typeof RebuildSummarySchema;
+  },
+  /**
+   * GetTestsHealth returns the results of the most recent runs of the course's tests
+   * against the reference solution, one for each assignment.
+   *
+   * @generated from rpc qf.QuickFeedService.GetTestsHealth
+   */
+  getTestsHealth: {
+    methodKind: "unary";
+    input: typeof CourseRequestSchema;
+    output: typeof TestsHealthSchema;
+  },
+  /**
+   * GetFlakyTests returns the tests of the given assignment that passed only when rerun,
+   * aggregated over the assignment's recorded submissions.
+   *
+   * @generated from rpc qf.QuickFeedService.GetFlakyTests
+   */
+  getFlakyTests: {
+    methodKind: "unary";
+    input: typeof FlakyTestsRequestSchema;
+    output: typeof FlakyTestsSchema;
+  },
+  /**
+   * GetLeaderboard ranks the latest submissions for an assignment by a benchmark metric of one of its tests.
+   * Students may only view the leaderboards of courses that have enabled leaderboards.
+   *
+   * @generated from rpc qf.QuickFeedService.GetLeaderboard
+   */
+  getLeaderboard: {
+    methodKind: "unary";
+    input: typeof LeaderboardRequestSchema;
+    output: typeof LeaderboardSchema;
+  },
+  /**
+   * GetStaleSubmissions returns the submissions graded with another revision of the tests repository.
+   *
+   * @generated from rpc qf.QuickFeedService.GetStaleSubmissions
+   */
+  getStaleSubmissions: {
+    methodKind: "unary";
+    input: typeof StaleSubmissionsRequestSchema;
+    output: typeof StaleSubmissionsSchema;
+  },
+  /**
+   * GetBuildLogArchive returns the complete, untruncated build log of a submission's test run.
+   *
+   * @generated from rpc qf.QuickFeedService.GetBuildLogArchive
+   */
+  getBuildLogArchive: {
+    methodKind: "unary";
+    input: typeof BuildLogArchiveRequestSchema;
+    output: typeof BuildLogArchiveSchema;
+  },
   /**
    * @generated from rpc qf.QuickFeedService.CreateReview
    */
@@ -262,6 +348,25 @@ export const QuickFeedService: GenService<{
     input: typeof VoidSchema;
     output: typeof SubmissionSchema;
   },
+  /**
+   * @generated from rpc qf.QuickFeedService.RebuildStream
+   */
+  rebuildStream: {
+    methodKind: "server_streaming";
+    input: typeof RebuildStatusRequestSchema;
+    output: typeof RebuildProgressSchema;
+  },
+  /**
+   * BuildLogStream sends the output of the user's or group's test run for the given assignment,
+   * while the tests are running. The stream is closed when the test run is finished.
+   *
+   * @generated from rpc qf.QuickFeedService.BuildLogStream
+   */
+  buildLogStream: {
+    methodKind: "server_streaming";
+    input: typeof BuildLogRequestSchema;
+    output: typeof BuildLogSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_qf_quickfeed, 0);
 
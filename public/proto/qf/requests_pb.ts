@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file qf/requests.proto.
  */
 export const file_qf_requests: GenFile = /*@__PURE__*/
-  fileDesc("ChFxZi9yZXF1ZXN0cy5wcm90bxICcWYilQEKEUNvdXJzZVN1Ym1pc3Npb25zEjsKC3N1Ym1pc3Npb25zGAEgAygLMiYucWYuQ291cnNlU3VibWlzc2lvbnMuU3VibWlzc2lvbnNFbnRyeRpDChBTdWJtaXNzaW9uc0VudHJ5EgsKA2tleRgBIAEoBBIeCgV2YWx1ZRgCIAEoCzIPLnFmLlN1Ym1pc3Npb25zOgI4ASI9Cg1SZXZpZXdSZXF1ZXN0EhAKCGNvdXJzZUlEGAEgASgEEhoKBnJldmlldxgCIAEoCzIKLnFmLlJldmlldyIhCg1Db3Vyc2VSZXF1ZXN0EhAKCGNvdXJzZUlEGAEgASgEIkEKDEdyb3VwUmVxdWVzdBIQCghjb3Vyc2VJRBgBIAEoBBIOCgZ1c2VySUQYAiABKAQSDwoHZ3JvdXBJRBgDIAEoBCJGCgxPcmdhbml6YXRpb24SGQoRU2NtT3JnYW5pemF0aW9uSUQYASABKAQSGwoTU2NtT3JnYW5pemF0aW9uTmFtZRgCIAEoCSJzChFFbnJvbGxtZW50UmVxdWVzdBISCghjb3Vyc2VJRBgBIAEoBEgAEhAKBnVzZXJJRBgCIAEoBEgAEisKCHN0YXR1c2VzGAMgAygOMhkucWYuRW5yb2xsbWVudC5Vc2VyU3RhdHVzQgsKCUZldGNoTW9kZSLrAQoRU3VibWlzc2lvblJlcXVlc3QSEAoIQ291cnNlSUQYASABKAQSFAoMQXNzaWdubWVudElEGAIgASgEEhAKBlVzZXJJRBgDIAEoBEgAEhEKB0dyb3VwSUQYBCABKARIABIWCgxTdWJtaXNzaW9uSUQYBSABKARIABI0CgRUeXBlGAYgASgOMiQucWYuU3VibWlzc2lvblJlcXVlc3QuU3VibWlzc2lvblR5cGVIACIuCg5TdWJtaXNzaW9uVHlwZRIHCgNBTEwQABIICgRVU0VSEAESCQoFR1JPVVAQAkILCglGZXRjaE1vZGUiRgoRUmVwb3NpdG9yeVJlcXVlc3QSDgoGdXNlcklEGAEgASgEEg8KB2dyb3VwSUQYAiABKAQSEAoIY291cnNlSUQYAyABKAQiZQoMUmVwb3NpdG9yaWVzEigKBFVSTHMYASADKAsyGi5xZi5SZXBvc2l0b3JpZXMuVVJMc0VudHJ5GisKCVVSTHNFbnRyeRILCgNrZXkYASABKA0SDQoFdmFsdWUYAiABKAk6AjgBIk4KDlJlYnVpbGRSZXF1ZXN0EhAKCGNvdXJzZUlEGAEgASgEEhQKDGFzc2lnbm1lbnRJRBgCIAEoBBIUCgxzdWJtaXNzaW9uSUQYAyABKAQiBgoEVm9pZEImWiFnaXRodWIuY29tL3F1aWNrZmVlZC9xdWlja2ZlZWQvcWa6AgBiBnByb3RvMw", [file_qf_types]);
+  fileDesc("ChFxZi9yZXF1ZXN0cy5wcm90bxICcWYilQEKEUNvdXJzZVN1Ym1pc3Npb25zEjsKC3N1Ym1pc3Npb25zGAEgAygLMiYucWYuQ291cnNlU3VibWlzc2lvbnMuU3VibWlzc2lvbnNFbnRyeRpDChBTdWJtaXNzaW9uc0VudHJ5EgsKA2tleRgBIAEoBBIeCgV2YWx1ZRgCIAEoCzIPLnFmLlN1Ym1pc3Npb25zOgI4ASI9Cg1SZXZpZXdSZXF1ZXN0EhAKCGNvdXJzZUlEGAEgASgEEhoKBnJldmlldxgCIAEoCzIKLnFmLlJldmlldyIhCg1Db3Vyc2VSZXF1ZXN0EhAKCGNvdXJzZUlEGAEgASgEIkEKDEdyb3VwUmVxdWVzdBIQCghjb3Vyc2VJRBgBIAEoBBIOCgZ1c2VySUQYAiABKAQSDwoHZ3JvdXBJRBgDIAEoBCJGCgxPcmdhbml6YXRpb24SGQoRU2NtT3JnYW5pemF0aW9uSUQYASABKAQSGwoTU2NtT3JnYW5pemF0aW9uTmFtZRgCIAEoCSJzChFFbnJvbGxtZW50UmVxdWVzdBISCghjb3Vyc2VJRBgBIAEoBEgAEhAKBnVzZXJJRBgCIAEoBEgAEisKCHN0YXR1c2VzGAMgAygOMhkucWYuRW5yb2xsbWVudC5Vc2VyU3RhdHVzQgsKCUZldGNoTW9kZSLrAQoRU3VibWlzc2lvblJlcXVlc3QSEAoIQ291cnNlSUQYASABKAQSFAoMQXNzaWdubWVudElEGAIgASgEEhAKBlVzZXJJRBgDIAEoBEgAEhEKB0dyb3VwSUQYBCABKARIABIWCgxTdWJtaXNzaW9uSUQYBSABKARIABI0CgRUeXBlGAYgASgOMiQucWYuU3VibWlzc2lvblJlcXVlc3QuU3VibWlzc2lvblR5cGVIACIuCg5TdWJtaXNzaW9uVHlwZRIHCgNBTEwQABIICgRVU0VSEAESCQoFR1JPVVAQAkILCglGZXRjaE1vZGUiRgoRUmVwb3NpdG9yeVJlcXVlc3QSDgoGdXNlcklEGAEgASgEEg8KB2dyb3VwSUQYAiABKAQSEAoIY291cnNlSUQYAyABKAQiZQoMUmVwb3NpdG9yaWVzEigKBFVSTHMYASADKAsyGi5xZi5SZXBvc2l0b3JpZXMuVVJMc0VudHJ5GisKCVVSTHNFbnRyeRILCgNrZXkYASABKA0SDQoFdmFsdWUYAiABKAk6AjgBIpEBCg5SZWJ1aWxkUmVxdWVzdBIQCghjb3Vyc2VJRBgBIAEoBBIUCgxhc3NpZ25tZW50SUQYAiABKAQSFAoMc3VibWlzc2lvbklEGAMgASgEEg0KBWZvcmNlGAQgASgIEg0KBXN0YWxlGAUgASgIEg4KBmRyeVJ1bhgGIAEoCBITCgt0ZXN0c0JyYW5jaBgHIAEoCSJBChdTdGFsZVN1Ym1pc3Npb25zUmVxdWVzdBIQCghjb3Vyc2VJRBgBIAEoBBIUCgxhc3NpZ25tZW50SUQYAiABKAQiOwoRRmxha3lUZXN0c1JlcXVlc3QSEAoIY291cnNlSUQYASABKAQSFAoMYXNzaWdubWVudElEGAIgASgEIsoBChJMZWFkZXJib2FyZFJlcXVlc3QSEAoIY291cnNlSUQYASABKAQSFAoMYXNzaWdubWVudElEGAIgASgEEhAKCHRlc3ROYW1lGAMgASgJEi0KBm1ldHJpYxgEIAEoDjIdLnFmLkxlYWRlcmJvYXJkUmVxdWVzdC5NZXRyaWMiSwoGTWV0cmljEg0KCU5TX1BFUl9PUBAAEhEKDUFMTE9DU19QRVJfT1AQARIQCgxCWVRFU19QRVJfT1AQAhINCglFWEVDX1RJTUUQAyI7ChRSZWJ1aWxkU3RhdHVzUmVxdWVzdBIQCghjb3Vyc2VJRBgBIAEoBBIRCglyZWJ1aWxkSUQYAiABKAQiWgoPQnVpbGRMb2dSZXF1ZXN0EhAKCGNvdXJzZUlEGAEgASgEEhQKDGFzc2lnbm1lbnRJRBgCIAEoBBIOCgZ1c2VySUQYAyABKAQSDwoHZ3JvdXBJRBgEIAEoBCJSChZCdWlsZExvZ0FyY2hpdmVSZXF1ZXN0EhAKCGNvdXJzZUlEGAEgASgEEhQKDHN1Ym1pc3Npb25JRBgCIAEoBBIQCghjb21taXRJRBgDIAEoCSIGCgRWb2lkQiZaIWdpdGh1Yi5jb20vcXVpY2tmZWVkL3F1aWNrZmVlZC9xZroCAGIGcHJvdG8z", [file_qf_types]);
 
 /**
  * @generated from message qf.CourseSubmissions
@@ -312,6 +312,34 @@ export type RebuildRequest = Message<"qf.RebuildRequest"> & {
    * @generated from field: uint64 submissionID = 3;
    */
   submissionID: bigint;
+
+  /**
+   * run the tests even if results for the same commit and tests are cached
+   *
+   * @generated from field: bool force = 4;
+   */
+  force: boolean;
+
+  /**
+   * only rebuild submissions graded with another revision of the tests repository
+   *
+   * @generated from field: bool stale = 5;
+   */
+  stale: boolean;
+
+  /**
+   * run the tests without recording the results; reported by GetRebuildSummary
+   *
+   * @generated from field: bool dryRun = 6;
+   */
+  dryRun: boolean;
+
+  /**
+   * branch of the tests repository to run in a dry run; empty for the current tests
+   *
+   * @generated from field: string testsBranch = 7;
+   */
+  testsBranch: string;
 };
 
 /**
@@ -321,6 +349,216 @@ export type RebuildRequest = Message<"qf.RebuildRequest"> & {
 export const RebuildRequestSchema: GenMessage<RebuildRequest> = /*@__PURE__*/
   messageDesc(file_qf_requests, 9);
 
+/**
+ * StaleSubmissionsRequest selects the submissions for an assignment that were graded
+ * with another revision of the tests repository than the current revision.
+ *
+ * @generated from message qf.StaleSubmissionsRequest
+ */
+export type StaleSubmissionsRequest = Message<"qf.StaleSubmissionsRequest"> & {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID: bigint;
+
+  /**
+   * @generated from field: uint64 assignmentID = 2;
+   */
+  assignmentID: bigint;
+};
+
+/**
+ * Describes the message qf.StaleSubmissionsRequest.
+ * Use `create(StaleSubmissionsRequestSchema)` to create a new message.
+ */
+export const StaleSubmissionsRequestSchema: GenMessage<StaleSubmissionsRequest> = /*@__PURE__*/
+  messageDesc(file_qf_requests, 10);
+
+/**
+ * FlakyTestsRequest selects the assignment whose flaky tests to report.
+ *
+ * @generated from message qf.FlakyTestsRequest
+ */
+export type FlakyTestsRequest = Message<"qf.FlakyTestsRequest"> & {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID: bigint;
+
+  /**
+   * @generated from field: uint64 assignmentID = 2;
+   */
+  assignmentID: bigint;
+};
+
+/**
+ * Describes the message qf.FlakyTestsRequest.
+ * Use `create(FlakyTestsRequestSchema)` to create a new message.
+ */
+export const FlakyTestsRequestSchema: GenMessage<FlakyTestsRequest> = /*@__PURE__*/
+  messageDesc(file_qf_requests, 11);
+
+/**
+ * LeaderboardRequest selects the benchmark test and metric by which to rank an assignment's submissions.
+ *
+ * @generated from message qf.LeaderboardRequest
+ */
+export type LeaderboardRequest = Message<"qf.LeaderboardRequest"> & {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID: bigint;
+
+  /**
+   * @generated from field: uint64 assignmentID = 2;
+   */
+  assignmentID: bigint;
+
+  /**
+   * @generated from field: string testName = 3;
+   */
+  testName: string;
+
+  /**
+   * lower values rank higher
+   *
+   * @generated from field: qf.LeaderboardRequest.Metric metric = 4;
+   */
+  metric: LeaderboardRequest_Metric;
+};
+
+/**
+ * Describes the message qf.LeaderboardRequest.
+ * Use `create(LeaderboardRequestSchema)` to create a new message.
+ */
+export const LeaderboardRequestSchema: GenMessage<LeaderboardRequest> = /*@__PURE__*/
+  messageDesc(file_qf_requests, 12);
+
+/**
+ * @generated from enum qf.LeaderboardRequest.Metric
+ */
+export enum LeaderboardRequest_Metric {
+  /**
+   * nanoseconds per operation
+   *
+   * @generated from enum value: NS_PER_OP = 0;
+   */
+  NS_PER_OP = 0,
+
+  /**
+   * allocations per operation
+   *
+   * @generated from enum value: ALLOCS_PER_OP = 1;
+   */
+  ALLOCS_PER_OP = 1,
+
+  /**
+   * bytes allocated per operation
+   *
+   * @generated from enum value: BYTES_PER_OP = 2;
+   */
+  BYTES_PER_OP = 2,
+
+  /**
+   * execution time of the test
+   *
+   * @generated from enum value: EXEC_TIME = 3;
+   */
+  EXEC_TIME = 3,
+}
+
+/**
+ * Describes the enum qf.LeaderboardRequest.Metric.
+ */
+export const LeaderboardRequest_MetricSchema: GenEnum<LeaderboardRequest_Metric> = /*@__PURE__*/
+  enumDesc(file_qf_requests, 12, 0);
+
+/**
+ * @generated from message qf.RebuildStatusRequest
+ */
+export type RebuildStatusRequest = Message<"qf.RebuildStatusRequest"> & {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID: bigint;
+
+  /**
+   * @generated from field: uint64 rebuildID = 2;
+   */
+  rebuildID: bigint;
+};
+
+/**
+ * Describes the message qf.RebuildStatusRequest.
+ * Use `create(RebuildStatusRequestSchema)` to create a new message.
+ */
+export const RebuildStatusRequestSchema: GenMessage<RebuildStatusRequest> = /*@__PURE__*/
+  messageDesc(file_qf_requests, 13);
+
+/**
+ * BuildLogRequest selects the user's or group's test run to follow; the group ID takes precedence.
+ *
+ * @generated from message qf.BuildLogRequest
+ */
+export type BuildLogRequest = Message<"qf.BuildLogRequest"> & {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID: bigint;
+
+  /**
+   * @generated from field: uint64 assignmentID = 2;
+   */
+  assignmentID: bigint;
+
+  /**
+   * @generated from field: uint64 userID = 3;
+   */
+  userID: bigint;
+
+  /**
+   * @generated from field: uint64 groupID = 4;
+   */
+  groupID: bigint;
+};
+
+/**
+ * Describes the message qf.BuildLogRequest.
+ * Use `create(BuildLogRequestSchema)` to create a new message.
+ */
+export const BuildLogRequestSchema: GenMessage<BuildLogRequest> = /*@__PURE__*/
+  messageDesc(file_qf_requests, 14);
+
+/**
+ * BuildLogArchiveRequest selects the archived build log of a submission's test run.
+ * If no commit ID is given, the most recently archived log for the submission is selected.
+ *
+ * @generated from message qf.BuildLogArchiveRequest
+ */
+export type BuildLogArchiveRequest = Message<"qf.BuildLogArchiveRequest"> & {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID: bigint;
+
+  /**
+   * @generated from field: uint64 submissionID = 2;
+   */
+  submissionID: bigint;
+
+  /**
+   * @generated from field: string commitID = 3;
+   */
+  commitID: string;
+};
+
+/**
+ * Describes the message qf.BuildLogArchiveRequest.
+ * Use `create(BuildLogArchiveRequestSchema)` to create a new message.
+ */
+export const BuildLogArchiveRequestSchema: GenMessage<BuildLogArchiveRequest> = /*@__PURE__*/
+  messageDesc(file_qf_requests, 15);
+
 /**
  * @generated from message qf.Void
  */
@@ -332,5 +570,5 @@ export type Void = Message<"qf.Void"> & {
  * Use `create(VoidSchema)` to create a new message.
  */
 export const VoidSchema: GenMessage<Void> = /*@__PURE__*/
-  messageDesc(file_qf_requests, 10);
+  messageDesc(file_qf_requests, 16);
 
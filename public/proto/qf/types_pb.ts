@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file qf/types.proto.
  */
 export const file_qf_types: GenFile = /*@__PURE__*/
-  fileDesc("Cg5xZi90eXBlcy5wcm90bxICcWYiqgIKBFVzZXISCgoCSUQYASABKAQSDwoHSXNBZG1pbhgCIAEoCBIMCgROYW1lGAMgASgJEhEKCVN0dWRlbnRJRBgEIAEoCRINCgVFbWFpbBgFIAEoCRIRCglBdmF0YXJVUkwYBiABKAkSDQoFTG9naW4YByABKAkSEwoLVXBkYXRlVG9rZW4YCCABKAgSEwoLU2NtUmVtb3RlSUQYCSABKAQSFAoMUmVmcmVzaFRva2VuGAogASgJEiMKC0Vucm9sbG1lbnRzGAsgAygLMg4ucWYuRW5yb2xsbWVudBJOChBGZWVkYmFja1JlY2VpcHRzGAwgAygLMhMucWYuRmVlZGJhY2tSZWNlaXB0Qh/KtQMbogEYZ29ybToiZm9yZWlnbktleTpVc2VySUQiIiAKBVVzZXJzEhcKBXVzZXJzGAEgAygLMggucWYuVXNlciL+AgoFR3JvdXASCgoCSUQYASABKAQSLQoEbmFtZRgCIAEoCUIfyrUDG6IBGGdvcm06InVuaXF1ZUluZGV4Omdyb3VwIhIxCghjb3Vyc2VJRBgDIAEoBEIfyrUDG6IBGGdvcm06InVuaXF1ZUluZGV4Omdyb3VwIhIlCgZzdGF0dXMYBSABKA4yFS5xZi5Hcm91cC5Hcm91cFN0YXR1cxI9CgV1c2VycxgGIAMoCzIILnFmLlVzZXJCJMq1AyCiAR1nb3JtOiJtYW55Mm1hbnk6Z3JvdXBfdXNlcnM7IhIjCgtlbnJvbGxtZW50cxgHIAMoCzIOLnFmLkVucm9sbG1lbnQSJgoMdXNlZFNsaXBEYXlzGAggAygLMhAucWYuVXNlZFNsaXBEYXlzEioKEXNsaXBEYXlzUmVtYWluaW5nGAkgASgNQg/KtQMLogEIZ29ybToiLSIiKAoLR3JvdXBTdGF0dXMSCwoHUEVORElORxAAEgwKCEFQUFJPVkVEEAEiIwoGR3JvdXBzEhkKBmdyb3VwcxgBIAMoCzIJLnFmLkdyb3VwIq8DCgZDb3Vyc2USCgoCSUQYASABKAQSFwoPY291cnNlQ3JlYXRvcklEGAIgASgEEgwKBG5hbWUYAyABKAkSLgoEY29kZRgEIAEoCUIgyrUDHKIBGWdvcm06InVuaXF1ZUluZGV4OmNvdXJzZSISLgoEeWVhchgFIAEoDUIgyrUDHKIBGWdvcm06InVuaXF1ZUluZGV4OmNvdXJzZSISCwoDdGFnGAYgASgJEhkKEVNjbU9yZ2FuaXphdGlvbklEGAggASgEEhsKE1NjbU9yZ2FuaXphdGlvbk5hbWUYCSABKAkSEAoIc2xpcERheXMYCiABKA0SGAoQRG9ja2VyZmlsZURpZ2VzdBgLIAEoCRI8CghlbnJvbGxlZBgMIAEoDjIZLnFmLkVucm9sbG1lbnQuVXNlclN0YXR1c0IPyrUDC6IBCGdvcm06Ii0iEiMKC2Vucm9sbG1lbnRzGA0gAygLMg4ucWYuRW5yb2xsbWVudBIjCgthc3NpZ25tZW50cxgOIAMoCzIOLnFmLkFzc2lnbm1lbnQSGQoGZ3JvdXBzGA8gAygLMgkucWYuR3JvdXAiJgoHQ291cnNlcxIbCgdjb3Vyc2VzGAEgAygLMgoucWYuQ291cnNlIqUDCgpSZXBvc2l0b3J5EgoKAklEGAEgASgEEj8KEVNjbU9yZ2FuaXphdGlvbklEGAIgASgEQiTKtQMgogEdZ29ybToidW5pcXVlSW5kZXg6cmVwb3NpdG9yeSISFwoPU2NtUmVwb3NpdG9yeUlEGAMgASgEEjQKBnVzZXJJRBgEIAEoBEIkyrUDIKIBHWdvcm06InVuaXF1ZUluZGV4OnJlcG9zaXRvcnkiEjUKB2dyb3VwSUQYBSABKARCJMq1AyCiAR1nb3JtOiJ1bmlxdWVJbmRleDpyZXBvc2l0b3J5IhIPCgdIVE1MVVJMGAYgASgJEksKCHJlcG9UeXBlGAcgASgOMhMucWYuUmVwb3NpdG9yeS5UeXBlQiTKtQMgogEdZ29ybToidW5pcXVlSW5kZXg6cmVwb3NpdG9yeSISGQoGaXNzdWVzGAggAygLMgkucWYuSXNzdWUiSwoEVHlwZRIICgROT05FEAASCAoESU5GTxABEg8KC0FTU0lHTk1FTlRTEAISCQoFVEVTVFMQAxIICgRVU0VSEAQSCQoFR1JPVVAQBSKQBQoKRW5yb2xsbWVudBIKCgJJRBgBIAEoBBI2Cghjb3Vyc2VJRBgCIAEoBEIkyrUDIKIBHWdvcm06InVuaXF1ZUluZGV4OmVucm9sbG1lbnQiEjQKBnVzZXJJRBgDIAEoBEIkyrUDIKIBHWdvcm06InVuaXF1ZUluZGV4OmVucm9sbG1lbnQiEg8KB2dyb3VwSUQYBCABKAQSFgoEdXNlchgFIAEoCzIILnFmLlVzZXISGgoGY291cnNlGAYgASgLMgoucWYuQ291cnNlEhgKBWdyb3VwGAcgASgLMgkucWYuR3JvdXASKQoGc3RhdHVzGAggASgOMhkucWYuRW5yb2xsbWVudC5Vc2VyU3RhdHVzEioKBXN0YXRlGAkgASgOMhsucWYuRW5yb2xsbWVudC5EaXNwbGF5U3RhdGUSKgoRc2xpcERheXNSZW1haW5pbmcYCiABKA1CD8q1AwuiAQhnb3JtOiItIhJmChBsYXN0QWN0aXZpdHlEYXRlGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIwyrUDLKIBKWdvcm06InNlcmlhbGl6ZXI6dGltZXN0YW1wO3R5cGU6ZGF0ZXRpbWUiEhUKDXRvdGFsQXBwcm92ZWQYDCABKAQSJgoMdXNlZFNsaXBEYXlzGA0gAygLMhAucWYuVXNlZFNsaXBEYXlzIj0KClVzZXJTdGF0dXMSCAoETk9ORRAAEgsKB1BFTkRJTkcQARILCgdTVFVERU5UEAISCwoHVEVBQ0hFUhADIkAKDERpc3BsYXlTdGF0ZRIJCgVVTlNFVBAAEgoKBkhJRERFThABEgsKB1ZJU0lCTEUQAhIMCghGQVZPUklURRADImkKDFVzZWRTbGlwRGF5cxIKCgJJRBgBIAEoBBIUCgxlbnJvbGxtZW50SUQYAiABKAQSFAoMYXNzaWdubWVudElEGAMgASgEEhAKCHVzZWREYXlzGAQgASgNEg8KB2dyb3VwSUQYBSABKAQiMgoLRW5yb2xsbWVudHMSIwoLZW5yb2xsbWVudHMYASADKAsyDi5xZi5FbnJvbGxtZW50IqUDCgpBc3NpZ25tZW50EgoKAklEGAEgASgEEhAKCENvdXJzZUlEGAIgASgEEgwKBG5hbWUYAyABKAkSXgoIZGVhZGxpbmUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQjDKtQMsogEpZ29ybToic2VyaWFsaXplcjp0aW1lc3RhbXA7dHlwZTpkYXRldGltZSISEwoLYXV0b0FwcHJvdmUYBSABKAgSDQoFb3JkZXIYBiABKA0SEgoKaXNHcm91cExhYhgHIAEoCBISCgpzY29yZUxpbWl0GAggASgNEhEKCXJldmlld2VycxgJIAEoDRIYChBjb250YWluZXJUaW1lb3V0GAogASgNEiMKC3N1Ym1pc3Npb25zGAsgAygLMg4ucWYuU3VibWlzc2lvbhIXCgV0YXNrcxgMIAMoCzIILnFmLlRhc2sSLwoRZ3JhZGluZ0JlbmNobWFya3MYDSADKAsyFC5xZi5HcmFkaW5nQmVuY2htYXJrEiMKDUV4cGVjdGVkVGVzdHMYDiADKAsyDC5xZi5UZXN0SW5mbyK5AQoIVGVzdEluZm8SCgoCSUQYASABKAQSOAoMQXNzaWdubWVudElEGAIgASgEQiLKtQMeogEbZ29ybToidW5pcXVlSW5kZXg6dGVzdGluZm8iEjQKCFRlc3ROYW1lGAMgASgJQiLKtQMeogEbZ29ybToidW5pcXVlSW5kZXg6dGVzdGluZm8iEhAKCE1heFNjb3JlGAQgASgFEg4KBldlaWdodBgFIAEoBRIPCgdEZXRhaWxzGAYgASgJIocBCgRUYXNrEgoKAklEGAEgASgEEhQKDGFzc2lnbm1lbnRJRBgCIAEoBBIXCg9hc3NpZ25tZW50T3JkZXIYAyABKA0SDQoFdGl0bGUYBCABKAkSDAoEYm9keRgFIAEoCRIMCgRuYW1lGAYgASgJEhkKBmlzc3VlcxgHIAMoCzIJLnFmLklzc3VlIlEKBUlzc3VlEgoKAklEGAEgASgEEhQKDHJlcG9zaXRvcnlJRBgCIAEoBBIOCgZ0YXNrSUQYAyABKAQSFgoOU2NtSXNzdWVOdW1iZXIYBCABKAQi/QEKC1B1bGxSZXF1ZXN0EgoKAklEGAEgASgEEhcKD1NjbVJlcG9zaXRvcnlJRBgCIAEoBBIOCgZ0YXNrSUQYAyABKAQSDwoHaXNzdWVJRBgEIAEoBBIOCgZ1c2VySUQYBSABKAQSFAoMU2NtQ29tbWVudElEGAYgASgEEhQKDHNvdXJjZUJyYW5jaBgHIAEoCRIOCgZudW1iZXIYCCABKAQSJAoFc3RhZ2UYCSABKA4yFS5xZi5QdWxsUmVxdWVzdC5TdGFnZSI2CgVTdGFnZRIICgROT05FEAASCQoFRFJBRlQQARIKCgZSRVZJRVcQAhIMCghBUFBST1ZFRBADIjIKC0Fzc2lnbm1lbnRzEiMKC2Fzc2lnbm1lbnRzGAEgAygLMg4ucWYuQXNzaWdubWVudCKPAwoKU3VibWlzc2lvbhIKCgJJRBgBIAEoBBIUCgxBc3NpZ25tZW50SUQYAiABKAQSDgoGdXNlcklEGAMgASgEEg8KB2dyb3VwSUQYBCABKAQSDQoFc2NvcmUYBSABKA0SEgoKY29tbWl0SGFzaBgGIAEoCRIZCgZHcmFkZXMYByADKAsyCS5xZi5HcmFkZRJiCgxhcHByb3ZlZERhdGUYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQjDKtQMsogEpZ29ybToic2VyaWFsaXplcjp0aW1lc3RhbXA7dHlwZTpkYXRldGltZSISGwoHcmV2aWV3cxgJIAMoCzIKLnFmLlJldmlldxIjCglCdWlsZEluZm8YCiABKAsyEC5zY29yZS5CdWlsZEluZm8SHAoGU2NvcmVzGAsgAygLMgwuc2NvcmUuU2NvcmUiPAoGU3RhdHVzEggKBE5PTkUQABIMCghBUFBST1ZFRBABEgwKCFJFSkVDVEVEEAISDAoIUkVWSVNJT04QAyIyCgtTdWJtaXNzaW9ucxIjCgtzdWJtaXNzaW9ucxgBIAMoCzIOLnFmLlN1Ym1pc3Npb24ilgEKBUdyYWRlEjUKDFN1Ym1pc3Npb25JRBgBIAEoBEIfyrUDG6IBGGdvcm06InVuaXF1ZUluZGV4OmdyYWRlIhIvCgZVc2VySUQYAiABKARCH8q1AxuiARhnb3JtOiJ1bmlxdWVJbmRleDpncmFkZSISJQoGU3RhdHVzGAMgASgOMhUucWYuU3VibWlzc2lvbi5TdGF0dXMiyAEKEEdyYWRpbmdCZW5jaG1hcmsSCgoCSUQYASABKAQSEAoIQ291cnNlSUQYAiABKAQSFAoMQXNzaWdubWVudElEGAMgASgEEhAKCFJldmlld0lEGAQgASgEEg8KB2hlYWRpbmcYBSABKAkSDwoHY29tbWVudBgGIAEoCRJMCghjcml0ZXJpYRgHIAMoCzIULnFmLkdyYWRpbmdDcml0ZXJpb25CJMq1AyCiAR1nb3JtOiJmb3JlaWduS2V5OkJlbmNobWFya0lEIiI2CgpCZW5jaG1hcmtzEigKCmJlbmNobWFya3MYASADKAsyFC5xZi5HcmFkaW5nQmVuY2htYXJrItEBChBHcmFkaW5nQ3JpdGVyaW9uEgoKAklEGAEgASgEEhMKC0JlbmNobWFya0lEGAIgASgEEhAKCENvdXJzZUlEGAMgASgEEg4KBnBvaW50cxgEIAEoBBITCgtkZXNjcmlwdGlvbhgFIAEoCRIpCgVncmFkZRgGIAEoDjIaLnFmLkdyYWRpbmdDcml0ZXJpb24uR3JhZGUSDwoHY29tbWVudBgHIAEoCSIpCgVHcmFkZRIICgROT05FEAASCgoGRkFJTEVEEAESCgoGUEFTU0VEEAIikQIKBlJldmlldxIKCgJJRBgBIAEoBBIUCgxTdWJtaXNzaW9uSUQYAiABKAQSEgoKUmV2aWV3ZXJJRBgDIAEoBBIQCghmZWVkYmFjaxgEIAEoCRINCgVzY29yZRgFIAEoDRJSChFncmFkaW5nQmVuY2htYXJrcxgGIAMoCzIULnFmLkdyYWRpbmdCZW5jaG1hcmtCIcq1Ax2iARpnb3JtOiJmb3JlaWduS2V5OlJldmlld0lEIhJcCgZlZGl0ZWQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQjDKtQMsogEpZ29ybToic2VyaWFsaXplcjp0aW1lc3RhbXA7dHlwZTpkYXRldGltZSIi8gEKEkFzc2lnbm1lbnRGZWVkYmFjaxIKCgJJRBgBIAEoBBIQCghDb3Vyc2VJRBgCIAEoBBIUCgxBc3NpZ25tZW50SUQYAyABKAQSFAoMTGlrZWRDb250ZW50GAQgASgJEh4KFkltcHJvdmVtZW50U3VnZ2VzdGlvbnMYBSABKAkSEQoJVGltZVNwZW50GAYgASgNEl8KCUNyZWF0ZWRBdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCMMq1AyyiASlnb3JtOiJzZXJpYWxpemVyOnRpbWVzdGFtcDt0eXBlOmRhdGV0aW1lIiKTAQoPRmVlZGJhY2tSZWNlaXB0EkIKDEFzc2lnbm1lbnRJRBgBIAEoBEIsyrUDKKIBJWdvcm06InByaW1hcnlLZXk7YXV0b0luY3JlbWVudDpmYWxzZSISPAoGVXNlcklEGAIgASgEQizKtQMoogElZ29ybToicHJpbWFyeUtleTthdXRvSW5jcmVtZW50OmZhbHNlIiJAChNBc3NpZ25tZW50RmVlZGJhY2tzEikKCWZlZWRiYWNrcxgBIAMoCzIWLnFmLkFzc2lnbm1lbnRGZWVkYmFja0ImWiFnaXRodWIuY29tL3F1aWNrZmVlZC9xdWlja2ZlZWQvcWa6AgBiBnByb3RvMw", [file_google_protobuf_timestamp, file_kit_score_score]);
+  fileDesc("Cg5xZi90eXBlcy5wcm90bxICcWYiqgIKBFVzZXISCgoCSUQYASABKAQSDwoHSXNBZG1pbhgCIAEoCBIMCgROYW1lGAMgASgJEhEKCVN0dWRlbnRJRBgEIAEoCRINCgVFbWFpbBgFIAEoCRIRCglBdmF0YXJVUkwYBiABKAkSDQoFTG9naW4YByABKAkSEwoLVXBkYXRlVG9rZW4YCCABKAgSEwoLU2NtUmVtb3RlSUQYCSABKAQSFAoMUmVmcmVzaFRva2VuGAogASgJEiMKC0Vucm9sbG1lbnRzGAsgAygLMg4ucWYuRW5yb2xsbWVudBJOChBGZWVkYmFja1JlY2VpcHRzGAwgAygLMhMucWYuRmVlZGJhY2tSZWNlaXB0Qh/KtQMbogEYZ29ybToiZm9yZWlnbktleTpVc2VySUQiIiAKBVVzZXJzEhcKBXVzZXJzGAEgAygLMggucWYuVXNlciL+AgoFR3JvdXASCgoCSUQYASABKAQSLQoEbmFtZRgCIAEoCUIfyrUDG6IBGGdvcm06InVuaXF1ZUluZGV4Omdyb3VwIhIxCghjb3Vyc2VJRBgDIAEoBEIfyrUDG6IBGGdvcm06InVuaXF1ZUluZGV4Omdyb3VwIhIlCgZzdGF0dXMYBSABKA4yFS5xZi5Hcm91cC5Hcm91cFN0YXR1cxI9CgV1c2VycxgGIAMoCzIILnFmLlVzZXJCJMq1AyCiAR1nb3JtOiJtYW55Mm1hbnk6Z3JvdXBfdXNlcnM7IhIjCgtlbnJvbGxtZW50cxgHIAMoCzIOLnFmLkVucm9sbG1lbnQSJgoMdXNlZFNsaXBEYXlzGAggAygLMhAucWYuVXNlZFNsaXBEYXlzEioKEXNsaXBEYXlzUmVtYWluaW5nGAkgASgNQg/KtQMLogEIZ29ybToiLSIiKAoLR3JvdXBTdGF0dXMSCwoHUEVORElORxAAEgwKCEFQUFJPVkVEEAEiIwoGR3JvdXBzEhkKBmdyb3VwcxgBIAMoCzIJLnFmLkdyb3VwIo0ECgZDb3Vyc2USCgoCSUQYASABKAQSFwoPY291cnNlQ3JlYXRvcklEGAIgASgEEgwKBG5hbWUYAyABKAkSLgoEY29kZRgEIAEoCUIgyrUDHKIBGWdvcm06InVuaXF1ZUluZGV4OmNvdXJzZSISLgoEeWVhchgFIAEoDUIgyrUDHKIBGWdvcm06InVuaXF1ZUluZGV4OmNvdXJzZSISCwoDdGFnGAYgASgJEhkKEVNjbU9yZ2FuaXphdGlvbklEGAggASgEEhsKE1NjbU9yZ2FuaXphdGlvbk5hbWUYCSABKAkSEAoIc2xpcERheXMYCiABKA0SGAoQRG9ja2VyZmlsZURpZ2VzdBgLIAEoCRI8CghlbnJvbGxlZBgMIAEoDjIZLnFmLkVucm9sbG1lbnQuVXNlclN0YXR1c0IPyrUDC6IBCGdvcm06Ii0iEiMKC2Vucm9sbG1lbnRzGA0gAygLMg4ucWYuRW5yb2xsbWVudBIjCgthc3NpZ25tZW50cxgOIAMoCzIOLnFmLkFzc2lnbm1lbnQSGQoGZ3JvdXBzGA8gAygLMgkucWYuR3JvdXASEwoLYXV0b1JlYnVpbGQYECABKAgSGgoSc29sdXRpb25SZXBvc2l0b3J5GBEgASgJEhYKDnNvbHV0aW9uQnJhbmNoGBIgASgJEhMKC2xlYWRlcmJvYXJkGBMgASgIIiYKB0NvdXJzZXMSGwoHY291cnNlcxgBIAMoCzIKLnFmLkNvdXJzZSKlAwoKUmVwb3NpdG9yeRIKCgJJRBgBIAEoBBI/ChFTY21Pcmdhbml6YXRpb25JRBgCIAEoBEIkyrUDIKIBHWdvcm06InVuaXF1ZUluZGV4OnJlcG9zaXRvcnkiEhcKD1NjbVJlcG9zaXRvcnlJRBgDIAEoBBI0CgZ1c2VySUQYBCABKARCJMq1AyCiAR1nb3JtOiJ1bmlxdWVJbmRleDpyZXBvc2l0b3J5IhI1Cgdncm91cElEGAUgASgEQiTKtQMgogEdZ29ybToidW5pcXVlSW5kZXg6cmVwb3NpdG9yeSISDwoHSFRNTFVSTBgGIAEoCRJLCghyZXBvVHlwZRgHIAEoDjITLnFmLlJlcG9zaXRvcnkuVHlwZUIkyrUDIKIBHWdvcm06InVuaXF1ZUluZGV4OnJlcG9zaXRvcnkiEhkKBmlzc3VlcxgIIAMoCzIJLnFmLklzc3VlIksKBFR5cGUSCAoETk9ORRAAEggKBElORk8QARIPCgtBU1NJR05NRU5UUxACEgkKBVRFU1RTEAMSCAoEVVNFUhAEEgkKBUdST1VQEAUikAUKCkVucm9sbG1lbnQSCgoCSUQYASABKAQSNgoIY291cnNlSUQYAiABKARCJMq1AyCiAR1nb3JtOiJ1bmlxdWVJbmRleDplbnJvbGxtZW50IhI0CgZ1c2VySUQYAyABKARCJMq1AyCiAR1nb3JtOiJ1bmlxdWVJbmRleDplbnJvbGxtZW50IhIPCgdncm91cElEGAQgASgEEhYKBHVzZXIYBSABKAsyCC5xZi5Vc2VyEhoKBmNvdXJzZRgGIAEoCzIKLnFmLkNvdXJzZRIYCgVncm91cBgHIAEoCzIJLnFmLkdyb3VwEikKBnN0YXR1cxgIIAEoDjIZLnFmLkVucm9sbG1lbnQuVXNlclN0YXR1cxIqCgVzdGF0ZRgJIAEoDjIbLnFmLkVucm9sbG1lbnQuRGlzcGxheVN0YXRlEioKEXNsaXBEYXlzUmVtYWluaW5nGAogASgNQg/KtQMLogEIZ29ybToiLSISZgoQbGFzdEFjdGl2aXR5RGF0ZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCMMq1AyyiASlnb3JtOiJzZXJpYWxpemVyOnRpbWVzdGFtcDt0eXBlOmRhdGV0aW1lIhIVCg10b3RhbEFwcHJvdmVkGAwgASgEEiYKDHVzZWRTbGlwRGF5cxgNIAMoCzIQLnFmLlVzZWRTbGlwRGF5cyI9CgpVc2VyU3RhdHVzEggKBE5PTkUQABILCgdQRU5ESU5HEAESCwoHU1RVREVOVBACEgsKB1RFQUNIRVIQAyJACgxEaXNwbGF5U3RhdGUSCQoFVU5TRVQQABIKCgZISURERU4QARILCgdWSVNJQkxFEAISDAoIRkFWT1JJVEUQAyJpCgxVc2VkU2xpcERheXMSCgoCSUQYASABKAQSFAoMZW5yb2xsbWVudElEGAIgASgEEhQKDGFzc2lnbm1lbnRJRBgDIAEoBBIQCgh1c2VkRGF5cxgEIAEoDRIPCgdncm91cElEGAUgASgEIjIKC0Vucm9sbG1lbnRzEiMKC2Vucm9sbG1lbnRzGAEgAygLMg4ucWYuRW5yb2xsbWVudCLrBAoKQXNzaWdubWVudBIKCgJJRBgBIAEoBBIQCghDb3Vyc2VJRBgCIAEoBBIMCgRuYW1lGAMgASgJEl4KCGRlYWRsaW5lGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIwyrUDLKIBKWdvcm06InNlcmlhbGl6ZXI6dGltZXN0YW1wO3R5cGU6ZGF0ZXRpbWUiEhMKC2F1dG9BcHByb3ZlGAUgASgIEg0KBW9yZGVyGAYgASgNEhIKCmlzR3JvdXBMYWIYByABKAgSEgoKc2NvcmVMaW1pdBgIIAEoDRIRCglyZXZpZXdlcnMYCSABKA0SGAoQY29udGFpbmVyVGltZW91dBgKIAEoDRIjCgtzdWJtaXNzaW9ucxgLIAMoCzIOLnFmLlN1Ym1pc3Npb24SFwoFdGFza3MYDCADKAsyCC5xZi5UYXNrEi8KEWdyYWRpbmdCZW5jaG1hcmtzGA0gAygLMhQucWYuR3JhZGluZ0JlbmNobWFyaxIjCg1FeHBlY3RlZFRlc3RzGA4gAygLMgwucWYuVGVzdEluZm8SEwoLbWVtb3J5TGltaXQYDyABKA0SEAoIY3B1TGltaXQYECABKA0SEQoJcGlkc0xpbWl0GBEgASgNEhUKDWZpbGVTaXplTGltaXQYEiABKA0SEwoLdGVzdFJldHJpZXMYEyABKA0SGQoRY292ZXJhZ2VUaHJlc2hvbGQYFCABKA0SFgoOY292ZXJhZ2VXZWlnaHQYFSABKA0SEwoLbGludFBlbmFsdHkYFiABKA0SFgoObWF4TGludFBlbmFsdHkYFyABKA0ilwIKCFRlc3RJbmZvEgoKAklEGAEgASgEEjgKDEFzc2lnbm1lbnRJRBgCIAEoBEIiyrUDHqIBG2dvcm06InVuaXF1ZUluZGV4OnRlc3RpbmZvIhI0CghUZXN0TmFtZRgDIAEoCUIiyrUDHqIBG2dvcm06InVuaXF1ZUluZGV4OnRlc3RpbmZvIhIQCghNYXhTY29yZRgEIAEoBRIOCgZXZWlnaHQYBSABKAUSDwoHRGV0YWlscxgGIAEoCRIOCgZIaWRkZW4YByABKAgSDQoFR3JvdXAYCCABKAkSPQoMR3JvdXBXZWlnaHRzGAkgAygFQifKtQMjogEgZ29ybToic2VyaWFsaXplcjpqc29uO3R5cGU6dGV4dCIihwEKBFRhc2sSCgoCSUQYASABKAQSFAoMYXNzaWdubWVudElEGAIgASgEEhcKD2Fzc2lnbm1lbnRPcmRlchgDIAEoDRINCgV0aXRsZRgEIAEoCRIMCgRib2R5GAUgASgJEgwKBG5hbWUYBiABKAkSGQoGaXNzdWVzGAcgAygLMgkucWYuSXNzdWUiUQoFSXNzdWUSCgoCSUQYASABKAQSFAoMcmVwb3NpdG9yeUlEGAIgASgEEg4KBnRhc2tJRBgDIAEoBBIWCg5TY21Jc3N1ZU51bWJlchgEIAEoBCL9AQoLUHVsbFJlcXVlc3QSCgoCSUQYASABKAQSFwoPU2NtUmVwb3NpdG9yeUlEGAIgASgEEg4KBnRhc2tJRBgDIAEoBBIPCgdpc3N1ZUlEGAQgASgEEg4KBnVzZXJJRBgFIAEoBBIUCgxTY21Db21tZW50SUQYBiABKAQSFAoMc291cmNlQnJhbmNoGAcgASgJEg4KBm51bWJlchgIIAEoBBIkCgVzdGFnZRgJIAEoDjIVLnFmLlB1bGxSZXF1ZXN0LlN0YWdlIjYKBVN0YWdlEggKBE5PTkUQABIJCgVEUkFGVBABEgoKBlJFVklFVxACEgwKCEFQUFJPVkVEEAMiMgoLQXNzaWdubWVudHMSIwoLYXNzaWdubWVudHMYASADKAsyDi5xZi5Bc3NpZ25tZW50Io8DCgpTdWJtaXNzaW9uEgoKAklEGAEgASgEEhQKDEFzc2lnbm1lbnRJRBgCIAEoBBIOCgZ1c2VySUQYAyABKAQSDwoHZ3JvdXBJRBgEIAEoBBINCgVzY29yZRgFIAEoDRISCgpjb21taXRIYXNoGAYgASgJEhkKBkdyYWRlcxgHIAMoCzIJLnFmLkdyYWRlEmIKDGFwcHJvdmVkRGF0ZRgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCMMq1AyyiASlnb3JtOiJzZXJpYWxpemVyOnRpbWVzdGFtcDt0eXBlOmRhdGV0aW1lIhIbCgdyZXZpZXdzGAkgAygLMgoucWYuUmV2aWV3EiMKCUJ1aWxkSW5mbxgKIAEoCzIQLnNjb3JlLkJ1aWxkSW5mbxIcCgZTY29yZXMYCyADKAsyDC5zY29yZS5TY29yZSI8CgZTdGF0dXMSCAoETk9ORRAAEgwKCEFQUFJPVkVEEAESDAoIUkVKRUNURUQQAhIMCghSRVZJU0lPThADIjIKC1N1Ym1pc3Npb25zEiMKC3N1Ym1pc3Npb25zGAEgAygLMg4ucWYuU3VibWlzc2lvbiKWAQoFR3JhZGUSNQoMU3VibWlzc2lvbklEGAEgASgEQh/KtQMbogEYZ29ybToidW5pcXVlSW5kZXg6Z3JhZGUiEi8KBlVzZXJJRBgCIAEoBEIfyrUDG6IBGGdvcm06InVuaXF1ZUluZGV4OmdyYWRlIhIlCgZTdGF0dXMYAyABKA4yFS5xZi5TdWJtaXNzaW9uLlN0YXR1cyL4BgoDSm9iEgoKAklEGAEgASgEEhAKCENvdXJzZUlEGAIgASgEEhQKDEFzc2lnbm1lbnRJRBgDIAEoBBIUCgxSZXBvc2l0b3J5SUQYBCABKAQSFAoMU3VibWlzc2lvbklEGAUgASgEEhIKCkJyYW5jaE5hbWUYBiABKAkSEAoIQ29tbWl0SUQYByABKAkSEAoISm9iT3duZXIYCCABKAkSDwoHUmVidWlsZBgJIAEoCBIeCgZzdGF0dXMYCiABKA4yDi5xZi5Kb2IuU3RhdHVzEg0KBUVycm9yGAsgASgJEl8KCUNyZWF0ZWRBdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCMMq1AyyiASlnb3JtOiJzZXJpYWxpemVyOnRpbWVzdGFtcDt0eXBlOmRhdGV0aW1lIhJfCglVcGRhdGVkQXQYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQjDKtQMsogEpZ29ybToic2VyaWFsaXplcjp0aW1lc3RhbXA7dHlwZTpkYXRldGltZSISEQoJUmVidWlsZElEGA4gASgEEg0KBUZvcmNlGA8gASgIEhUKDVByZXZpb3VzU2NvcmUYECABKA0SDQoFU2NvcmUYESABKA0SDgoGRHJ5UnVuGBIgASgIEhMKC1Rlc3RzQnJhbmNoGBMgASgJEjsKCk5vd1Bhc3NpbmcYFCADKAlCJ8q1AyOiASBnb3JtOiJzZXJpYWxpemVyOmpzb247dHlwZTp0ZXh0IhI7CgpOb3dGYWlsaW5nGBUgAygJQifKtQMjogEgZ29ybToic2VyaWFsaXplcjpqc29uO3R5cGU6dGV4dCISEAoIU29sdXRpb24YFiABKAgSEwoLVGVzdHNDb21taXQYFyABKAkSPQoMRmFpbGluZ1Rlc3RzGBggAygJQifKtQMjogEgZ29ybToic2VyaWFsaXplcjpqc29uO3R5cGU6dGV4dCISPQoMTWlzc2luZ1Rlc3RzGBkgAygJQifKtQMjogEgZ29ybToic2VyaWFsaXplcjpqc29uO3R5cGU6dGV4dCIiSwoGU3RhdHVzEgoKBlFVRVVFRBAAEgsKB1JVTk5JTkcQARINCglTVUNDRUVERUQQAhIKCgZGQUlMRUQQAxINCglDQU5DRUxMRUQQBCLWAQoHUmVidWlsZBIKCgJJRBgBIAEoBBIQCghDb3Vyc2VJRBgCIAEoBBIUCgxBc3NpZ25tZW50SUQYAyABKAQSXwoJQ3JlYXRlZEF0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIwyrUDLKIBKWdvcm06InNlcmlhbGl6ZXI6dGltZXN0YW1wO3R5cGU6ZGF0ZXRpbWUiEhEKCUF1dG9tYXRpYxgFIAEoCBIOCgZEcnlSdW4YBiABKAgSEwoLVGVzdHNCcmFuY2gYByABKAkiqAEKDlJlYnVpbGRTdW1tYXJ5EhwKB3JlYnVpbGQYASABKAsyCy5xZi5SZWJ1aWxkEiUKCHByb2dyZXNzGAIgASgLMhMucWYuUmVidWlsZFByb2dyZXNzEhEKCWluY3JlYXNlZBgDIAEoDRIRCglkZWNyZWFzZWQYBCABKA0SEQoJdW5jaGFuZ2VkGAUgASgNEhgKB2NoYW5nZWQYBiADKAsyBy5xZi5Kb2IiOQoQUmVidWlsZFN1bW1hcmllcxIlCglzdW1tYXJpZXMYASADKAsyEi5xZi5SZWJ1aWxkU3VtbWFyeSJBCglGbGFreVRlc3QSEAoIdGVzdE5hbWUYASABKAkSDQoFZmxha3kYAiABKA0SEwoLc3VibWlzc2lvbnMYAyABKA0iKgoKRmxha3lUZXN0cxIcCgV0ZXN0cxgBIAMoCzINLnFmLkZsYWt5VGVzdCJeChBMZWFkZXJib2FyZEVudHJ5EgwKBHJhbmsYASABKA0SDAoEbmFtZRgCIAEoCRIOCgZ1c2VySUQYAyABKAQSDwoHZ3JvdXBJRBgEIAEoBBINCgV2YWx1ZRgFIAEoASI0CgtMZWFkZXJib2FyZBIlCgdlbnRyaWVzGAEgAygLMhQucWYuTGVhZGVyYm9hcmRFbnRyeSIkCgtUZXN0c0hlYWx0aBIVCgRqb2JzGAEgAygLMgcucWYuSm9iIn8KD1JlYnVpbGRQcm9ncmVzcxIRCglyZWJ1aWxkSUQYASABKAQSDQoFdG90YWwYAiABKA0SEQoJc3VjY2VlZGVkGAMgASgNEg4KBmZhaWxlZBgEIAEoDRIRCgljYW5jZWxsZWQYBSABKA0SFAoDam9iGAYgASgLMgcucWYuSm9iIikKCEJ1aWxkTG9nEg0KBWpvYklEGAEgASgEEg4KBm91dHB1dBgCIAEoCSJMChBTdGFsZVN1Ym1pc3Npb25zEhMKC3Rlc3RzQ29tbWl0GAEgASgJEiMKC3N1Ym1pc3Npb25zGAIgAygLMg4ucWYuU3VibWlzc2lvbiJKCg9CdWlsZExvZ0FyY2hpdmUSFAoMc3VibWlzc2lvbklEGAEgASgEEhAKCGNvbW1pdElEGAIgASgJEg8KB2NvbnRlbnQYAyABKAwiyAEKEEdyYWRpbmdCZW5jaG1hcmsSCgoCSUQYASABKAQSEAoIQ291cnNlSUQYAiABKAQSFAoMQXNzaWdubWVudElEGAMgASgEEhAKCFJldmlld0lEGAQgASgEEg8KB2hlYWRpbmcYBSABKAkSDwoHY29tbWVudBgGIAEoCRJMCghjcml0ZXJpYRgHIAMoCzIULnFmLkdyYWRpbmdDcml0ZXJpb25CJMq1AyCiAR1nb3JtOiJmb3JlaWduS2V5OkJlbmNobWFya0lEIiI2CgpCZW5jaG1hcmtzEigKCmJlbmNobWFya3MYASADKAsyFC5xZi5HcmFkaW5nQmVuY2htYXJrItEBChBHcmFkaW5nQ3JpdGVyaW9uEgoKAklEGAEgASgEEhMKC0JlbmNobWFya0lEGAIgASgEEhAKCENvdXJzZUlEGAMgASgEEg4KBnBvaW50cxgEIAEoBBITCgtkZXNjcmlwdGlvbhgFIAEoCRIpCgVncmFkZRgGIAEoDjIaLnFmLkdyYWRpbmdDcml0ZXJpb24uR3JhZGUSDwoHY29tbWVudBgHIAEoCSIpCgVHcmFkZRIICgROT05FEAASCgoGRkFJTEVEEAESCgoGUEFTU0VEEAIikQIKBlJldmlldxIKCgJJRBgBIAEoBBIUCgxTdWJtaXNzaW9uSUQYAiABKAQSEgoKUmV2aWV3ZXJJRBgDIAEoBBIQCghmZWVkYmFjaxgEIAEoCRINCgVzY29yZRgFIAEoDRJSChFncmFkaW5nQmVuY2htYXJrcxgGIAMoCzIULnFmLkdyYWRpbmdCZW5jaG1hcmtCIcq1Ax2iARpnb3JtOiJmb3JlaWduS2V5OlJldmlld0lEIhJcCgZlZGl0ZWQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQjDKtQMsogEpZ29ybToic2VyaWFsaXplcjp0aW1lc3RhbXA7dHlwZTpkYXRldGltZSIi8gEKEkFzc2lnbm1lbnRGZWVkYmFjaxIKCgJJRBgBIAEoBBIQCghDb3Vyc2VJRBgCIAEoBBIUCgxBc3NpZ25tZW50SUQYAyABKAQSFAoMTGlrZWRDb250ZW50GAQgASgJEh4KFkltcHJvdmVtZW50U3VnZ2VzdGlvbnMYBSABKAkSEQoJVGltZVNwZW50GAYgASgNEl8KCUNyZWF0ZWRBdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCMMq1AyyiASlnb3JtOiJzZXJpYWxpemVyOnRpbWVzdGFtcDt0eXBlOmRhdGV0aW1lIiKTAQoPRmVlZGJhY2tSZWNlaXB0EkIKDEFzc2lnbm1lbnRJRBgBIAEoBEIsyrUDKKIBJWdvcm06InByaW1hcnlLZXk7YXV0b0luY3JlbWVudDpmYWxzZSISPAoGVXNlcklEGAIgASgEQizKtQMoogElZ29ybToicHJpbWFyeUtleTthdXRvSW5jcmVtZW50OmZhbHNlIiJAChNBc3NpZ25tZW50RmVlZGJhY2tzEikKCWZlZWRiYWNrcxgBIAMoCzIWLnFmLkFzc2lnbm1lbnRGZWVkYmFja0ImWiFnaXRodWIuY29tL3F1aWNrZmVlZC9xdWlja2ZlZWQvcWa6AgBiBnByb3RvMw", [file_google_protobuf_timestamp, file_kit_score_score]);
 
 /**
  * @generated from message qf.User
@@ -278,6 +278,34 @@ export type Course = Message<"qf.Course"> & {
    * @generated from field: repeated qf.Group groups = 15;
    */
   groups: Group[];
+
+  /**
+   * rebuild submissions when an assignment's tests change
+   *
+   * @generated from field: bool autoRebuild = 16;
+   */
+  autoRebuild: boolean;
+
+  /**
+   * repository with the reference solution; the assignments repository if empty
+   *
+   * @generated from field: string solutionRepository = 17;
+   */
+  solutionRepository: string;
+
+  /**
+   * branch with the reference solution; empty for the default branch
+   *
+   * @generated from field: string solutionBranch = 18;
+   */
+  solutionBranch: string;
+
+  /**
+   * students may view leaderboards ranking submissions by benchmark results
+   *
+   * @generated from field: bool leaderboard = 19;
+   */
+  leaderboard: boolean;
 };
 
 /**
@@ -683,6 +711,69 @@ export type Assignment = Message<"qf.Assignment"> & {
    * @generated from field: repeated qf.TestInfo ExpectedTests = 14;
    */
   ExpectedTests: TestInfo[];
+
+  /**
+   * container memory limit in megabytes
+   *
+   * @generated from field: uint32 memoryLimit = 15;
+   */
+  memoryLimit: number;
+
+  /**
+   * container CPU quota in thousandths of a CPU
+   *
+   * @generated from field: uint32 cpuLimit = 16;
+   */
+  cpuLimit: number;
+
+  /**
+   * container limit on the number of processes
+   *
+   * @generated from field: uint32 pidsLimit = 17;
+   */
+  pidsLimit: number;
+
+  /**
+   * container limit on the size of each file written, in megabytes
+   *
+   * @generated from field: uint32 fileSizeLimit = 18;
+   */
+  fileSizeLimit: number;
+
+  /**
+   * number of times failed tests are rerun in the same test run
+   *
+   * @generated from field: uint32 testRetries = 19;
+   */
+  testRetries: number;
+
+  /**
+   * code coverage percentage that obtains the full coverage score; 0 if coverage is not scored
+   *
+   * @generated from field: uint32 coverageThreshold = 20;
+   */
+  coverageThreshold: number;
+
+  /**
+   * the weight of the coverage score; used to compute final grade
+   *
+   * @generated from field: uint32 coverageWeight = 21;
+   */
+  coverageWeight: number;
+
+  /**
+   * percentage points deducted from the score per lint finding
+   *
+   * @generated from field: uint32 lintPenalty = 22;
+   */
+  lintPenalty: number;
+
+  /**
+   * maximum percentage points deducted for lint findings; 0 if not capped
+   *
+   * @generated from field: uint32 maxLintPenalty = 23;
+   */
+  maxLintPenalty: number;
 };
 
 /**
@@ -735,6 +826,27 @@ export type TestInfo = Message<"qf.TestInfo"> & {
    * @generated from field: string Details = 6;
    */
   Details: string;
+
+  /**
+   * the test is hidden from students until the assignment's deadline
+   *
+   * @generated from field: bool Hidden = 7;
+   */
+  Hidden: boolean;
+
+  /**
+   * slash-separated path of the nested score groups the test belongs to, if any
+   *
+   * @generated from field: string Group = 8;
+   */
+  Group: string;
+
+  /**
+   * weights of the groups in Group, outermost first
+   *
+   * @generated from field: repeated int32 GroupWeights = 9;
+   */
+  GroupWeights: number[];
 };
 
 /**
@@ -1102,6 +1214,624 @@ export type Grade = Message<"qf.Grade"> & {
 export const GradeSchema: GenMessage<Grade> = /*@__PURE__*/
   messageDesc(file_qf_types, 18);
 
+/**
+ * Job is a persistent record of a test run for a push event or a rebuild request.
+ *
+ * @generated from message qf.Job
+ */
+export type Job = Message<"qf.Job"> & {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID: bigint;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
+   */
+  CourseID: bigint;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 AssignmentID = 3;
+   */
+  AssignmentID: bigint;
+
+  /**
+   * foreign key; the student or group repository to test
+   *
+   * @generated from field: uint64 RepositoryID = 4;
+   */
+  RepositoryID: bigint;
+
+  /**
+   * the submission to rebuild; only used for rebuild jobs
+   *
+   * @generated from field: uint64 SubmissionID = 5;
+   */
+  SubmissionID: bigint;
+
+  /**
+   * empty for the repository's default branch
+   *
+   * @generated from field: string BranchName = 6;
+   */
+  BranchName: string;
+
+  /**
+   * @generated from field: string CommitID = 7;
+   */
+  CommitID: string;
+
+  /**
+   * login of the user or name of the group that triggered the job
+   *
+   * @generated from field: string JobOwner = 8;
+   */
+  JobOwner: string;
+
+  /**
+   * @generated from field: bool Rebuild = 9;
+   */
+  Rebuild: boolean;
+
+  /**
+   * @generated from field: qf.Job.Status status = 10;
+   */
+  status: Job_Status;
+
+  /**
+   * reason for failure; only set for failed jobs
+   *
+   * @generated from field: string Error = 11;
+   */
+  Error: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp CreatedAt = 12;
+   */
+  CreatedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp UpdatedAt = 13;
+   */
+  UpdatedAt?: Timestamp;
+
+  /**
+   * foreign key; only used for jobs created by a rebuild of all submissions
+   *
+   * @generated from field: uint64 RebuildID = 14;
+   */
+  RebuildID: bigint;
+
+  /**
+   * run the tests even if results for the same commit and tests are cached
+   *
+   * @generated from field: bool Force = 15;
+   */
+  Force: boolean;
+
+  /**
+   * the submission's score before the rebuild; only used for rebuild jobs
+   *
+   * @generated from field: uint32 PreviousScore = 16;
+   */
+  PreviousScore: number;
+
+  /**
+   * the score recorded by the job; only set for succeeded jobs
+   *
+   * @generated from field: uint32 Score = 17;
+   */
+  Score: number;
+
+  /**
+   * run the tests without recording the results
+   *
+   * @generated from field: bool DryRun = 18;
+   */
+  DryRun: boolean;
+
+  /**
+   * branch of the tests repository to run; empty for the current tests
+   *
+   * @generated from field: string TestsBranch = 19;
+   */
+  TestsBranch: string;
+
+  /**
+   * dry run: tests that failed in the recorded results
+   *
+   * @generated from field: repeated string NowPassing = 20;
+   */
+  NowPassing: string[];
+
+  /**
+   * dry run: tests that passed in the recorded results
+   *
+   * @generated from field: repeated string NowFailing = 21;
+   */
+  NowFailing: string[];
+
+  /**
+   * run the tests against the course's reference solution
+   *
+   * @generated from field: bool Solution = 22;
+   */
+  Solution: boolean;
+
+  /**
+   * solution run: commit of the tests repository that was run
+   *
+   * @generated from field: string TestsCommit = 23;
+   */
+  TestsCommit: string;
+
+  /**
+   * solution run: tests without max score
+   *
+   * @generated from field: repeated string FailingTests = 24;
+   */
+  FailingTests: string[];
+
+  /**
+   * solution run: expected tests that reported no score
+   *
+   * @generated from field: repeated string MissingTests = 25;
+   */
+  MissingTests: string[];
+};
+
+/**
+ * Describes the message qf.Job.
+ * Use `create(JobSchema)` to create a new message.
+ */
+export const JobSchema: GenMessage<Job> = /*@__PURE__*/
+  messageDesc(file_qf_types, 19);
+
+/**
+ * @generated from enum qf.Job.Status
+ */
+export enum Job_Status {
+  /**
+   * @generated from enum value: QUEUED = 0;
+   */
+  QUEUED = 0,
+
+  /**
+   * @generated from enum value: RUNNING = 1;
+   */
+  RUNNING = 1,
+
+  /**
+   * @generated from enum value: SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * @generated from enum value: FAILED = 3;
+   */
+  FAILED = 3,
+
+  /**
+   * @generated from enum value: CANCELLED = 4;
+   */
+  CANCELLED = 4,
+}
+
+/**
+ * Describes the enum qf.Job.Status.
+ */
+export const Job_StatusSchema: GenEnum<Job_Status> = /*@__PURE__*/
+  enumDesc(file_qf_types, 19, 0);
+
+/**
+ * Rebuild is a persistent record of a request to rebuild all submissions for an assignment.
+ *
+ * @generated from message qf.Rebuild
+ */
+export type Rebuild = Message<"qf.Rebuild"> & {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID: bigint;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
+   */
+  CourseID: bigint;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 AssignmentID = 3;
+   */
+  AssignmentID: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp CreatedAt = 4;
+   */
+  CreatedAt?: Timestamp;
+
+  /**
+   * created by a push to the tests repository
+   *
+   * @generated from field: bool Automatic = 5;
+   */
+  Automatic: boolean;
+
+  /**
+   * the results are reported, but not recorded
+   *
+   * @generated from field: bool DryRun = 6;
+   */
+  DryRun: boolean;
+
+  /**
+   * branch of the tests repository run by a dry run; empty for the current tests
+   *
+   * @generated from field: string TestsBranch = 7;
+   */
+  TestsBranch: string;
+};
+
+/**
+ * Describes the message qf.Rebuild.
+ * Use `create(RebuildSchema)` to create a new message.
+ */
+export const RebuildSchema: GenMessage<Rebuild> = /*@__PURE__*/
+  messageDesc(file_qf_types, 20);
+
+/**
+ * RebuildSummary summarizes how a rebuild changed the scores of the rebuilt submissions.
+ *
+ * @generated from message qf.RebuildSummary
+ */
+export type RebuildSummary = Message<"qf.RebuildSummary"> & {
+  /**
+   * @generated from field: qf.Rebuild rebuild = 1;
+   */
+  rebuild?: Rebuild;
+
+  /**
+   * @generated from field: qf.RebuildProgress progress = 2;
+   */
+  progress?: RebuildProgress;
+
+  /**
+   * number of succeeded jobs that increased the score
+   *
+   * @generated from field: uint32 increased = 3;
+   */
+  increased: number;
+
+  /**
+   * number of succeeded jobs that decreased the score
+   *
+   * @generated from field: uint32 decreased = 4;
+   */
+  decreased: number;
+
+  /**
+   * number of succeeded jobs that kept the score
+   *
+   * @generated from field: uint32 unchanged = 5;
+   */
+  unchanged: number;
+
+  /**
+   * succeeded jobs that changed the score or the status of a test
+   *
+   * @generated from field: repeated qf.Job changed = 6;
+   */
+  changed: Job[];
+};
+
+/**
+ * Describes the message qf.RebuildSummary.
+ * Use `create(RebuildSummarySchema)` to create a new message.
+ */
+export const RebuildSummarySchema: GenMessage<RebuildSummary> = /*@__PURE__*/
+  messageDesc(file_qf_types, 21);
+
+/**
+ * @generated from message qf.RebuildSummaries
+ */
+export type RebuildSummaries = Message<"qf.RebuildSummaries"> & {
+  /**
+   * most recent rebuild first
+   *
+   * @generated from field: repeated qf.RebuildSummary summaries = 1;
+   */
+  summaries: RebuildSummary[];
+};
+
+/**
+ * Describes the message qf.RebuildSummaries.
+ * Use `create(RebuildSummariesSchema)` to create a new message.
+ */
+export const RebuildSummariesSchema: GenMessage<RebuildSummaries> = /*@__PURE__*/
+  messageDesc(file_qf_types, 22);
+
+/**
+ * FlakyTest reports how often a test passed only when rerun in the recorded submissions for an assignment.
+ *
+ * @generated from message qf.FlakyTest
+ */
+export type FlakyTest = Message<"qf.FlakyTest"> & {
+  /**
+   * @generated from field: string testName = 1;
+   */
+  testName: string;
+
+  /**
+   * number of submissions in which the test failed, but passed when rerun
+   *
+   * @generated from field: uint32 flaky = 2;
+   */
+  flaky: number;
+
+  /**
+   * number of submissions with a score for the test
+   *
+   * @generated from field: uint32 submissions = 3;
+   */
+  submissions: number;
+};
+
+/**
+ * Describes the message qf.FlakyTest.
+ * Use `create(FlakyTestSchema)` to create a new message.
+ */
+export const FlakyTestSchema: GenMessage<FlakyTest> = /*@__PURE__*/
+  messageDesc(file_qf_types, 23);
+
+/**
+ * @generated from message qf.FlakyTests
+ */
+export type FlakyTests = Message<"qf.FlakyTests"> & {
+  /**
+   * most flaky test first
+   *
+   * @generated from field: repeated qf.FlakyTest tests = 1;
+   */
+  tests: FlakyTest[];
+};
+
+/**
+ * Describes the message qf.FlakyTests.
+ * Use `create(FlakyTestsSchema)` to create a new message.
+ */
+export const FlakyTestsSchema: GenMessage<FlakyTests> = /*@__PURE__*/
+  messageDesc(file_qf_types, 24);
+
+/**
+ * LeaderboardEntry ranks a submission by the benchmark metric of a leaderboard.
+ *
+ * @generated from message qf.LeaderboardEntry
+ */
+export type LeaderboardEntry = Message<"qf.LeaderboardEntry"> & {
+  /**
+   * entries with equal values have the same rank
+   *
+   * @generated from field: uint32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * the student's login or the group's name
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * zero for group submissions
+   *
+   * @generated from field: uint64 userID = 3;
+   */
+  userID: bigint;
+
+  /**
+   * zero for individual submissions
+   *
+   * @generated from field: uint64 groupID = 4;
+   */
+  groupID: bigint;
+
+  /**
+   * the submission's value of the ranked metric
+   *
+   * @generated from field: double value = 5;
+   */
+  value: number;
+};
+
+/**
+ * Describes the message qf.LeaderboardEntry.
+ * Use `create(LeaderboardEntrySchema)` to create a new message.
+ */
+export const LeaderboardEntrySchema: GenMessage<LeaderboardEntry> = /*@__PURE__*/
+  messageDesc(file_qf_types, 25);
+
+/**
+ * @generated from message qf.Leaderboard
+ */
+export type Leaderboard = Message<"qf.Leaderboard"> & {
+  /**
+   * best submission first
+   *
+   * @generated from field: repeated qf.LeaderboardEntry entries = 1;
+   */
+  entries: LeaderboardEntry[];
+};
+
+/**
+ * Describes the message qf.Leaderboard.
+ * Use `create(LeaderboardSchema)` to create a new message.
+ */
+export const LeaderboardSchema: GenMessage<Leaderboard> = /*@__PURE__*/
+  messageDesc(file_qf_types, 26);
+
+/**
+ * TestsHealth reports the most recent runs of the course's tests against the reference solution.
+ *
+ * @generated from message qf.TestsHealth
+ */
+export type TestsHealth = Message<"qf.TestsHealth"> & {
+  /**
+   * most recent solution job for each assignment
+   *
+   * @generated from field: repeated qf.Job jobs = 1;
+   */
+  jobs: Job[];
+};
+
+/**
+ * Describes the message qf.TestsHealth.
+ * Use `create(TestsHealthSchema)` to create a new message.
+ */
+export const TestsHealthSchema: GenMessage<TestsHealth> = /*@__PURE__*/
+  messageDesc(file_qf_types, 27);
+
+/**
+ * RebuildProgress reports the progress of a rebuild.
+ *
+ * @generated from message qf.RebuildProgress
+ */
+export type RebuildProgress = Message<"qf.RebuildProgress"> & {
+  /**
+   * @generated from field: uint64 rebuildID = 1;
+   */
+  rebuildID: bigint;
+
+  /**
+   * number of submissions to rebuild
+   *
+   * @generated from field: uint32 total = 2;
+   */
+  total: number;
+
+  /**
+   * @generated from field: uint32 succeeded = 3;
+   */
+  succeeded: number;
+
+  /**
+   * @generated from field: uint32 failed = 4;
+   */
+  failed: number;
+
+  /**
+   * @generated from field: uint32 cancelled = 5;
+   */
+  cancelled: number;
+
+  /**
+   * the most recently finished job; not set in the initial progress report
+   *
+   * @generated from field: qf.Job job = 6;
+   */
+  job?: Job;
+};
+
+/**
+ * Describes the message qf.RebuildProgress.
+ * Use `create(RebuildProgressSchema)` to create a new message.
+ */
+export const RebuildProgressSchema: GenMessage<RebuildProgress> = /*@__PURE__*/
+  messageDesc(file_qf_types, 28);
+
+/**
+ * BuildLog holds output from a running test job, without score lines.
+ *
+ * @generated from message qf.BuildLog
+ */
+export type BuildLog = Message<"qf.BuildLog"> & {
+  /**
+   * @generated from field: uint64 jobID = 1;
+   */
+  jobID: bigint;
+
+  /**
+   * one or more complete lines of output
+   *
+   * @generated from field: string output = 2;
+   */
+  output: string;
+};
+
+/**
+ * Describes the message qf.BuildLog.
+ * Use `create(BuildLogSchema)` to create a new message.
+ */
+export const BuildLogSchema: GenMessage<BuildLog> = /*@__PURE__*/
+  messageDesc(file_qf_types, 29);
+
+/**
+ * StaleSubmissions holds the submissions for an assignment that were graded
+ * with another revision of the tests repository than the current revision.
+ *
+ * @generated from message qf.StaleSubmissions
+ */
+export type StaleSubmissions = Message<"qf.StaleSubmissions"> & {
+  /**
+   * the current commit of the tests repository
+   *
+   * @generated from field: string testsCommit = 1;
+   */
+  testsCommit: string;
+
+  /**
+   * submissions with build info, without build logs
+   *
+   * @generated from field: repeated qf.Submission submissions = 2;
+   */
+  submissions: Submission[];
+};
+
+/**
+ * Describes the message qf.StaleSubmissions.
+ * Use `create(StaleSubmissionsSchema)` to create a new message.
+ */
+export const StaleSubmissionsSchema: GenMessage<StaleSubmissions> = /*@__PURE__*/
+  messageDesc(file_qf_types, 30);
+
+/**
+ * BuildLogArchive holds the complete output of a submission's test run.
+ *
+ * @generated from message qf.BuildLogArchive
+ */
+export type BuildLogArchive = Message<"qf.BuildLogArchive"> & {
+  /**
+   * @generated from field: uint64 submissionID = 1;
+   */
+  submissionID: bigint;
+
+  /**
+   * @generated from field: string commitID = 2;
+   */
+  commitID: string;
+
+  /**
+   * gzip-compressed output
+   *
+   * @generated from field: bytes content = 3;
+   */
+  content: Uint8Array;
+};
+
+/**
+ * Describes the message qf.BuildLogArchive.
+ * Use `create(BuildLogArchiveSchema)` to create a new message.
+ */
+export const BuildLogArchiveSchema: GenMessage<BuildLogArchive> = /*@__PURE__*/
+  messageDesc(file_qf_types, 31);
+
 /**
  * @generated from message qf.GradingBenchmark
  */
@@ -1153,7 +1883,7 @@ export type GradingBenchmark = Message<"qf.GradingBenchmark"> & {
  * Use `create(GradingBenchmarkSchema)` to create a new message.
  */
 export const GradingBenchmarkSchema: GenMessage<GradingBenchmark> = /*@__PURE__*/
-  messageDesc(file_qf_types, 19);
+  messageDesc(file_qf_types, 32);
 
 /**
  * @generated from message qf.Benchmarks
@@ -1170,7 +1900,7 @@ export type Benchmarks = Message<"qf.Benchmarks"> & {
  * Use `create(BenchmarksSchema)` to create a new message.
  */
 export const BenchmarksSchema: GenMessage<Benchmarks> = /*@__PURE__*/
-  messageDesc(file_qf_types, 20);
+  messageDesc(file_qf_types, 33);
 
 /**
  * @generated from message qf.GradingCriterion
@@ -1221,7 +1951,7 @@ export type GradingCriterion = Message<"qf.GradingCriterion"> & {
  * Use `create(GradingCriterionSchema)` to create a new message.
  */
 export const GradingCriterionSchema: GenMessage<GradingCriterion> = /*@__PURE__*/
-  messageDesc(file_qf_types, 21);
+  messageDesc(file_qf_types, 34);
 
 /**
  * @generated from enum qf.GradingCriterion.Grade
@@ -1247,7 +1977,7 @@ export enum GradingCriterion_Grade {
  * Describes the enum qf.GradingCriterion.Grade.
  */
 export const GradingCriterion_GradeSchema: GenEnum<GradingCriterion_Grade> = /*@__PURE__*/
-  enumDesc(file_qf_types, 21, 0);
+  enumDesc(file_qf_types, 34, 0);
 
 /**
  * @generated from message qf.Review
@@ -1298,7 +2028,7 @@ export type Review = Message<"qf.Review"> & {
  * Use `create(ReviewSchema)` to create a new message.
  */
 export const ReviewSchema: GenMessage<Review> = /*@__PURE__*/
-  messageDesc(file_qf_types, 22);
+  messageDesc(file_qf_types, 35);
 
 /**
  * @generated from message qf.AssignmentFeedback
@@ -1355,7 +2085,7 @@ export type AssignmentFeedback = Message<"qf.AssignmentFeedback"> & {
  * Use `create(AssignmentFeedbackSchema)` to create a new message.
  */
 export const AssignmentFeedbackSchema: GenMessage<AssignmentFeedback> = /*@__PURE__*/
-  messageDesc(file_qf_types, 23);
+  messageDesc(file_qf_types, 36);
 
 /**
  * @generated from message qf.FeedbackReceipt
@@ -1377,7 +2107,7 @@ export type FeedbackReceipt = Message<"qf.FeedbackReceipt"> & {
  * Use `create(FeedbackReceiptSchema)` to create a new message.
  */
 export const FeedbackReceiptSchema: GenMessage<FeedbackReceipt> = /*@__PURE__*/
-  messageDesc(file_qf_types, 24);
+  messageDesc(file_qf_types, 37);
 
 /**
  * @generated from message qf.AssignmentFeedbacks
@@ -1394,5 +2124,5 @@ export type AssignmentFeedbacks = Message<"qf.AssignmentFeedbacks"> & {
  * Use `create(AssignmentFeedbacksSchema)` to create a new message.
  */
 export const AssignmentFeedbacksSchema: GenMessage<AssignmentFeedbacks> = /*@__PURE__*/
-  messageDesc(file_qf_types, 25);
+  messageDesc(file_qf_types, 38);
 
@@ -2,6 +2,7 @@ import { create, isMessage } from "@bufbuild/protobuf"
 import type { Timestamp } from "@bufbuild/protobuf/wkt"
 import { timestampDate } from "@bufbuild/protobuf/wkt"
 import type { Score } from "../proto/kit/score/score_pb"
+import { Score_Status } from "../proto/kit/score/score_pb"
 import type { CourseSubmissions } from "../proto/qf/requests_pb"
 import type { Assignment, Course, Enrollment, GradingBenchmark, Group, Review, Submission, Submissions, User } from "../proto/qf/types_pb"
 import { Enrollment_DisplayState, Enrollment_UserStatus, GradeSchema, Group_GroupStatus, GroupSchema, Submission_Status, SubmissionSchema, SubmissionsSchema } from "../proto/qf/types_pb"
@@ -98,6 +99,10 @@ export const getPassedTestsCount = (score: Score[]): string => {
     let totalTests = 0
     let passedTests = 0
     score.forEach(s => {
+        if (s.status === Score_Status.UNEXPECTED) {
+            // tests that are not expected do not count towards the total
+            return
+        }
         if (s.Score === s.MaxScore) {
             passedTests++
         }
@@ -109,6 +114,91 @@ export const getPassedTestsCount = (score: Score[]): string => {
     return `${passedTests}/${totalTests}`
 }
 
+/** getMissingTests returns the names of the expected tests that did not report a score, e.g., due to a panic or timeout. */
+export const getMissingTests = (score: Score[]): string[] => {
+    return score.filter(s => s.status === Score_Status.MISSING).map(s => s.TestName)
+}
+
+/** scorePoints returns the points obtained by the test, including partial points. */
+export const scorePoints = (score: Score): number => {
+    return score.Points !== 0 ? score.Points : score.Score
+}
+
+/** ScoreNode is a node in the breakdown tree of a submission's scores.
+ *  Leaves hold a test score, and inner nodes hold a score group with its tests and nested groups. */
+export type ScoreNode = {
+    name: string
+    weight: number
+    score?: Score
+    children: ScoreNode[]
+}
+
+/** newScoreTree returns the breakdown tree of the given scores, placing each score in the nested score groups given by its group path. */
+export const newScoreTree = (scores: Score[]): ScoreNode => {
+    const root: ScoreNode = { name: "", weight: 1, children: [] }
+    scores.forEach(score => {
+        let node = root
+        const path = score.Group === "" ? [] : score.Group.split("/")
+        path.forEach((name, depth) => {
+            let group = node.children.find(child => child.score === undefined && child.name === name)
+            if (group === undefined) {
+                group = { name, weight: score.GroupWeights[depth] > 0 ? score.GroupWeights[depth] : 1, children: [] }
+                node.children.push(group)
+            }
+            node = group
+        })
+        node.children.push({ name: score.TestName, weight: score.Weight, score, children: [] })
+    })
+    return root
+}
+
+/** isCounted returns true if the node counts towards the total score; tests that are not expected, and groups with only such tests, are not counted. */
+export const isCounted = (node: ScoreNode): boolean => {
+    return node.score ? node.score.status !== Score_Status.UNEXPECTED : node.children.some(isCounted)
+}
+
+/** countedWeight returns the total weight of the given nodes that count towards the total score. */
+export const countedWeight = (nodes: ScoreNode[]): number => {
+    return nodes.filter(isCounted).reduce((acc, node) => acc + node.weight, 0)
+}
+
+/** scoreFraction returns the score of the node as a fraction in the range 0-1.
+ *  The score of a group is the weighted average of the scores of its tests and nested groups. */
+export const scoreFraction = (node: ScoreNode): number => {
+    if (node.score) {
+        return node.score.MaxScore > 0 ? Math.min(Math.max(scorePoints(node.score), 0), node.score.MaxScore) / node.score.MaxScore : 0
+    }
+    const totalWeight = countedWeight(node.children)
+    if (totalWeight === 0) {
+        return 0
+    }
+    return node.children.filter(isCounted).reduce((acc, child) => acc + scoreFraction(child) * child.weight, 0) / totalWeight
+}
+
+/** formatExecTime returns a human readable execution time for the given number of microseconds, e.g., "1.2 ms" */
+export const formatExecTime = (microseconds: bigint): string => {
+    const us = Number(microseconds)
+    if (us < 1000) {
+        return `${us} µs`
+    }
+    if (us < 1000000) {
+        return `${(us / 1000).toFixed(1)} ms`
+    }
+    return `${(us / 1000000).toFixed(2)} s`
+}
+
+/** formatMemory returns a human readable size for the given number of bytes, e.g., "3.4 MB" */
+export const formatMemory = (bytes: bigint): string => {
+    const units = ["B", "kB", "MB", "GB"]
+    let size = Number(bytes)
+    let unit = 0
+    while (size >= 1000 && unit < units.length - 1) {
+        size /= 1000
+        unit++
+    }
+    return unit === 0 ? `${size} ${units[unit]}` : `${size.toFixed(1)} ${units[unit]}`
+}
+
 /** hasEnrollment returns true if any of the provided has been approved */
 export const hasEnrollment = (enrollments: Enrollment[]): boolean => {
     return enrollments.some(enrollment => enrollment.status > Enrollment_UserStatus.PENDING)
@@ -26,7 +26,7 @@ export const initializeOvermind = (state: Partial<State & SubType<{ review: Part
 
 /** UnaryApiClient is a type that represents the ApiClient without streaming methods. */
 interface UnaryApiClient {
-    client: Omit<ApiClient["client"], "submissionStream">
+    client: Omit<ApiClient["client"], "submissionStream" | "rebuildStream" | "buildLogStream">
 }
 
 /** Methods is a type that represents the methods of the UnaryApiClient */
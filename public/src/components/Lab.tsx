@@ -1,12 +1,14 @@
-import React, { useEffect } from 'react'
+import React, { useEffect, useState } from 'react'
 import { useLocation, useParams } from 'react-router'
 import type { Assignment, Submission } from '../../proto/qf/types_pb'
 import { hasReviews, isManuallyGraded } from '../Helpers'
 import { useActions, useAppState } from '../overmind'
 import { CenteredMessage, KnownMessage } from './CenteredMessage'
+import Leaderboard from './Leaderboard'
 import LabResultTable from "./LabResultTable"
 import ReviewResult from './ReviewResult'
 import AssignmentFeedbackForm from './feedback/form/AssignmentFeedbackForm'
+import LintFindings from './submissions/LintFindings'
 
 
 /** Lab displays a submission based on the /course/:id/lab/:lab route if the user is a student.
@@ -21,12 +23,41 @@ const Lab = () => {
     const location = useLocation()
     const isGroupLab = location.pathname.includes("group-lab")
 
+    const [liveLog, setLiveLog] = useState("")
+    const groupID = isGroupLab ? state.enrollmentsByCourseID[courseID]?.groupID ?? 0n : 0n
+
     useEffect(() => {
         if (!state.isTeacher) {
             actions.setSelectedAssignmentID(Number(lab))
         }
     }, [actions, lab, state.isTeacher])
 
+    useEffect(() => {
+        if (state.isTeacher || !lab || !courseID) {
+            return
+        }
+        const controller = new AbortController()
+        const follow = async () => {
+            // Follow test runs until the lab is closed; the server closes the stream when a test run is finished.
+            while (!controller.signal.aborted) {
+                setLiveLog("")
+                const finished = await actions.followBuildLog({
+                    courseID: BigInt(courseID),
+                    assignmentID: BigInt(lab),
+                    userID: state.self.ID,
+                    groupID,
+                    signal: controller.signal,
+                    onLog: (log) => setLiveLog(prev => prev + log.output),
+                })
+                if (!finished) {
+                    return
+                }
+            }
+        }
+        follow()
+        return () => controller.abort()
+    }, [actions, courseID, lab, groupID, state.isTeacher, state.self.ID])
+
     const InternalLab = () => {
         let submission: Submission | null
         let assignment: Assignment | null
@@ -71,6 +102,10 @@ const Lab = () => {
                         <AssignmentFeedbackForm assignment={assignment} courseID={courseID} />
                     )}
                     <LabResultTable submission={submission} assignment={assignment} />
+                    {(state.isTeacher || state.courses.find(c => c.ID === assignment.CourseID)?.leaderboard) && (
+                        <Leaderboard courseID={assignment.CourseID} submission={submission} />
+                    )}
+                    <LintFindings buildInfo={submission.BuildInfo} />
 
                     {isManuallyGraded(assignment.reviewers) && review.length > 0 ? <ReviewResult review={review[0]} /> : null}
 
@@ -81,6 +116,11 @@ const Lab = () => {
                                     <i className="fas fa-terminal" />
                                     <span>Build Log</span>
                                 </h3>
+                                {state.isTeacher && (
+                                    <button className="btn btn-ghost btn-xs" title="Download the complete build log" onClick={() => actions.downloadBuildLog({ courseID: state.activeCourse, submission })}>
+                                        <i className="fas fa-download" /> Full log
+                                    </button>
+                                )}
                             </div>
                             <div className="overflow-x-auto">
                                 <pre className="p-4 text-sm leading-relaxed font-mono bg-base-200 m-0">
@@ -98,6 +138,23 @@ const Lab = () => {
     return (
         <div className={state.isTeacher ? "" : "row"}>
             <div className={state.isTeacher ? "" : "col-md-9"}>
+                {liveLog && (
+                    <div className="card bg-base-200 shadow-xl rounded-2xl overflow-hidden mb-4">
+                        <div className="card-body p-0">
+                            <div className="flex items-center justify-between bg-base-300 px-4 py-3 border-b border-base-content/10">
+                                <h3 className="text-sm font-semibold flex items-center gap-2">
+                                    <i className="fas fa-spinner fa-spin" />
+                                    <span>Running Tests</span>
+                                </h3>
+                            </div>
+                            <div className="overflow-x-auto">
+                                <pre className="p-4 text-sm leading-relaxed font-mono bg-base-200 m-0">
+                                    <code style={{ wordBreak: 'break-word', whiteSpace: 'pre-wrap' }}>{liveLog}</code>
+                                </pre>
+                            </div>
+                        </div>
+                    </div>
+                )}
                 <InternalLab />
             </div>
         </div>
@@ -0,0 +1,98 @@
+import { useEffect, useState } from "react"
+import { LeaderboardRequest_Metric } from "../../proto/qf/requests_pb"
+import type { LeaderboardEntry, Submission } from "../../proto/qf/types_pb"
+import { formatExecTime, formatMemory } from "../Helpers"
+import { useActions, useAppState } from "../overmind"
+
+const metrics = [
+    { metric: LeaderboardRequest_Metric.NS_PER_OP, label: "Time per operation" },
+    { metric: LeaderboardRequest_Metric.ALLOCS_PER_OP, label: "Allocations per operation" },
+    { metric: LeaderboardRequest_Metric.BYTES_PER_OP, label: "Memory per operation" },
+    { metric: LeaderboardRequest_Metric.EXEC_TIME, label: "Test execution time" },
+]
+
+/** formatMetric returns a human readable value of the given leaderboard metric. */
+const formatMetric = (metric: LeaderboardRequest_Metric, value: number): string => {
+    switch (metric) {
+        case LeaderboardRequest_Metric.NS_PER_OP:
+            return value < 1000 ? `${Math.round(value)} ns/op` : `${formatExecTime(BigInt(Math.round(value / 1000)))}/op`
+        case LeaderboardRequest_Metric.ALLOCS_PER_OP:
+            return `${value} allocs/op`
+        case LeaderboardRequest_Metric.BYTES_PER_OP:
+            return `${formatMemory(BigInt(Math.round(value)))}/op`
+        case LeaderboardRequest_Metric.EXEC_TIME:
+            return formatExecTime(BigInt(value))
+    }
+    return value.toString()
+}
+
+/** Leaderboard ranks the latest submissions for the submission's assignment by a benchmark metric of one of its tests.
+ *  It is only shown if the submission has tests that report benchmark results or execution times. */
+const Leaderboard = ({ courseID, submission }: { courseID: bigint, submission: Submission }) => {
+    const state = useAppState()
+    const actions = useActions().global
+    const tests = submission.Scores.filter(score => score.NsPerOp > 0 || score.ExecTime > 0n).map(score => score.TestName)
+    const benchmarks = submission.Scores.filter(score => score.NsPerOp > 0).map(score => score.TestName)
+    const [testName, setTestName] = useState<string>(benchmarks[0] ?? tests[0] ?? "")
+    const [metric, setMetric] = useState<LeaderboardRequest_Metric>(benchmarks.length > 0 ? LeaderboardRequest_Metric.NS_PER_OP : LeaderboardRequest_Metric.EXEC_TIME)
+    const [entries, setEntries] = useState<LeaderboardEntry[]>([])
+
+    useEffect(() => {
+        if (testName === "") {
+            return
+        }
+        actions.getLeaderboard({ courseID, assignmentID: submission.AssignmentID, testName, metric }).then(setEntries)
+    }, [actions, courseID, submission.AssignmentID, testName, metric])
+
+    if (tests.length === 0) {
+        return null
+    }
+
+    const isOwn = (entry: LeaderboardEntry) =>
+        entry.groupID > 0n ? entry.groupID === submission.groupID : entry.userID === state.self.ID
+
+    return (
+        <div className="card bg-base-200 shadow-xl rounded-2xl overflow-hidden mb-4">
+            <div className="card-body p-4">
+                <div className="flex flex-wrap items-center justify-between gap-2">
+                    <h3 className="text-sm font-semibold flex items-center gap-2">
+                        <i className="fas fa-trophy" />
+                        <span>Leaderboard</span>
+                    </h3>
+                    <div className="flex gap-2">
+                        <select className="select select-bordered select-sm" value={testName} onChange={e => setTestName(e.target.value)}>
+                            {tests.map(test => <option key={test} value={test}>{test}</option>)}
+                        </select>
+                        <select className="select select-bordered select-sm" value={metric} onChange={e => setMetric(Number(e.target.value))}>
+                            {metrics.map(m => <option key={m.metric} value={m.metric}>{m.label}</option>)}
+                        </select>
+                    </div>
+                </div>
+                {entries.length === 0 ? (
+                    <div className="text-sm text-base-content/70">No submissions have reported this metric yet.</div>
+                ) : (
+                    <table className="table table-sm">
+                        <thead>
+                            <tr>
+                                <th>Rank</th>
+                                <th>Student or group</th>
+                                <th className="text-right">Result</th>
+                            </tr>
+                        </thead>
+                        <tbody>
+                            {entries.map(entry => (
+                                <tr key={`${entry.userID}-${entry.groupID}`} className={isOwn(entry) ? "font-semibold bg-base-300" : ""}>
+                                    <td>{entry.rank}</td>
+                                    <td>{entry.name}</td>
+                                    <td className="text-right">{formatMetric(metric, entry.value)}</td>
+                                </tr>
+                            ))}
+                        </tbody>
+                    </table>
+                )}
+            </div>
+        </div>
+    )
+}
+
+export default Leaderboard
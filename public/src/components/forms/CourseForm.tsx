@@ -39,10 +39,26 @@ const CourseForm = ({ courseToEdit }: { courseToEdit: Course }) => {
             case "slipDays":
                 course.slipDays = Number(value)
                 break
+            case "solutionRepository":
+                course.solutionRepository = value.trim()
+                break
+            case "solutionBranch":
+                course.solutionBranch = value.trim()
+                break
         }
         setCourse(course)
     }, [course])
 
+    const handleAutoRebuild = useCallback((event: React.ChangeEvent<HTMLInputElement>) => {
+        course.autoRebuild = event.currentTarget.checked
+        setCourse(course)
+    }, [course])
+
+    const handleLeaderboard = useCallback((event: React.ChangeEvent<HTMLInputElement>) => {
+        course.leaderboard = event.currentTarget.checked
+        setCourse(course)
+    }, [course])
+
     // Creates a new course if no course is being edited, otherwise updates the existing course
     const submitHandler = async (e: React.FormEvent<HTMLFormElement>) => {
         e.preventDefault()
@@ -101,6 +117,42 @@ const CourseForm = ({ courseToEdit }: { courseToEdit: Course }) => {
                             type="number"
                         />
                     </div>
+                    <div className="grid grid-cols-1 md:grid-cols-2 gap-4">
+                        <FormInput
+                            prepend="Solution repository"
+                            name="solutionRepository"
+                            placeholder="(default: assignments)"
+                            defaultValue={course.solutionRepository}
+                            onChange={handleChange}
+                        />
+                        <FormInput
+                            prepend="Solution branch"
+                            name="solutionBranch"
+                            placeholder="(ex. solution)"
+                            defaultValue={course.solutionBranch}
+                            onChange={handleChange}
+                        />
+                    </div>
+                    <label className="label cursor-pointer justify-start gap-3">
+                        <input
+                            type="checkbox"
+                            className="checkbox"
+                            name="autoRebuild"
+                            defaultChecked={course.autoRebuild}
+                            onChange={handleAutoRebuild}
+                        />
+                        <span>Rebuild stale submissions automatically when an assignment's tests change</span>
+                    </label>
+                    <label className="label cursor-pointer justify-start gap-3">
+                        <input
+                            type="checkbox"
+                            className="checkbox"
+                            name="leaderboard"
+                            defaultChecked={course.leaderboard}
+                            onChange={handleLeaderboard}
+                        />
+                        <span>Let students view leaderboards ranking submissions by their benchmark results</span>
+                    </label>
                     <div className="card-actions justify-end pt-4">
                         <button className="btn btn-primary" type="submit">
                             <i className="fas fa-floppy-disk mr-2" />
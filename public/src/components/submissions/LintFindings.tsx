@@ -0,0 +1,66 @@
+import type { BuildInfo } from "../../../proto/kit/score/score_pb"
+
+/** severityBadge returns the badge class for the given severity of a lint finding. */
+const severityBadge = (severity: string): string => {
+    switch (severity.toLowerCase()) {
+        case "error":
+            return "badge-error"
+        case "warning":
+            return "badge-warning"
+    }
+    return "badge-ghost"
+}
+
+/** LintFindings lists the findings reported by the linters of a submission's test run, if any,
+ *  and the points deducted from the submission's score for the findings. */
+const LintFindings = ({ buildInfo }: { buildInfo?: BuildInfo }) => {
+    const findings = buildInfo?.LintFindings ?? []
+    if (findings.length === 0) {
+        return null
+    }
+    return (
+        <div className="card bg-base-200 shadow-xl rounded-2xl overflow-hidden mb-4">
+            <div className="card-body p-4">
+                <div className="flex flex-wrap items-center justify-between gap-2">
+                    <h3 className="text-sm font-semibold flex items-center gap-2">
+                        <i className="fas fa-broom" />
+                        <span>Lint Findings ({findings.length})</span>
+                    </h3>
+                    {buildInfo && buildInfo.LintDeduction > 0 && (
+                        <span className="badge badge-warning" title="Percentage points deducted from the score for the lint findings">
+                            -{buildInfo.LintDeduction}%
+                        </span>
+                    )}
+                </div>
+                <div className="overflow-x-auto">
+                    <table className="table table-sm">
+                        <thead>
+                            <tr>
+                                <th>Location</th>
+                                <th>Linter</th>
+                                <th>Message</th>
+                            </tr>
+                        </thead>
+                        <tbody>
+                            {findings.map((finding, idx) => (
+                                // findings have no identity, but they only change with a new test run
+                                <tr key={idx}>
+                                    <td className="font-mono text-xs whitespace-nowrap">
+                                        {finding.File}{finding.Line > 0 ? `:${finding.Line}` : ""}{finding.Column > 0 ? `:${finding.Column}` : ""}
+                                    </td>
+                                    <td className="whitespace-nowrap">
+                                        {finding.Tool}{finding.Rule ? ` ${finding.Rule}` : ""}
+                                        {finding.Severity && <span className={`badge badge-sm ml-2 ${severityBadge(finding.Severity)}`}>{finding.Severity}</span>}
+                                    </td>
+                                    <td className="text-sm" style={{ wordBreak: "break-word", whiteSpace: "pre-wrap" }}>{finding.Message}</td>
+                                </tr>
+                            ))}
+                        </tbody>
+                    </table>
+                </div>
+            </div>
+        </div>
+    )
+}
+
+export default LintFindings
@@ -1,5 +1,6 @@
+import type { Coverage, PackageCoverage } from "../../../proto/kit/score/score_pb"
 import type { Assignment, Submission, UsedSlipDays } from "../../../proto/qf/types_pb"
-import { assignmentStatusText, getFormattedTime, getPassedTestsCount, getStatusByUser, isAllApproved, isManuallyGraded } from "../../Helpers"
+import { assignmentStatusText, getFormattedTime, getMissingTests, getPassedTestsCount, getStatusByUser, isAllApproved, isManuallyGraded } from "../../Helpers"
 import { useAppState } from "../../overmind"
 
 type SubmissionInfoProps = {
@@ -14,6 +15,7 @@ const SubmissionInfo = ({ submission, assignment }: SubmissionInfoProps) => {
     const delivered = getFormattedTime(buildInfo?.SubmissionDate)
     const built = getFormattedTime(buildInfo?.BuildDate)
     const executionTime = buildInfo ? `${buildInfo.ExecTime / BigInt(1000)} seconds` : ""
+    const missingTests = getMissingTests(submission.Scores)
 
     const isGroupSubmission = submission.groupID > 0n
     const group = isGroupSubmission
@@ -66,10 +68,43 @@ const SubmissionInfo = ({ submission, assignment }: SubmissionInfoProps) => {
                         <td>{getPassedTestsCount(submission.Scores)}</td>
                     </tr>
                 ) : null}
+                {missingTests.length > 0 ? (
+                    <tr>
+                        <td colSpan={2}>Tests Not Run</td>
+                        <td className="text-warning" title="These tests did not report a score, e.g., because the tests panicked or timed out">
+                            {missingTests.join(", ")}
+                        </td>
+                    </tr>
+                ) : null}
                 <tr>
                     <td colSpan={2}>Execution time</td>
                     <td>{executionTime}</td>
                 </tr>
+                {
+                    // Only render row if the tests collected code coverage
+                    buildInfo?.Coverage ? (
+                        <tr>
+                            <td colSpan={2}>Code coverage</td>
+                            <td>
+                                <div>{coveragePercent(buildInfo.Coverage)}</div>
+                                {buildInfo.Coverage.Packages.map(pkg => (
+                                    <div key={pkg.Package} className="text-xs text-base-content/70">
+                                        <code>{pkg.Package}</code>: {coveragePercent(pkg)}
+                                    </div>
+                                ))}
+                            </td>
+                        </tr>
+                    ) : null
+                }
+                {
+                    // Only render row if the submission records the tests version it was graded with
+                    buildInfo?.TestsCommit ? (
+                        <tr>
+                            <td colSpan={2}>Tests version</td>
+                            <td><code>{buildInfo.TestsCommit.slice(0, 7)}</code></td>
+                        </tr>
+                    ) : null
+                }
                 <tr>
                     <td colSpan={2}>{isGroupSubmission ? "Group slip days" : "Slip days"}</td>
                     <td>{isGroupSubmission ? group?.slipDaysRemaining : enrollment.slipDaysRemaining}</td>
@@ -87,6 +122,14 @@ const SubmissionInfo = ({ submission, assignment }: SubmissionInfoProps) => {
     )
 }
 
+/** coveragePercent returns the percentage of covered statements or lines, with one decimal. */
+function coveragePercent(coverage: Coverage | PackageCoverage): string {
+    if (coverage.Total === 0n) {
+        return "0.0%"
+    }
+    return `${(100 * Number(coverage.Covered) / Number(coverage.Total)).toFixed(1)}%`
+}
+
 function usedSlipdaysRows(assignment: Assignment, usedSlipDays: UsedSlipDays[]): React.ReactNode {
     // returns a table row if there exists some used slip days for the assignment, otherwise returns nothing
     if (usedSlipDays.length === 0) {
@@ -1,23 +1,62 @@
 import type { Score } from "../../../proto/kit/score/score_pb"
+import { Score_Status } from "../../../proto/kit/score/score_pb"
+import { formatExecTime, formatMemory, scorePoints } from "../../Helpers"
 
+/** SubmissionScore shows a test score, indented by its depth in the score groups.
+ *  The share is the test's maximum contribution to the total score; tests that are not expected have no share. */
 const SubmissionScore = ({
     score,
-    totalWeight,
+    share,
+    depth,
 }: {
     score: Score
-    totalWeight: number
+    share: number
+    depth: number
 }) => {
-    const passed = score.Score === score.MaxScore
+    const points = scorePoints(score)
+    const passed = points === score.MaxScore
     const rowClass = passed ? "passed" : "failed"
-    const percentage = (score.Score / score.MaxScore) * (score.Weight / totalWeight) * 100
-    const maxPercentage = (score.MaxScore / score.MaxScore) * (score.Weight / totalWeight) * 100
+    const percentage = (points / score.MaxScore) * share * 100
+    const maxPercentage = share * 100
     const cellColor = percentage === maxPercentage ? "text-success" : "text-error"
 
     return (
         <tr className={rowClass}>
-            <td className="pl-3! w-full">{score.TestName}</td>
+            <td className="w-full" style={{ paddingLeft: `${0.75 + depth * 1.5}rem` }}>
+                {score.TestName}
+                {score.status === Score_Status.UNEXPECTED &&
+                    <span className="badge badge-ghost badge-sm ml-2" title="This test is not listed in the assignment's tests and does not count towards the total score">
+                        not counted
+                    </span>
+                }
+                {(score.ExecTime > 0n || score.Memory > 0n) &&
+                    <span className="text-xs text-base-content/60 ml-2" title="Execution time and memory allocated by the test">
+                        {[
+                            score.ExecTime > 0n ? formatExecTime(score.ExecTime) : "",
+                            score.Memory > 0n ? formatMemory(score.Memory) : "",
+                        ].filter(metric => metric !== "").join(", ")}
+                    </span>
+                }
+                {score.NsPerOp > 0 &&
+                    <span className="text-xs text-base-content/60 ml-2" title="Benchmark results per operation">
+                        {`${Math.round(score.NsPerOp)} ns/op, ${score.AllocsPerOp} allocs/op, ${formatMemory(score.BytesPerOp)}/op`}
+                    </span>
+                }
+                {score.Hidden &&
+                    <span className="badge badge-neutral badge-sm ml-2" title="This test's name and details are hidden from students until the assignment's deadline">
+                        hidden
+                    </span>
+                }
+                {score.Flaky &&
+                    <span className="badge badge-info badge-sm ml-2" title="This test failed, but passed when it was rerun">
+                        flaky
+                    </span>
+                }
+            </td>
             <td className="whitespace-nowrap min-w-24 text-right">
-                {score.Score}/{score.MaxScore}
+                {score.status === Score_Status.MISSING
+                    ? <span className="badge badge-warning badge-sm" title="The test did not report a score, e.g., because the tests panicked or timed out">did not run</span>
+                    : `${Number(points.toFixed(2))}/${score.MaxScore}`}
             </td>
             <td className="whitespace-nowrap min-w-24 text-right">
                 <span className={cellColor}>
@@ -0,0 +1,48 @@
+import type { ScoreNode } from "../../Helpers"
+import { scoreFraction } from "../../Helpers"
+
+/** SubmissionScoreGroup shows the score of a score group, indented by its depth in the score groups.
+ *  The group's tests and nested groups are shown in the rows below it. The share is the group's
+ *  maximum contribution to the total score. */
+const SubmissionScoreGroup = ({
+    group,
+    share,
+    depth,
+}: {
+    group: ScoreNode
+    share: number
+    depth: number
+}) => {
+    const fraction = scoreFraction(group)
+    const percentage = fraction * share * 100
+    const maxPercentage = share * 100
+    const cellColor = percentage === maxPercentage ? "text-success" : "text-error"
+
+    return (
+        <tr className="font-semibold">
+            <td className="w-full" style={{ paddingLeft: `${0.75 + depth * 1.5}rem` }}>
+                <i className="fas fa-layer-group mr-2" />
+                {group.name}
+            </td>
+            <td className="whitespace-nowrap min-w-24 text-right">
+                {(fraction * 100).toFixed(1)}%
+            </td>
+            <td className="whitespace-nowrap min-w-24 text-right">
+                <span className={cellColor}>
+                    {percentage.toFixed(1)}%
+                </span>
+            </td>
+            <td className="whitespace-nowrap min-w-24 text-right">
+                <span
+                    style={{ opacity: 0.5 }}
+                    title={`Weight: ${group.weight}`}
+                    aria-label={`Max weighted percentage is ${maxPercentage.toFixed(1)} percent, weight ${group.weight}`}
+                >
+                    {maxPercentage.toFixed(1)}%
+                </span>
+            </td>
+        </tr>
+    )
+}
+
+export default SubmissionScoreGroup
@@ -1,8 +1,9 @@
-import { clone } from "@bufbuild/protobuf"
 import React, { useCallback } from 'react'
-import { ScoreSchema } from "../../../proto/kit/score/score_pb"
 import type { Submission } from "../../../proto/qf/types_pb"
+import type { ScoreNode } from "../../Helpers"
+import { countedWeight, isCounted, newScoreTree, scoreFraction, scorePoints } from "../../Helpers"
 import SubmissionScore from "./SubmissionScore"
+import SubmissionScoreGroup from "./SubmissionScoreGroup"
 
 type ScoreSort = "name" | "score" | "weight" | "percentage"
 
@@ -10,23 +11,40 @@ const SubmissionScores = ({ submission }: { submission: Submission }) => {
     const [sortKey, setSortKey] = React.useState<ScoreSort>("name")
     const [sortAscending, setSortAscending] = React.useState<boolean>(true)
 
-    const sortScores = () => {
+    /** sortValue returns the value of the node to sort by; share is the node's share of the total score. */
+    const sortValue = (node: ScoreNode, share: number): number => {
+        switch (sortKey) {
+            case "score":
+                return node.score ? scorePoints(node.score) : scoreFraction(node) * 100
+            case "weight":
+                return node.weight
+            case "percentage":
+                return scoreFraction(node) * share
+            default:
+                return 0
+        }
+    }
+
+    /** rows returns the table rows of the children of the given node, sorted within each group.
+     *  The share of a child is its maximum contribution to the total score, given by its weight relative to its counted siblings. */
+    const rows = (node: ScoreNode, share: number, depth: number, path: string): React.JSX.Element[] => {
+        const totalWeight = countedWeight(node.children)
+        const childShare = (child: ScoreNode) => isCounted(child) && totalWeight > 0 ? share * child.weight / totalWeight : 0
         const sortBy = sortAscending ? 1 : -1
-        const scores = submission.Scores.map(score => clone(ScoreSchema, score))
-        const totalWeight = scores.reduce((acc, score) => acc + score.Weight, 0)
-        return scores.sort((a, b) => {
-            switch (sortKey) {
-                case "name":
-                    return sortBy * (a.TestName.localeCompare(b.TestName))
-                case "score":
-                    return sortBy * (a.Score - b.Score)
-                case "weight":
-                    return sortBy * (a.Weight - b.Weight)
-                case "percentage":
-                    return sortBy * ((a.Score / a.MaxScore) * (a.Weight / totalWeight) - (b.Score / b.MaxScore) * (b.Weight / totalWeight))
-                default:
-                    return 0
+        const children = [...node.children].sort((a, b) =>
+            sortKey === "name"
+                ? sortBy * a.name.localeCompare(b.name)
+                : sortBy * (sortValue(a, childShare(a)) - sortValue(b, childShare(b)))
+        )
+        return children.flatMap(child => {
+            if (child.score) {
+                return [<SubmissionScore key={`${path}/${child.score.ID}/${child.name}`} score={child.score} share={childShare(child)} depth={depth} />]
             }
+            const groupPath = `${path}/${child.name}`
+            return [
+                <SubmissionScoreGroup key={groupPath} group={child} share={childShare(child)} depth={depth} />,
+                ...rows(child, childShare(child), depth + 1, groupPath),
+            ]
         })
     }
 
@@ -40,8 +58,7 @@ const SubmissionScores = ({ submission }: { submission: Submission }) => {
         }
     }, [sortKey, sortAscending])
 
-    const sortedScores = React.useMemo(sortScores, [submission, sortKey, sortAscending])
-    const totalWeight = sortedScores.reduce((acc, score) => acc + score.Weight, 0)
+    const tree = React.useMemo(() => newScoreTree(submission.Scores), [submission])
     return (
         <table className="table table-zebra">
             <thead className="bg-base-300 text-base-content">
@@ -53,9 +70,7 @@ const SubmissionScores = ({ submission }: { submission: Submission }) => {
                 </tr>
             </thead>
             <tbody>
-                {sortedScores.map(score =>
-                    <SubmissionScore key={score.ID.toString()} score={score} totalWeight={totalWeight} />
-                )}
+                {rows(tree, 1, 0, "")}
             </tbody>
             <tfoot>
                 <tr>
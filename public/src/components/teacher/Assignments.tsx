@@ -1,11 +1,13 @@
 // ...existing code...
-import { useState } from "react"
+import { useEffect, useState } from "react"
 import { useNavigate } from "react-router-dom"
-import type { Assignment } from "../../../proto/qf/types_pb"
+import type { Assignment, FlakyTest, RebuildSummary } from "../../../proto/qf/types_pb"
 import { Color, getFormattedTime, hasBenchmarks, isManuallyGraded } from "../../Helpers"
 import { useCourseID } from "../../hooks/useCourseID"
 import { useActions, useAppState } from "../../overmind"
 import Button, { ButtonType } from "../admin/Button"
+import RebuildSummaries, { RebuildReport } from "./RebuildSummaries"
+import TestsHealth from "./TestsHealth"
 import RubricDisplay from "./RubricDisplay"
 
 const Assignments = () => {
@@ -19,21 +21,53 @@ const Assignments = () => {
         const [open, setOpen] = useState<boolean>(false)
         const [buttonText, setButtonText] = useState<string>("Rebuild all tests")
         const [isRebuilding, setIsRebuilding] = useState<boolean>(false)
+        const [force, setForce] = useState<boolean>(false)
+        const [stale, setStale] = useState<boolean>(false)
+        const [staleCount, setStaleCount] = useState<number | undefined>(undefined)
+        const [dryRun, setDryRun] = useState<boolean>(false)
+        const [testsBranch, setTestsBranch] = useState<string>("")
+        const [report, setReport] = useState<RebuildSummary | undefined>(undefined)
+        const [flakyTests, setFlakyTests] = useState<FlakyTest[]>([])
 
         const manually = isManuallyGraded(assignment.reviewers)
 
+        useEffect(() => {
+            if (open && !manually && !isRebuilding) {
+                actions.getStaleSubmissionCount({ courseID, assignmentID: assignment.ID }).then(setStaleCount)
+            }
+        }, [actions, courseID, assignment.ID, open, manually, isRebuilding])
+
+        useEffect(() => {
+            if (open && assignment.testRetries > 0 && !isRebuilding) {
+                actions.getFlakyTests({ courseID, assignmentID: assignment.ID }).then(setFlakyTests)
+            }
+        }, [actions, courseID, assignment.ID, assignment.testRetries, open, isRebuilding])
+
         const rebuild = async () => {
+            const action = dryRun ? "test without recording the results of" : "rebuild"
             if (
                 confirm(
-                    `Warning! This will rebuild all submissions for ${assignment.name}. This may take several minutes. Are you sure you want to continue?`,
+                    `Warning! This will ${action} ${stale ? "stale" : "all"} submissions for ${assignment.name}. This may take several minutes. Are you sure you want to continue?`,
                 )
             ) {
                 setButtonText("Rebuilding...")
                 setIsRebuilding(true)
-                const success = await actions.rebuildAllSubmissions({
+                setReport(undefined)
+                const { rebuildID, success } = await actions.rebuildAllSubmissions({
                     assignmentID: assignment.ID,
                     courseID,
+                    force,
+                    stale,
+                    dryRun,
+                    testsBranch: dryRun ? testsBranch.trim() : "",
+                    onProgress: (progress) => {
+                        const finished = progress.succeeded + progress.failed + progress.cancelled
+                        setButtonText(`Rebuilding... ${finished}/${progress.total}`)
+                    },
                 })
+                if (dryRun && rebuildID > 0n) {
+                    setReport(await actions.getRebuildSummary({ courseID, rebuildID }))
+                }
                 setIsRebuilding(false)
                 if (success) {
                     setButtonText("Rebuild Successful ✓")
@@ -87,28 +121,99 @@ const Assignments = () => {
                 {open && (
                     <div className="p-4 border-t border-base-content/10">
                         {!manually ? (
-                            <div className="flex flex-col md:flex-row md:items-center md:justify-between gap-3">
-                                <div className="flex items-center gap-3">
-                                    <Button
-                                        text={buttonText}
-                                        color={Color.BLUE}
-                                        onClick={rebuild}
-                                        disabled={isRebuilding}
-                                    />
-                                    <div className="text-sm text-base-content/70">
-                                        Rebuilds all submissions for this assignment.
+                            <>
+                                <div className="flex flex-col md:flex-row md:items-center md:justify-between gap-3">
+                                    <div className="flex items-center gap-3">
+                                        <Button
+                                            text={buttonText}
+                                            color={Color.BLUE}
+                                            onClick={rebuild}
+                                            disabled={isRebuilding}
+                                        />
+                                        <label className="label cursor-pointer gap-2">
+                                            <input
+                                                type="checkbox"
+                                                className="checkbox checkbox-sm"
+                                                checked={force}
+                                                onChange={() => setForce(!force)}
+                                                disabled={isRebuilding}
+                                            />
+                                            <span className="text-sm">Force</span>
+                                        </label>
+                                        <label className="label cursor-pointer gap-2">
+                                            <input
+                                                type="checkbox"
+                                                className="checkbox checkbox-sm"
+                                                checked={stale}
+                                                onChange={() => setStale(!stale)}
+                                                disabled={isRebuilding}
+                                            />
+                                            <span className="text-sm">{`Stale only${staleCount !== undefined ? ` (${staleCount})` : ""}`}</span>
+                                        </label>
+                                        <label className="label cursor-pointer gap-2">
+                                            <input
+                                                type="checkbox"
+                                                className="checkbox checkbox-sm"
+                                                checked={dryRun}
+                                                onChange={() => setDryRun(!dryRun)}
+                                                disabled={isRebuilding}
+                                            />
+                                            <span className="text-sm">Dry run</span>
+                                        </label>
+                                        {dryRun && (
+                                            <input
+                                                type="text"
+                                                className="input input-bordered input-sm w-40"
+                                                placeholder="Tests branch"
+                                                value={testsBranch}
+                                                onChange={e => setTestsBranch(e.target.value)}
+                                                disabled={isRebuilding}
+                                            />
+                                        )}
+                                        <div className="text-sm text-base-content/70">
+                                            Rebuilds all submissions for this assignment, or only those graded with an older version of the tests. Unless forced, submissions whose commit and tests are unchanged reuse their cached results. A dry run reports how the scores would change with the current tests, or the tests of the given branch, without recording the results.
+                                        </div>
                                     </div>
-                                </div>
 
-                                <div className="flex items-center gap-2">
-                                    <Button
-                                        text="View submissions"
-                                        color={Color.GREEN}
-                                        type={ButtonType.OUTLINE}
-                                        onClick={handleViewSubmissions}
-                                    />
+                                    <div className="flex items-center gap-2">
+                                        <Button
+                                            text="View submissions"
+                                            color={Color.GREEN}
+                                            type={ButtonType.OUTLINE}
+                                            onClick={handleViewSubmissions}
+                                        />
+                                    </div>
                                 </div>
-                            </div>
+                                {flakyTests.length > 0 && (
+                                    <div className="mt-3">
+                                        <div className="text-sm font-semibold">Flaky tests</div>
+                                        <table className="table table-sm">
+                                            <thead>
+                                                <tr>
+                                                    <th>Test</th>
+                                                    <th>Passed when rerun</th>
+                                                </tr>
+                                            </thead>
+                                            <tbody>
+                                                {flakyTests.map(test => (
+                                                    <tr key={test.testName}>
+                                                        <td>{test.testName}</td>
+                                                        <td>{`${test.flaky} of ${test.submissions} submissions`}</td>
+                                                    </tr>
+                                                ))}
+                                            </tbody>
+                                        </table>
+                                    </div>
+                                )}
+                                {report && (
+                                    <div className="mt-3">
+                                        <div className="text-sm">
+                                            {`Dry run: ${report.increased} increased, ${report.decreased} decreased, ${report.unchanged} unchanged, ${report.progress?.failed ?? 0} failed`}
+                                        </div>
+                                        <RebuildReport summary={report} />
+                                    </div>
+                                )}
+                            </>
                         ) : (
                             <div className="text-sm text-base-content/70">
                                 This assignment is manually graded. Manage criteria and benchmarks in the assignment <code className="px-1 rounded bg-base-100 text-error">criteria.json</code> file.
@@ -132,6 +237,8 @@ const Assignments = () => {
             {state.assignments[courseID.toString()]?.map(assignment =>
                 <AssignmentElement key={assignment.ID} assignment={assignment} />
             )}
+            <TestsHealth />
+            <RebuildSummaries />
         </div>
     )
 }
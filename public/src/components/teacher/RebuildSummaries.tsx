@@ -0,0 +1,85 @@
+import { useEffect, useState } from "react"
+import type { RebuildSummary } from "../../../proto/qf/types_pb"
+import { getFormattedTime } from "../../Helpers"
+import { useCourseID } from "../../hooks/useCourseID"
+import { useActions, useAppState } from "../../overmind"
+
+/** RebuildReport lists the rebuilt submissions whose score or test status changed. */
+export const RebuildReport = ({ summary }: { summary: RebuildSummary }) => {
+    if (summary.changed.length === 0) {
+        return null
+    }
+    return (
+        <table className="table table-sm mt-2">
+            <thead>
+                <tr>
+                    <th>Owner</th>
+                    <th>Previous score</th>
+                    <th>Score</th>
+                    <th>Now passing</th>
+                    <th>Now failing</th>
+                </tr>
+            </thead>
+            <tbody>
+                {summary.changed.map(job => (
+                    <tr key={job.ID.toString()}>
+                        <td>{job.JobOwner}</td>
+                        <td>{`${job.PreviousScore}%`}</td>
+                        <td className={job.Score > job.PreviousScore ? "text-success" : job.Score < job.PreviousScore ? "text-error" : ""}>{`${job.Score}%`}</td>
+                        <td className="text-success">{job.NowPassing.join(", ")}</td>
+                        <td className="text-error">{job.NowFailing.join(", ")}</td>
+                    </tr>
+                ))}
+            </tbody>
+        </table>
+    )
+}
+
+/** RebuildSummaries lists the course's most recent rebuilds and how they changed the scores of the rebuilt submissions. */
+const RebuildSummaries = () => {
+    const courseID = useCourseID()
+    const actions = useActions().global
+    const state = useAppState()
+    const [summaries, setSummaries] = useState<RebuildSummary[]>([])
+
+    useEffect(() => {
+        actions.getRebuildSummaries(courseID).then(setSummaries)
+    }, [actions, courseID])
+
+    if (summaries.length === 0) {
+        return null
+    }
+
+    const assignmentName = (assignmentID: bigint) =>
+        state.assignments[courseID.toString()]?.find(assignment => assignment.ID === assignmentID)?.name ?? `Assignment ${assignmentID}`
+
+    return (
+        <div className="card bg-base-200 shadow-md rounded-lg">
+            <div className="card-body p-4">
+                <h3 className="card-title text-lg">Recent rebuilds</h3>
+                {summaries.map(summary => {
+                    const { rebuild, progress } = summary
+                    const finished = (progress?.succeeded ?? 0) + (progress?.failed ?? 0) + (progress?.cancelled ?? 0)
+                    return (
+                        <details key={rebuild?.ID.toString()} className="border-t border-base-content/10 py-2">
+                            <summary className="cursor-pointer flex flex-wrap items-center gap-2">
+                                <span className="font-semibold">{assignmentName(rebuild?.AssignmentID ?? 0n)}</span>
+                                <span className="text-sm text-base-content/70">{getFormattedTime(rebuild?.CreatedAt)}</span>
+                                {rebuild?.Automatic && <span className="badge badge-info badge-sm">Tests changed</span>}
+                                {rebuild?.DryRun && <span className="badge badge-neutral badge-sm">{`Dry run${rebuild.TestsBranch ? ` of ${rebuild.TestsBranch}` : ""}`}</span>}
+                                <span className="text-sm">{`${finished}/${progress?.total ?? 0} finished`}</span>
+                                {(progress?.failed ?? 0) > 0 && <span className="badge badge-error badge-sm">{`${progress?.failed} failed`}</span>}
+                                <span className="badge badge-success badge-sm">{`${summary.increased} increased`}</span>
+                                <span className="badge badge-warning badge-sm">{`${summary.decreased} decreased`}</span>
+                                <span className="badge badge-ghost badge-sm">{`${summary.unchanged} unchanged`}</span>
+                            </summary>
+                            <RebuildReport summary={summary} />
+                        </details>
+                    )
+                })}
+            </div>
+        </div>
+    )
+}
+
+export default RebuildSummaries
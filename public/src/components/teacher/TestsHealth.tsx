@@ -0,0 +1,80 @@
+import { useEffect, useState } from "react"
+import type { Job } from "../../../proto/qf/types_pb"
+import { Job_Status } from "../../../proto/qf/types_pb"
+import { getFormattedTime } from "../../Helpers"
+import { useCourseID } from "../../hooks/useCourseID"
+import { useActions, useAppState } from "../../overmind"
+
+/** isHealthy returns true if the reference solution passed all of the assignment's tests. */
+const isHealthy = (job: Job) =>
+    job.status === Job_Status.SUCCEEDED && job.Score === 100 && job.FailingTests.length === 0 && job.MissingTests.length === 0
+
+/** TestsHealth reports how the reference solution scored on the most recent tests of each assignment. */
+const TestsHealth = () => {
+    const courseID = useCourseID()
+    const actions = useActions().global
+    const state = useAppState()
+    const [jobs, setJobs] = useState<Job[]>([])
+
+    useEffect(() => {
+        actions.getTestsHealth(courseID).then(setJobs)
+    }, [actions, courseID])
+
+    if (jobs.length === 0) {
+        return null
+    }
+
+    const assignmentName = (assignmentID: bigint) =>
+        state.assignments[courseID.toString()]?.find(assignment => assignment.ID === assignmentID)?.name ?? `Assignment ${assignmentID}`
+
+    const statusBadge = (job: Job) => {
+        switch (job.status) {
+            case Job_Status.QUEUED:
+            case Job_Status.RUNNING:
+                return <span className="badge badge-ghost badge-sm">Running</span>
+            case Job_Status.CANCELLED:
+                return <span className="badge badge-ghost badge-sm">Cancelled</span>
+        }
+        return isHealthy(job)
+            ? <span className="badge badge-success badge-sm">Healthy</span>
+            : <span className="badge badge-error badge-sm">Needs attention</span>
+    }
+
+    return (
+        <div className="card bg-base-200 shadow-md rounded-lg">
+            <div className="card-body p-4">
+                <h3 className="card-title text-lg">Tests health</h3>
+                <table className="table table-sm">
+                    <thead>
+                        <tr>
+                            <th>Assignment</th>
+                            <th>Tests commit</th>
+                            <th>Checked</th>
+                            <th>Status</th>
+                            <th>Solution score</th>
+                            <th>Failing tests</th>
+                            <th>Missing tests</th>
+                        </tr>
+                    </thead>
+                    <tbody>
+                        {jobs.map(job => (
+                            <tr key={job.ID.toString()}>
+                                <td>{assignmentName(job.AssignmentID)}</td>
+                                <td className="font-mono">{job.TestsCommit.slice(0, 7)}</td>
+                                <td>{getFormattedTime(job.UpdatedAt)}</td>
+                                <td>{statusBadge(job)}</td>
+                                <td className={job.status === Job_Status.SUCCEEDED && job.Score < 100 ? "text-error" : ""}>
+                                    {job.status === Job_Status.SUCCEEDED ? `${job.Score}%` : job.Error}
+                                </td>
+                                <td className="text-error">{job.FailingTests.join(", ")}</td>
+                                <td className="text-warning">{job.MissingTests.join(", ")}</td>
+                            </tr>
+                        ))}
+                    </tbody>
+                </table>
+            </div>
+        </div>
+    )
+}
+
+export default TestsHealth
@@ -1,14 +1,20 @@
 import { clone, create, isMessage } from "@bufbuild/protobuf"
-import type { ConnectError } from "@connectrpc/connect"
-import { Code } from "@connectrpc/connect"
+import { Code, ConnectError } from "@connectrpc/connect"
 import type { Context } from "../.."
+import type { LeaderboardRequest_Metric } from "../../../../proto/qf/requests_pb"
 import { RepositoryRequestSchema, SubmissionRequest_SubmissionType, } from "../../../../proto/qf/requests_pb"
 import type {
+    BuildLog,
     Course,
     Enrollment,
+    FlakyTest,
     Grade,
     Group,
+    Job,
     Group_GroupStatus,
+    LeaderboardEntry,
+    RebuildProgress,
+    RebuildSummary,
     Submission,
     Submission_Status,
     User
@@ -539,7 +545,7 @@ export const getSubmission = async ({ state, effects }: Context, { courseID, own
     }
 }
 
-/** Rebuilds the currently active submission */
+/** Rebuilds the currently active submission. The tests are run even if the results for the submission's commit are cached. */
 export const rebuildSubmission = async ({ state, actions, effects }: Context, { owner, submission }: { owner: SubmissionOwner, submission: Submission | null }): Promise<void> => {
     if (!(submission && state.selectedAssignment && state.activeCourse)) {
         return
@@ -548,6 +554,7 @@ export const rebuildSubmission = async ({ state, actions, effects }: Context, {
         courseID: state.activeCourse,
         assignmentID: state.selectedAssignment.ID,
         submissionID: submission.ID,
+        force: true,
     })
     if (response.error) {
         return
@@ -558,13 +565,131 @@ export const rebuildSubmission = async ({ state, actions, effects }: Context, {
     actions.global.alert({ color: Color.GREEN, text: 'Submission rebuilt successfully' })
 }
 
-/* rebuildAllSubmissions rebuilds all submissions for a given assignment */
-export const rebuildAllSubmissions = async ({ effects }: Context, { courseID, assignmentID }: { courseID: bigint, assignmentID: bigint }): Promise<boolean> => {
+/* rebuildAllSubmissions rebuilds all submissions for a given assignment, reporting progress until the rebuild is finished.
+ * If stale is set, only submissions graded with an older version of the tests are rebuilt.
+ * Unless forced, cached results are reused for submissions whose commit and tests are unchanged.
+ * A dry run runs the tests of the given tests branch, or the current tests, without recording the results;
+ * use getRebuildSummary to get the dry run's report.
+ * Returns the rebuild's ID, or zero if the rebuild could not be started,
+ * and whether all submissions were rebuilt successfully. */
+export const rebuildAllSubmissions = async ({ effects }: Context, { courseID, assignmentID, force, stale, dryRun = false, testsBranch = "", onProgress }: { courseID: bigint, assignmentID: bigint, force: boolean, stale: boolean, dryRun?: boolean, testsBranch?: string, onProgress: (progress: RebuildProgress) => void }): Promise<{ rebuildID: bigint, success: boolean }> => {
     const response = await effects.global.api.client.rebuildSubmissions({
         courseID,
         assignmentID,
+        force,
+        stale,
+        dryRun,
+        testsBranch,
     })
-    return !response.error
+    if (response.error) {
+        return { rebuildID: 0n, success: false }
+    }
+    const rebuildID = response.message.ID
+    try {
+        const stream = effects.global.api.client.rebuildStream({ courseID, rebuildID })
+        for await (const progress of stream) {
+            onProgress(progress)
+            if (progress.succeeded + progress.failed + progress.cancelled >= progress.total) {
+                return { rebuildID, success: progress.failed === 0 && progress.cancelled === 0 }
+            }
+        }
+    } catch {
+        // The stream was closed before the rebuild finished.
+    }
+    return { rebuildID, success: false }
+}
+
+/* followBuildLog calls onLog with the output of the given test run while its tests are running.
+ * Returns true when the test run is finished, i.e., when the server closes the stream, or when the signal is aborted.
+ * Returns false if the stream failed for another reason, e.g., if the user cannot follow the test run. */
+export const followBuildLog = async ({ effects }: Context, { courseID, assignmentID, userID, groupID, signal, onLog }: { courseID: bigint, assignmentID: bigint, userID: bigint, groupID: bigint, signal: AbortSignal, onLog: (log: BuildLog) => void }): Promise<boolean> => {
+    try {
+        const stream = effects.global.api.client.buildLogStream({ courseID, assignmentID, userID, groupID }, { signal })
+        for await (const log of stream) {
+            onLog(log)
+        }
+    } catch (error) {
+        // The server closes the stream when the test run is finished; aborting the signal also cancels the stream.
+        return ConnectError.from(error).code === Code.Canceled
+    }
+    return true
+}
+
+/** Returns the number of submissions for the given assignment that were graded with an older version of the tests,
+ * or undefined if the number cannot be determined. */
+export const getStaleSubmissionCount = async ({ effects }: Context, { courseID, assignmentID }: { courseID: bigint, assignmentID: bigint }): Promise<number | undefined> => {
+    const response = await effects.global.api.client.getStaleSubmissions({
+        courseID,
+        assignmentID,
+    })
+    if (response.error) {
+        return undefined
+    }
+    return response.message.submissions.length
+}
+
+/** Returns summaries of the course's most recent rebuilds, including how the rebuilds changed the scores of the rebuilt submissions. */
+export const getRebuildSummaries = async ({ effects }: Context, courseID: bigint): Promise<RebuildSummary[]> => {
+    const response = await effects.global.api.client.getRebuildSummaries({ courseID })
+    if (response.error) {
+        return []
+    }
+    return response.message.summaries
+}
+
+/** Returns the most recent runs of the course's tests against the reference solution, one for each assignment. */
+export const getTestsHealth = async ({ effects }: Context, courseID: bigint): Promise<Job[]> => {
+    const response = await effects.global.api.client.getTestsHealth({ courseID })
+    if (response.error) {
+        return []
+    }
+    return response.message.jobs
+}
+
+/** Returns the tests of the given assignment that failed, but passed when rerun, in the assignment's recorded submissions. */
+export const getFlakyTests = async ({ effects }: Context, { courseID, assignmentID }: { courseID: bigint, assignmentID: bigint }): Promise<FlakyTest[]> => {
+    const response = await effects.global.api.client.getFlakyTests({ courseID, assignmentID })
+    if (response.error) {
+        return []
+    }
+    return response.message.tests
+}
+
+/** Returns the latest submissions for the given assignment ranked by the given metric of the given test, best first.
+ * Returns an empty list if the leaderboard cannot be fetched, e.g., if the course has not enabled leaderboards. */
+export const getLeaderboard = async ({ effects }: Context, { courseID, assignmentID, testName, metric }: { courseID: bigint, assignmentID: bigint, testName: string, metric: LeaderboardRequest_Metric }): Promise<LeaderboardEntry[]> => {
+    const response = await effects.global.api.client.getLeaderboard({ courseID, assignmentID, testName, metric })
+    if (response.error) {
+        return []
+    }
+    return response.message.entries
+}
+
+/** Returns the summary of the given rebuild, e.g., the report of a dry run, or undefined if the summary cannot be fetched. */
+export const getRebuildSummary = async ({ effects }: Context, { courseID, rebuildID }: { courseID: bigint, rebuildID: bigint }): Promise<RebuildSummary | undefined> => {
+    const response = await effects.global.api.client.getRebuildSummary({ courseID, rebuildID })
+    if (response.error) {
+        return undefined
+    }
+    return response.message
+}
+
+/** Downloads the complete build log of the given submission's most recent test run as a gzip-compressed file. */
+export const downloadBuildLog = async ({ effects }: Context, { courseID, submission }: { courseID: bigint, submission: Submission }): Promise<void> => {
+    const response = await effects.global.api.client.getBuildLogArchive({
+        courseID,
+        submissionID: submission.ID,
+    })
+    if (response.error) {
+        return
+    }
+    const archive = response.message
+    const url = URL.createObjectURL(new Blob([archive.content.slice()], { type: "application/gzip" }))
+    const link = document.createElement("a")
+    link.href = url
+    link.download = `submission-${archive.submissionID}-${archive.commitID}.log.gz`
+    link.click()
+    URL.revokeObjectURL(url)
 }
 
 /** Enrolls a user (self) in a course given by courseID. Refreshes enrollments in state if enroll is successful. */
@@ -2,6 +2,9 @@ package qf
 
 import (
 	context "context"
+	"fmt"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/quickfeed/quickfeed/kit/score"
@@ -10,6 +13,8 @@ import (
 
 const (
 	days = time.Duration(24 * time.Hour)
+	// CoverageTestName is the test name of the score obtained for code coverage.
+	CoverageTestName = "Coverage"
 )
 
 // SinceDeadline returns the duration since the deadline.
@@ -42,6 +47,32 @@ func (a *Assignment) GradedManually() bool {
 	return a.GetReviewers() > 0
 }
 
+// HideTests removes the hidden tests from the assignment's expected tests,
+// unless the assignment's deadline has passed.
+func (a *Assignment) HideTests(now time.Time) {
+	if a.SinceDeadline(now) >= 0 {
+		return
+	}
+	a.ExpectedTests = slices.DeleteFunc(a.GetExpectedTests(), func(testInfo *TestInfo) bool {
+		return testInfo.GetHidden()
+	})
+}
+
+// HiddenTests returns the names of the assignment's hidden tests,
+// unless the assignment's deadline has passed.
+func (a *Assignment) HiddenTests(now time.Time) []string {
+	if a.SinceDeadline(now) >= 0 {
+		return nil
+	}
+	var hidden []string
+	for _, testInfo := range a.GetExpectedTests() {
+		if testInfo.GetHidden() {
+			hidden = append(hidden, testInfo.GetTestName())
+		}
+	}
+	return hidden
+}
+
 // ZeroScoreTests returns a slice of score.Score objects with zero scores
 // for all expected tests in this assignment.
 func (a *Assignment) ZeroScoreTests() []*score.Score {
@@ -53,10 +84,49 @@ func (a *Assignment) ZeroScoreTests() []*score.Score {
 	scores := make([]*score.Score, len(expectedTests))
 	for i, testInfo := range expectedTests {
 		scores[i] = &score.Score{
-			TestName: testInfo.GetTestName(),
-			MaxScore: testInfo.GetMaxScore(),
-			Weight:   testInfo.GetWeight(),
+			TestName:     testInfo.GetTestName(),
+			MaxScore:     testInfo.GetMaxScore(),
+			Weight:       testInfo.GetWeight(),
+			Hidden:       testInfo.GetHidden(),
+			Group:        testInfo.GetGroup(),
+			GroupWeights: testInfo.GetGroupWeights(),
 		}
 	}
 	return scores
 }
+
+// CoverageScore returns the score obtained for the given code coverage, or nil if the
+// assignment does not score coverage. The coverage score is the coverage percentage,
+// up to the assignment's coverage threshold. If no coverage was collected, the score is
+// zero and marked as missing.
+func (a *Assignment) CoverageScore(coverage *score.Coverage) *score.Score {
+	threshold := int32(a.GetCoverageThreshold())
+	if threshold == 0 {
+		return nil
+	}
+	sc := &score.Score{
+		TestName: CoverageTestName,
+		MaxScore: threshold,
+		Weight:   max(int32(a.GetCoverageWeight()), 1),
+	}
+	if coverage == nil {
+		sc.Status = score.Score_MISSING
+		sc.TestDetails = "no coverage collected"
+		return sc
+	}
+	percent := coverage.Percent()
+	sc.Score = min(int32(math.Round(percent)), threshold)
+	sc.TestDetails = fmt.Sprintf("%.1f%% coverage (full score at %d%% or more)", percent, threshold)
+	return sc
+}
+
+// LintDeduction returns the percentage points deducted from the score for the given number
+// of lint findings: the assignment's lint penalty per finding, up to its max lint penalty,
+// if set, and at most 100.
+func (a *Assignment) LintDeduction(findings int) uint32 {
+	deduction := min(uint64(a.GetLintPenalty())*uint64(findings), 100)
+	if maxPenalty := uint64(a.GetMaxLintPenalty()); maxPenalty > 0 {
+		deduction = min(deduction, maxPenalty)
+	}
+	return uint32(deduction)
+}
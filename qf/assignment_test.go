@@ -2,9 +2,12 @@ package qf
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/quickfeed/quickfeed/kit/score"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestAssignmentZeroScoreTests(t *testing.T) {
@@ -28,6 +31,16 @@ func TestAssignmentZeroScoreTests(t *testing.T) {
 			assignment: &Assignment{ExpectedTests: []*TestInfo{{TestName: "TestA", MaxScore: 10, Weight: 5}, {TestName: "TestB", MaxScore: 20, Weight: 10}}},
 			wantScores: []*score.Score{{TestName: "TestA", MaxScore: 10, Weight: 5}, {TestName: "TestB", MaxScore: 20, Weight: 10}},
 		},
+		{
+			name:       "HiddenExpectedTest",
+			assignment: &Assignment{ExpectedTests: []*TestInfo{{TestName: "TestA", MaxScore: 10, Weight: 5, Hidden: true}}},
+			wantScores: []*score.Score{{TestName: "TestA", MaxScore: 10, Weight: 5, Hidden: true}},
+		},
+		{
+			name:       "GroupedExpectedTest",
+			assignment: &Assignment{ExpectedTests: []*TestInfo{{TestName: "TestA/B", MaxScore: 10, Weight: 5, Group: "A/B", GroupWeights: []int32{2, 1}}}},
+			wantScores: []*score.Score{{TestName: "TestA/B", MaxScore: 10, Weight: 5, Group: "A/B", GroupWeights: []int32{2, 1}}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -41,3 +54,83 @@ func TestAssignmentZeroScoreTests(t *testing.T) {
 		})
 	}
 }
+
+func TestAssignmentHiddenTests(t *testing.T) {
+	now := time.Now()
+	expectedTests := []*TestInfo{
+		{TestName: "TestA"},
+		{TestName: "TestB", Hidden: true},
+		{TestName: "TestC", Hidden: true},
+	}
+	open := &Assignment{Deadline: timestamppb.New(now.Add(time.Hour)), ExpectedTests: expectedTests}
+	if diff := cmp.Diff([]string{"TestB", "TestC"}, open.HiddenTests(now)); diff != "" {
+		t.Errorf("HiddenTests() before deadline mismatch (-want +got):\n%s", diff)
+	}
+	closed := &Assignment{Deadline: timestamppb.New(now.Add(-time.Hour)), ExpectedTests: expectedTests}
+	if got := closed.HiddenTests(now); got != nil {
+		t.Errorf("HiddenTests() after deadline = %v, want nil", got)
+	}
+}
+
+func TestAssignmentCoverageScore(t *testing.T) {
+	tests := []struct {
+		name       string
+		assignment *Assignment
+		coverage   *score.Coverage
+		wantScore  *score.Score
+	}{
+		{
+			name:       "NotScored",
+			assignment: &Assignment{},
+			coverage:   &score.Coverage{Covered: 50, Total: 100},
+			wantScore:  nil,
+		},
+		{
+			name:       "NoCoverage",
+			assignment: &Assignment{CoverageThreshold: 80},
+			wantScore:  &score.Score{TestName: CoverageTestName, MaxScore: 80, Weight: 1, Status: score.Score_MISSING, TestDetails: "no coverage collected"},
+		},
+		{
+			name:       "BelowThreshold",
+			assignment: &Assignment{CoverageThreshold: 80, CoverageWeight: 3},
+			coverage:   &score.Coverage{Covered: 2, Total: 3},
+			wantScore:  &score.Score{TestName: CoverageTestName, Score: 67, MaxScore: 80, Weight: 3, TestDetails: "66.7% coverage (full score at 80% or more)"},
+		},
+		{
+			name:       "AboveThreshold",
+			assignment: &Assignment{CoverageThreshold: 80},
+			coverage:   &score.Coverage{Covered: 90, Total: 100},
+			wantScore:  &score.Score{TestName: CoverageTestName, Score: 80, MaxScore: 80, Weight: 1, TestDetails: "90.0% coverage (full score at 80% or more)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.assignment.CoverageScore(tt.coverage)
+			if diff := cmp.Diff(tt.wantScore, got, protocmp.Transform()); diff != "" {
+				t.Errorf("CoverageScore() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAssignmentLintDeduction(t *testing.T) {
+	tests := []struct {
+		name          string
+		assignment    *Assignment
+		findings      int
+		wantDeduction uint32
+	}{
+		{name: "NoPenalty", assignment: &Assignment{}, findings: 10, wantDeduction: 0},
+		{name: "NoFindings", assignment: &Assignment{LintPenalty: 2}, findings: 0, wantDeduction: 0},
+		{name: "Uncapped", assignment: &Assignment{LintPenalty: 2}, findings: 7, wantDeduction: 14},
+		{name: "Capped", assignment: &Assignment{LintPenalty: 2, MaxLintPenalty: 10}, findings: 7, wantDeduction: 10},
+		{name: "AtMost100", assignment: &Assignment{LintPenalty: 30}, findings: 4, wantDeduction: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.assignment.LintDeduction(tt.findings); got != tt.wantDeduction {
+				t.Errorf("LintDeduction(%d) = %d, want %d", tt.findings, got, tt.wantDeduction)
+			}
+		})
+	}
+}
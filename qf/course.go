@@ -109,3 +109,18 @@ func (course *Course) PopulateSlipDays() {
 func (*Course) UserIDs() []uint64 {
 	return []uint64{}
 }
+
+// HasSolution returns true if the course has a reference solution to validate
+// the course's tests against when the tests repository is updated.
+func (course *Course) HasSolution() bool {
+	return course.GetSolutionRepository() != "" || course.GetSolutionBranch() != ""
+}
+
+// SolutionRepo returns the name of the repository holding the course's reference solution.
+// The reference solution is in the assignments repository unless another repository is specified.
+func (course *Course) SolutionRepo() string {
+	if course.GetSolutionRepository() != "" {
+		return course.GetSolutionRepository()
+	}
+	return AssignmentsRepo
+}
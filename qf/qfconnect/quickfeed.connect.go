@@ -99,6 +99,30 @@ const (
 	// QuickFeedServiceRebuildSubmissionsProcedure is the fully-qualified name of the QuickFeedService's
 	// RebuildSubmissions RPC.
 	QuickFeedServiceRebuildSubmissionsProcedure = "/qf.QuickFeedService/RebuildSubmissions"
+	// QuickFeedServiceCancelRebuildProcedure is the fully-qualified name of the QuickFeedService's
+	// CancelRebuild RPC.
+	QuickFeedServiceCancelRebuildProcedure = "/qf.QuickFeedService/CancelRebuild"
+	// QuickFeedServiceGetRebuildSummariesProcedure is the fully-qualified name of the
+	// QuickFeedService's GetRebuildSummaries RPC.
+	QuickFeedServiceGetRebuildSummariesProcedure = "/qf.QuickFeedService/GetRebuildSummaries"
+	// QuickFeedServiceGetRebuildSummaryProcedure is the fully-qualified name of the QuickFeedService's
+	// GetRebuildSummary RPC.
+	QuickFeedServiceGetRebuildSummaryProcedure = "/qf.QuickFeedService/GetRebuildSummary"
+	// QuickFeedServiceGetTestsHealthProcedure is the fully-qualified name of the QuickFeedService's
+	// GetTestsHealth RPC.
+	QuickFeedServiceGetTestsHealthProcedure = "/qf.QuickFeedService/GetTestsHealth"
+	// QuickFeedServiceGetFlakyTestsProcedure is the fully-qualified name of the QuickFeedService's
+	// GetFlakyTests RPC.
+	QuickFeedServiceGetFlakyTestsProcedure = "/qf.QuickFeedService/GetFlakyTests"
+	// QuickFeedServiceGetLeaderboardProcedure is the fully-qualified name of the QuickFeedService's
+	// GetLeaderboard RPC.
+	QuickFeedServiceGetLeaderboardProcedure = "/qf.QuickFeedService/GetLeaderboard"
+	// QuickFeedServiceGetStaleSubmissionsProcedure is the fully-qualified name of the
+	// QuickFeedService's GetStaleSubmissions RPC.
+	QuickFeedServiceGetStaleSubmissionsProcedure = "/qf.QuickFeedService/GetStaleSubmissions"
+	// QuickFeedServiceGetBuildLogArchiveProcedure is the fully-qualified name of the QuickFeedService's
+	// GetBuildLogArchive RPC.
+	QuickFeedServiceGetBuildLogArchiveProcedure = "/qf.QuickFeedService/GetBuildLogArchive"
 	// QuickFeedServiceCreateReviewProcedure is the fully-qualified name of the QuickFeedService's
 	// CreateReview RPC.
 	QuickFeedServiceCreateReviewProcedure = "/qf.QuickFeedService/CreateReview"
@@ -120,6 +144,12 @@ const (
 	// QuickFeedServiceSubmissionStreamProcedure is the fully-qualified name of the QuickFeedService's
 	// SubmissionStream RPC.
 	QuickFeedServiceSubmissionStreamProcedure = "/qf.QuickFeedService/SubmissionStream"
+	// QuickFeedServiceRebuildStreamProcedure is the fully-qualified name of the QuickFeedService's
+	// RebuildStream RPC.
+	QuickFeedServiceRebuildStreamProcedure = "/qf.QuickFeedService/RebuildStream"
+	// QuickFeedServiceBuildLogStreamProcedure is the fully-qualified name of the QuickFeedService's
+	// BuildLogStream RPC.
+	QuickFeedServiceBuildLogStreamProcedure = "/qf.QuickFeedService/BuildLogStream"
 )
 
 // QuickFeedServiceClient is a client for the qf.QuickFeedService service.
@@ -150,7 +180,29 @@ type QuickFeedServiceClient interface {
 	// UpdateSubmission updates the submission specified in the Grade message.
 	// If the Grade's UserID is zero, the grade is applied to all users associated with the submission.
 	UpdateSubmission(context.Context, *qf.Grade) (*qf.Void, error)
-	RebuildSubmissions(context.Context, *qf.RebuildRequest) (*qf.Void, error)
+	// RebuildSubmissions rebuilds a single submission, or all submissions for the assignment
+	// if no submission ID is given. Rebuilding all submissions returns immediately;
+	// use RebuildStream to follow the rebuild's progress.
+	RebuildSubmissions(context.Context, *qf.RebuildRequest) (*qf.Rebuild, error)
+	CancelRebuild(context.Context, *qf.RebuildStatusRequest) (*qf.Void, error)
+	// GetRebuildSummaries returns summaries of the course's most recent rebuilds,
+	// including how the rebuilds changed the scores of the rebuilt submissions.
+	GetRebuildSummaries(context.Context, *qf.CourseRequest) (*qf.RebuildSummaries, error)
+	// GetRebuildSummary returns the summary of the given rebuild, e.g., the report of a dry run.
+	GetRebuildSummary(context.Context, *qf.RebuildStatusRequest) (*qf.RebuildSummary, error)
+	// GetTestsHealth returns the results of the most recent runs of the course's tests
+	// against the reference solution, one for each assignment.
+	GetTestsHealth(context.Context, *qf.CourseRequest) (*qf.TestsHealth, error)
+	// GetFlakyTests returns the tests of the given assignment that passed only when rerun,
+	// aggregated over the assignment's recorded submissions.
+	GetFlakyTests(context.Context, *qf.FlakyTestsRequest) (*qf.FlakyTests, error)
+	// GetLeaderboard ranks the latest submissions for an assignment by a benchmark metric of one of its tests.
+	// Students may only view the leaderboards of courses that have enabled leaderboards.
+	GetLeaderboard(context.Context, *qf.LeaderboardRequest) (*qf.Leaderboard, error)
+	// GetStaleSubmissions returns the submissions graded with another revision of the tests repository.
+	GetStaleSubmissions(context.Context, *qf.StaleSubmissionsRequest) (*qf.StaleSubmissions, error)
+	// GetBuildLogArchive returns the complete, untruncated build log of a submission's test run.
+	GetBuildLogArchive(context.Context, *qf.BuildLogArchiveRequest) (*qf.BuildLogArchive, error)
 	CreateReview(context.Context, *qf.ReviewRequest) (*qf.Review, error)
 	UpdateReview(context.Context, *qf.ReviewRequest) (*qf.Review, error)
 	CreateAssignmentFeedback(context.Context, *qf.AssignmentFeedback) (*qf.Void, error)
@@ -158,6 +210,10 @@ type QuickFeedServiceClient interface {
 	GetRepositories(context.Context, *qf.CourseRequest) (*qf.Repositories, error)
 	IsEmptyRepo(context.Context, *qf.RepositoryRequest) (*qf.Void, error)
 	SubmissionStream(context.Context, *qf.Void) (*connect.ServerStreamForClient[qf.Submission], error)
+	RebuildStream(context.Context, *qf.RebuildStatusRequest) (*connect.ServerStreamForClient[qf.RebuildProgress], error)
+	// BuildLogStream sends the output of the user's or group's test run for the given assignment,
+	// while the tests are running. The stream is closed when the test run is finished.
+	BuildLogStream(context.Context, *qf.BuildLogRequest) (*connect.ServerStreamForClient[qf.BuildLog], error)
 }
 
 // NewQuickFeedServiceClient constructs a client for the qf.QuickFeedService service. By default, it
@@ -297,12 +353,60 @@ func NewQuickFeedServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(quickFeedServiceMethods.ByName("UpdateSubmission")),
 			connect.WithClientOptions(opts...),
 		),
-		rebuildSubmissions: connect.NewClient[qf.RebuildRequest, qf.Void](
+		rebuildSubmissions: connect.NewClient[qf.RebuildRequest, qf.Rebuild](
 			httpClient,
 			baseURL+QuickFeedServiceRebuildSubmissionsProcedure,
 			connect.WithSchema(quickFeedServiceMethods.ByName("RebuildSubmissions")),
 			connect.WithClientOptions(opts...),
 		),
+		cancelRebuild: connect.NewClient[qf.RebuildStatusRequest, qf.Void](
+			httpClient,
+			baseURL+QuickFeedServiceCancelRebuildProcedure,
+			connect.WithSchema(quickFeedServiceMethods.ByName("CancelRebuild")),
+			connect.WithClientOptions(opts...),
+		),
+		getRebuildSummaries: connect.NewClient[qf.CourseRequest, qf.RebuildSummaries](
+			httpClient,
+			baseURL+QuickFeedServiceGetRebuildSummariesProcedure,
+			connect.WithSchema(quickFeedServiceMethods.ByName("GetRebuildSummaries")),
+			connect.WithClientOptions(opts...),
+		),
+		getRebuildSummary: connect.NewClient[qf.RebuildStatusRequest, qf.RebuildSummary](
+			httpClient,
+			baseURL+QuickFeedServiceGetRebuildSummaryProcedure,
+			connect.WithSchema(quickFeedServiceMethods.ByName("GetRebuildSummary")),
+			connect.WithClientOptions(opts...),
+		),
+		getTestsHealth: connect.NewClient[qf.CourseRequest, qf.TestsHealth](
+			httpClient,
+			baseURL+QuickFeedServiceGetTestsHealthProcedure,
+			connect.WithSchema(quickFeedServiceMethods.ByName("GetTestsHealth")),
+			connect.WithClientOptions(opts...),
+		),
+		getFlakyTests: connect.NewClient[qf.FlakyTestsRequest, qf.FlakyTests](
+			httpClient,
+			baseURL+QuickFeedServiceGetFlakyTestsProcedure,
+			connect.WithSchema(quickFeedServiceMethods.ByName("GetFlakyTests")),
+			connect.WithClientOptions(opts...),
+		),
+		getLeaderboard: connect.NewClient[qf.LeaderboardRequest, qf.Leaderboard](
+			httpClient,
+			baseURL+QuickFeedServiceGetLeaderboardProcedure,
+			connect.WithSchema(quickFeedServiceMethods.ByName("GetLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+		getStaleSubmissions: connect.NewClient[qf.StaleSubmissionsRequest, qf.StaleSubmissions](
+			httpClient,
+			baseURL+QuickFeedServiceGetStaleSubmissionsProcedure,
+			connect.WithSchema(quickFeedServiceMethods.ByName("GetStaleSubmissions")),
+			connect.WithClientOptions(opts...),
+		),
+		getBuildLogArchive: connect.NewClient[qf.BuildLogArchiveRequest, qf.BuildLogArchive](
+			httpClient,
+			baseURL+QuickFeedServiceGetBuildLogArchiveProcedure,
+			connect.WithSchema(quickFeedServiceMethods.ByName("GetBuildLogArchive")),
+			connect.WithClientOptions(opts...),
+		),
 		createReview: connect.NewClient[qf.ReviewRequest, qf.Review](
 			httpClient,
 			baseURL+QuickFeedServiceCreateReviewProcedure,
@@ -345,6 +449,18 @@ func NewQuickFeedServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(quickFeedServiceMethods.ByName("SubmissionStream")),
 			connect.WithClientOptions(opts...),
 		),
+		rebuildStream: connect.NewClient[qf.RebuildStatusRequest, qf.RebuildProgress](
+			httpClient,
+			baseURL+QuickFeedServiceRebuildStreamProcedure,
+			connect.WithSchema(quickFeedServiceMethods.ByName("RebuildStream")),
+			connect.WithClientOptions(opts...),
+		),
+		buildLogStream: connect.NewClient[qf.BuildLogRequest, qf.BuildLog](
+			httpClient,
+			baseURL+QuickFeedServiceBuildLogStreamProcedure,
+			connect.WithSchema(quickFeedServiceMethods.ByName("BuildLogStream")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -371,7 +487,15 @@ type quickFeedServiceClient struct {
 	getSubmissions           *connect.Client[qf.SubmissionRequest, qf.Submissions]
 	getSubmissionsByCourse   *connect.Client[qf.SubmissionRequest, qf.CourseSubmissions]
 	updateSubmission         *connect.Client[qf.Grade, qf.Void]
-	rebuildSubmissions       *connect.Client[qf.RebuildRequest, qf.Void]
+	rebuildSubmissions       *connect.Client[qf.RebuildRequest, qf.Rebuild]
+	cancelRebuild            *connect.Client[qf.RebuildStatusRequest, qf.Void]
+	getRebuildSummaries      *connect.Client[qf.CourseRequest, qf.RebuildSummaries]
+	getRebuildSummary        *connect.Client[qf.RebuildStatusRequest, qf.RebuildSummary]
+	getTestsHealth           *connect.Client[qf.CourseRequest, qf.TestsHealth]
+	getFlakyTests            *connect.Client[qf.FlakyTestsRequest, qf.FlakyTests]
+	getLeaderboard           *connect.Client[qf.LeaderboardRequest, qf.Leaderboard]
+	getStaleSubmissions      *connect.Client[qf.StaleSubmissionsRequest, qf.StaleSubmissions]
+	getBuildLogArchive       *connect.Client[qf.BuildLogArchiveRequest, qf.BuildLogArchive]
 	createReview             *connect.Client[qf.ReviewRequest, qf.Review]
 	updateReview             *connect.Client[qf.ReviewRequest, qf.Review]
 	createAssignmentFeedback *connect.Client[qf.AssignmentFeedback, qf.Void]
@@ -379,6 +503,8 @@ type quickFeedServiceClient struct {
 	getRepositories          *connect.Client[qf.CourseRequest, qf.Repositories]
 	isEmptyRepo              *connect.Client[qf.RepositoryRequest, qf.Void]
 	submissionStream         *connect.Client[qf.Void, qf.Submission]
+	rebuildStream            *connect.Client[qf.RebuildStatusRequest, qf.RebuildProgress]
+	buildLogStream           *connect.Client[qf.BuildLogRequest, qf.BuildLog]
 }
 
 // GetUser calls qf.QuickFeedService.GetUser.
@@ -571,7 +697,7 @@ func (c *quickFeedServiceClient) UpdateSubmission(ctx context.Context, req *qf.G
 }
 
 // RebuildSubmissions calls qf.QuickFeedService.RebuildSubmissions.
-func (c *quickFeedServiceClient) RebuildSubmissions(ctx context.Context, req *qf.RebuildRequest) (*qf.Void, error) {
+func (c *quickFeedServiceClient) RebuildSubmissions(ctx context.Context, req *qf.RebuildRequest) (*qf.Rebuild, error) {
 	response, err := c.rebuildSubmissions.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
@@ -579,6 +705,78 @@ func (c *quickFeedServiceClient) RebuildSubmissions(ctx context.Context, req *qf
 	return nil, err
 }
 
+// CancelRebuild calls qf.QuickFeedService.CancelRebuild.
+func (c *quickFeedServiceClient) CancelRebuild(ctx context.Context, req *qf.RebuildStatusRequest) (*qf.Void, error) {
+	response, err := c.cancelRebuild.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetRebuildSummaries calls qf.QuickFeedService.GetRebuildSummaries.
+func (c *quickFeedServiceClient) GetRebuildSummaries(ctx context.Context, req *qf.CourseRequest) (*qf.RebuildSummaries, error) {
+	response, err := c.getRebuildSummaries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetRebuildSummary calls qf.QuickFeedService.GetRebuildSummary.
+func (c *quickFeedServiceClient) GetRebuildSummary(ctx context.Context, req *qf.RebuildStatusRequest) (*qf.RebuildSummary, error) {
+	response, err := c.getRebuildSummary.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetTestsHealth calls qf.QuickFeedService.GetTestsHealth.
+func (c *quickFeedServiceClient) GetTestsHealth(ctx context.Context, req *qf.CourseRequest) (*qf.TestsHealth, error) {
+	response, err := c.getTestsHealth.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetFlakyTests calls qf.QuickFeedService.GetFlakyTests.
+func (c *quickFeedServiceClient) GetFlakyTests(ctx context.Context, req *qf.FlakyTestsRequest) (*qf.FlakyTests, error) {
+	response, err := c.getFlakyTests.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetLeaderboard calls qf.QuickFeedService.GetLeaderboard.
+func (c *quickFeedServiceClient) GetLeaderboard(ctx context.Context, req *qf.LeaderboardRequest) (*qf.Leaderboard, error) {
+	response, err := c.getLeaderboard.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetStaleSubmissions calls qf.QuickFeedService.GetStaleSubmissions.
+func (c *quickFeedServiceClient) GetStaleSubmissions(ctx context.Context, req *qf.StaleSubmissionsRequest) (*qf.StaleSubmissions, error) {
+	response, err := c.getStaleSubmissions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetBuildLogArchive calls qf.QuickFeedService.GetBuildLogArchive.
+func (c *quickFeedServiceClient) GetBuildLogArchive(ctx context.Context, req *qf.BuildLogArchiveRequest) (*qf.BuildLogArchive, error) {
+	response, err := c.getBuildLogArchive.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // CreateReview calls qf.QuickFeedService.CreateReview.
 func (c *quickFeedServiceClient) CreateReview(ctx context.Context, req *qf.ReviewRequest) (*qf.Review, error) {
 	response, err := c.createReview.CallUnary(ctx, connect.NewRequest(req))
@@ -638,6 +836,16 @@ func (c *quickFeedServiceClient) SubmissionStream(ctx context.Context, req *qf.V
 	return c.submissionStream.CallServerStream(ctx, connect.NewRequest(req))
 }
 
+// RebuildStream calls qf.QuickFeedService.RebuildStream.
+func (c *quickFeedServiceClient) RebuildStream(ctx context.Context, req *qf.RebuildStatusRequest) (*connect.ServerStreamForClient[qf.RebuildProgress], error) {
+	return c.rebuildStream.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// BuildLogStream calls qf.QuickFeedService.BuildLogStream.
+func (c *quickFeedServiceClient) BuildLogStream(ctx context.Context, req *qf.BuildLogRequest) (*connect.ServerStreamForClient[qf.BuildLog], error) {
+	return c.buildLogStream.CallServerStream(ctx, connect.NewRequest(req))
+}
+
 // QuickFeedServiceHandler is an implementation of the qf.QuickFeedService service.
 type QuickFeedServiceHandler interface {
 	GetUser(context.Context, *qf.Void) (*qf.User, error)
@@ -666,7 +874,29 @@ type QuickFeedServiceHandler interface {
 	// UpdateSubmission updates the submission specified in the Grade message.
 	// If the Grade's UserID is zero, the grade is applied to all users associated with the submission.
 	UpdateSubmission(context.Context, *qf.Grade) (*qf.Void, error)
-	RebuildSubmissions(context.Context, *qf.RebuildRequest) (*qf.Void, error)
+	// RebuildSubmissions rebuilds a single submission, or all submissions for the assignment
+	// if no submission ID is given. Rebuilding all submissions returns immediately;
+	// use RebuildStream to follow the rebuild's progress.
+	RebuildSubmissions(context.Context, *qf.RebuildRequest) (*qf.Rebuild, error)
+	CancelRebuild(context.Context, *qf.RebuildStatusRequest) (*qf.Void, error)
+	// GetRebuildSummaries returns summaries of the course's most recent rebuilds,
+	// including how the rebuilds changed the scores of the rebuilt submissions.
+	GetRebuildSummaries(context.Context, *qf.CourseRequest) (*qf.RebuildSummaries, error)
+	// GetRebuildSummary returns the summary of the given rebuild, e.g., the report of a dry run.
+	GetRebuildSummary(context.Context, *qf.RebuildStatusRequest) (*qf.RebuildSummary, error)
+	// GetTestsHealth returns the results of the most recent runs of the course's tests
+	// against the reference solution, one for each assignment.
+	GetTestsHealth(context.Context, *qf.CourseRequest) (*qf.TestsHealth, error)
+	// GetFlakyTests returns the tests of the given assignment that passed only when rerun,
+	// aggregated over the assignment's recorded submissions.
+	GetFlakyTests(context.Context, *qf.FlakyTestsRequest) (*qf.FlakyTests, error)
+	// GetLeaderboard ranks the latest submissions for an assignment by a benchmark metric of one of its tests.
+	// Students may only view the leaderboards of courses that have enabled leaderboards.
+	GetLeaderboard(context.Context, *qf.LeaderboardRequest) (*qf.Leaderboard, error)
+	// GetStaleSubmissions returns the submissions graded with another revision of the tests repository.
+	GetStaleSubmissions(context.Context, *qf.StaleSubmissionsRequest) (*qf.StaleSubmissions, error)
+	// GetBuildLogArchive returns the complete, untruncated build log of a submission's test run.
+	GetBuildLogArchive(context.Context, *qf.BuildLogArchiveRequest) (*qf.BuildLogArchive, error)
 	CreateReview(context.Context, *qf.ReviewRequest) (*qf.Review, error)
 	UpdateReview(context.Context, *qf.ReviewRequest) (*qf.Review, error)
 	CreateAssignmentFeedback(context.Context, *qf.AssignmentFeedback) (*qf.Void, error)
@@ -674,6 +904,10 @@ type QuickFeedServiceHandler interface {
 	GetRepositories(context.Context, *qf.CourseRequest) (*qf.Repositories, error)
 	IsEmptyRepo(context.Context, *qf.RepositoryRequest) (*qf.Void, error)
 	SubmissionStream(context.Context, *qf.Void, *connect.ServerStream[qf.Submission]) error
+	RebuildStream(context.Context, *qf.RebuildStatusRequest, *connect.ServerStream[qf.RebuildProgress]) error
+	// BuildLogStream sends the output of the user's or group's test run for the given assignment,
+	// while the tests are running. The stream is closed when the test run is finished.
+	BuildLogStream(context.Context, *qf.BuildLogRequest, *connect.ServerStream[qf.BuildLog]) error
 }
 
 // NewQuickFeedServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -815,6 +1049,54 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 		connect.WithSchema(quickFeedServiceMethods.ByName("RebuildSubmissions")),
 		connect.WithHandlerOptions(opts...),
 	)
+	quickFeedServiceCancelRebuildHandler := connect.NewUnaryHandlerSimple(
+		QuickFeedServiceCancelRebuildProcedure,
+		svc.CancelRebuild,
+		connect.WithSchema(quickFeedServiceMethods.ByName("CancelRebuild")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetRebuildSummariesHandler := connect.NewUnaryHandlerSimple(
+		QuickFeedServiceGetRebuildSummariesProcedure,
+		svc.GetRebuildSummaries,
+		connect.WithSchema(quickFeedServiceMethods.ByName("GetRebuildSummaries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetRebuildSummaryHandler := connect.NewUnaryHandlerSimple(
+		QuickFeedServiceGetRebuildSummaryProcedure,
+		svc.GetRebuildSummary,
+		connect.WithSchema(quickFeedServiceMethods.ByName("GetRebuildSummary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetTestsHealthHandler := connect.NewUnaryHandlerSimple(
+		QuickFeedServiceGetTestsHealthProcedure,
+		svc.GetTestsHealth,
+		connect.WithSchema(quickFeedServiceMethods.ByName("GetTestsHealth")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetFlakyTestsHandler := connect.NewUnaryHandlerSimple(
+		QuickFeedServiceGetFlakyTestsProcedure,
+		svc.GetFlakyTests,
+		connect.WithSchema(quickFeedServiceMethods.ByName("GetFlakyTests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetLeaderboardHandler := connect.NewUnaryHandlerSimple(
+		QuickFeedServiceGetLeaderboardProcedure,
+		svc.GetLeaderboard,
+		connect.WithSchema(quickFeedServiceMethods.ByName("GetLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetStaleSubmissionsHandler := connect.NewUnaryHandlerSimple(
+		QuickFeedServiceGetStaleSubmissionsProcedure,
+		svc.GetStaleSubmissions,
+		connect.WithSchema(quickFeedServiceMethods.ByName("GetStaleSubmissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetBuildLogArchiveHandler := connect.NewUnaryHandlerSimple(
+		QuickFeedServiceGetBuildLogArchiveProcedure,
+		svc.GetBuildLogArchive,
+		connect.WithSchema(quickFeedServiceMethods.ByName("GetBuildLogArchive")),
+		connect.WithHandlerOptions(opts...),
+	)
 	quickFeedServiceCreateReviewHandler := connect.NewUnaryHandlerSimple(
 		QuickFeedServiceCreateReviewProcedure,
 		svc.CreateReview,
@@ -857,6 +1139,18 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 		connect.WithSchema(quickFeedServiceMethods.ByName("SubmissionStream")),
 		connect.WithHandlerOptions(opts...),
 	)
+	quickFeedServiceRebuildStreamHandler := connect.NewServerStreamHandlerSimple(
+		QuickFeedServiceRebuildStreamProcedure,
+		svc.RebuildStream,
+		connect.WithSchema(quickFeedServiceMethods.ByName("RebuildStream")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceBuildLogStreamHandler := connect.NewServerStreamHandlerSimple(
+		QuickFeedServiceBuildLogStreamProcedure,
+		svc.BuildLogStream,
+		connect.WithSchema(quickFeedServiceMethods.ByName("BuildLogStream")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/qf.QuickFeedService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case QuickFeedServiceGetUserProcedure:
@@ -903,6 +1197,22 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 			quickFeedServiceUpdateSubmissionHandler.ServeHTTP(w, r)
 		case QuickFeedServiceRebuildSubmissionsProcedure:
 			quickFeedServiceRebuildSubmissionsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceCancelRebuildProcedure:
+			quickFeedServiceCancelRebuildHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetRebuildSummariesProcedure:
+			quickFeedServiceGetRebuildSummariesHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetRebuildSummaryProcedure:
+			quickFeedServiceGetRebuildSummaryHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetTestsHealthProcedure:
+			quickFeedServiceGetTestsHealthHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetFlakyTestsProcedure:
+			quickFeedServiceGetFlakyTestsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetLeaderboardProcedure:
+			quickFeedServiceGetLeaderboardHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetStaleSubmissionsProcedure:
+			quickFeedServiceGetStaleSubmissionsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetBuildLogArchiveProcedure:
+			quickFeedServiceGetBuildLogArchiveHandler.ServeHTTP(w, r)
 		case QuickFeedServiceCreateReviewProcedure:
 			quickFeedServiceCreateReviewHandler.ServeHTTP(w, r)
 		case QuickFeedServiceUpdateReviewProcedure:
@@ -917,6 +1227,10 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 			quickFeedServiceIsEmptyRepoHandler.ServeHTTP(w, r)
 		case QuickFeedServiceSubmissionStreamProcedure:
 			quickFeedServiceSubmissionStreamHandler.ServeHTTP(w, r)
+		case QuickFeedServiceRebuildStreamProcedure:
+			quickFeedServiceRebuildStreamHandler.ServeHTTP(w, r)
+		case QuickFeedServiceBuildLogStreamProcedure:
+			quickFeedServiceBuildLogStreamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1010,10 +1324,42 @@ func (UnimplementedQuickFeedServiceHandler) UpdateSubmission(context.Context, *q
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UpdateSubmission is not implemented"))
 }
 
-func (UnimplementedQuickFeedServiceHandler) RebuildSubmissions(context.Context, *qf.RebuildRequest) (*qf.Void, error) {
+func (UnimplementedQuickFeedServiceHandler) RebuildSubmissions(context.Context, *qf.RebuildRequest) (*qf.Rebuild, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.RebuildSubmissions is not implemented"))
 }
 
+func (UnimplementedQuickFeedServiceHandler) CancelRebuild(context.Context, *qf.RebuildStatusRequest) (*qf.Void, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.CancelRebuild is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetRebuildSummaries(context.Context, *qf.CourseRequest) (*qf.RebuildSummaries, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetRebuildSummaries is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetRebuildSummary(context.Context, *qf.RebuildStatusRequest) (*qf.RebuildSummary, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetRebuildSummary is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetTestsHealth(context.Context, *qf.CourseRequest) (*qf.TestsHealth, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetTestsHealth is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetFlakyTests(context.Context, *qf.FlakyTestsRequest) (*qf.FlakyTests, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetFlakyTests is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetLeaderboard(context.Context, *qf.LeaderboardRequest) (*qf.Leaderboard, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetLeaderboard is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetStaleSubmissions(context.Context, *qf.StaleSubmissionsRequest) (*qf.StaleSubmissions, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetStaleSubmissions is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetBuildLogArchive(context.Context, *qf.BuildLogArchiveRequest) (*qf.BuildLogArchive, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetBuildLogArchive is not implemented"))
+}
+
 func (UnimplementedQuickFeedServiceHandler) CreateReview(context.Context, *qf.ReviewRequest) (*qf.Review, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.CreateReview is not implemented"))
 }
@@ -1041,3 +1387,11 @@ func (UnimplementedQuickFeedServiceHandler) IsEmptyRepo(context.Context, *qf.Rep
 func (UnimplementedQuickFeedServiceHandler) SubmissionStream(context.Context, *qf.Void, *connect.ServerStream[qf.Submission]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.SubmissionStream is not implemented"))
 }
+
+func (UnimplementedQuickFeedServiceHandler) RebuildStream(context.Context, *qf.RebuildStatusRequest, *connect.ServerStream[qf.RebuildProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.RebuildStream is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) BuildLogStream(context.Context, *qf.BuildLogRequest, *connect.ServerStream[qf.BuildLog]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.BuildLogStream is not implemented"))
+}
@@ -24,7 +24,7 @@ var File_qf_quickfeed_proto protoreflect.FileDescriptor
 
 const file_qf_quickfeed_proto_rawDesc = "" +
 	"\n" +
-	"\x12qf/quickfeed.proto\x12\x02qf\x1a\x0eqf/types.proto\x1a\x11qf/requests.proto2\xbb\v\n" +
+	"\x12qf/quickfeed.proto\x12\x02qf\x1a\x0eqf/types.proto\x1a\x11qf/requests.proto2\xbd\x10\n" +
 	"\x10QuickFeedService\x12\x1f\n" +
 	"\aGetUser\x12\b.qf.Void\x1a\b.qf.User\"\x00\x12!\n" +
 	"\bGetUsers\x12\b.qf.Void\x1a\t.qf.Users\"\x00\x12\"\n" +
@@ -51,8 +51,16 @@ const file_qf_quickfeed_proto_rawDesc = "" +
 	"\rGetSubmission\x12\x15.qf.SubmissionRequest\x1a\x0e.qf.Submission\"\x00\x12:\n" +
 	"\x0eGetSubmissions\x12\x15.qf.SubmissionRequest\x1a\x0f.qf.Submissions\"\x00\x12H\n" +
 	"\x16GetSubmissionsByCourse\x12\x15.qf.SubmissionRequest\x1a\x15.qf.CourseSubmissions\"\x00\x12)\n" +
-	"\x10UpdateSubmission\x12\t.qf.Grade\x1a\b.qf.Void\"\x00\x124\n" +
-	"\x12RebuildSubmissions\x12\x12.qf.RebuildRequest\x1a\b.qf.Void\"\x00\x12/\n" +
+	"\x10UpdateSubmission\x12\t.qf.Grade\x1a\b.qf.Void\"\x00\x127\n" +
+	"\x12RebuildSubmissions\x12\x12.qf.RebuildRequest\x1a\v.qf.Rebuild\"\x00\x125\n" +
+	"\rCancelRebuild\x12\x18.qf.RebuildStatusRequest\x1a\b.qf.Void\"\x00\x12@\n" +
+	"\x13GetRebuildSummaries\x12\x11.qf.CourseRequest\x1a\x14.qf.RebuildSummaries\"\x00\x12C\n" +
+	"\x11GetRebuildSummary\x12\x18.qf.RebuildStatusRequest\x1a\x12.qf.RebuildSummary\"\x00\x126\n" +
+	"\x0eGetTestsHealth\x12\x11.qf.CourseRequest\x1a\x0f.qf.TestsHealth\"\x00\x128\n" +
+	"\rGetFlakyTests\x12\x15.qf.FlakyTestsRequest\x1a\x0e.qf.FlakyTests\"\x00\x12;\n" +
+	"\x0eGetLeaderboard\x12\x16.qf.LeaderboardRequest\x1a\x0f.qf.Leaderboard\"\x00\x12J\n" +
+	"\x13GetStaleSubmissions\x12\x1b.qf.StaleSubmissionsRequest\x1a\x14.qf.StaleSubmissions\"\x00\x12G\n" +
+	"\x12GetBuildLogArchive\x12\x1a.qf.BuildLogArchiveRequest\x1a\x13.qf.BuildLogArchive\"\x00\x12/\n" +
 	"\fCreateReview\x12\x11.qf.ReviewRequest\x1a\n" +
 	".qf.Review\"\x00\x12/\n" +
 	"\fUpdateReview\x12\x11.qf.ReviewRequest\x1a\n" +
@@ -61,34 +69,52 @@ const file_qf_quickfeed_proto_rawDesc = "" +
 	"\x15GetAssignmentFeedback\x12\x11.qf.CourseRequest\x1a\x17.qf.AssignmentFeedbacks\"\x00\x128\n" +
 	"\x0fGetRepositories\x12\x11.qf.CourseRequest\x1a\x10.qf.Repositories\"\x00\x120\n" +
 	"\vIsEmptyRepo\x12\x15.qf.RepositoryRequest\x1a\b.qf.Void\"\x00\x120\n" +
-	"\x10SubmissionStream\x12\b.qf.Void\x1a\x0e.qf.Submission\"\x000\x01B&Z!github.com/quickfeed/quickfeed/qf\xba\x02\x00b\x06proto3"
+	"\x10SubmissionStream\x12\b.qf.Void\x1a\x0e.qf.Submission\"\x000\x01\x12B\n" +
+	"\rRebuildStream\x12\x18.qf.RebuildStatusRequest\x1a\x13.qf.RebuildProgress\"\x000\x01\x127\n" +
+	"\x0eBuildLogStream\x12\x13.qf.BuildLogRequest\x1a\f.qf.BuildLog\"\x000\x01B&Z!github.com/quickfeed/quickfeed/qf\xba\x02\x00b\x06proto3"
 
 var file_qf_quickfeed_proto_goTypes = []any{
-	(*Void)(nil),                // 0: qf.Void
-	(*User)(nil),                // 1: qf.User
-	(*GroupRequest)(nil),        // 2: qf.GroupRequest
-	(*CourseRequest)(nil),       // 3: qf.CourseRequest
-	(*Group)(nil),               // 4: qf.Group
-	(*Course)(nil),              // 5: qf.Course
-	(*Enrollment)(nil),          // 6: qf.Enrollment
-	(*EnrollmentRequest)(nil),   // 7: qf.EnrollmentRequest
-	(*Enrollments)(nil),         // 8: qf.Enrollments
-	(*SubmissionRequest)(nil),   // 9: qf.SubmissionRequest
-	(*Grade)(nil),               // 10: qf.Grade
-	(*RebuildRequest)(nil),      // 11: qf.RebuildRequest
-	(*ReviewRequest)(nil),       // 12: qf.ReviewRequest
-	(*AssignmentFeedback)(nil),  // 13: qf.AssignmentFeedback
-	(*RepositoryRequest)(nil),   // 14: qf.RepositoryRequest
-	(*Users)(nil),               // 15: qf.Users
-	(*Groups)(nil),              // 16: qf.Groups
-	(*Courses)(nil),             // 17: qf.Courses
-	(*Assignments)(nil),         // 18: qf.Assignments
-	(*Submission)(nil),          // 19: qf.Submission
-	(*Submissions)(nil),         // 20: qf.Submissions
-	(*CourseSubmissions)(nil),   // 21: qf.CourseSubmissions
-	(*Review)(nil),              // 22: qf.Review
-	(*AssignmentFeedbacks)(nil), // 23: qf.AssignmentFeedbacks
-	(*Repositories)(nil),        // 24: qf.Repositories
+	(*Void)(nil),                    // 0: qf.Void
+	(*User)(nil),                    // 1: qf.User
+	(*GroupRequest)(nil),            // 2: qf.GroupRequest
+	(*CourseRequest)(nil),           // 3: qf.CourseRequest
+	(*Group)(nil),                   // 4: qf.Group
+	(*Course)(nil),                  // 5: qf.Course
+	(*Enrollment)(nil),              // 6: qf.Enrollment
+	(*EnrollmentRequest)(nil),       // 7: qf.EnrollmentRequest
+	(*Enrollments)(nil),             // 8: qf.Enrollments
+	(*SubmissionRequest)(nil),       // 9: qf.SubmissionRequest
+	(*Grade)(nil),                   // 10: qf.Grade
+	(*RebuildRequest)(nil),          // 11: qf.RebuildRequest
+	(*RebuildStatusRequest)(nil),    // 12: qf.RebuildStatusRequest
+	(*FlakyTestsRequest)(nil),       // 13: qf.FlakyTestsRequest
+	(*LeaderboardRequest)(nil),      // 14: qf.LeaderboardRequest
+	(*StaleSubmissionsRequest)(nil), // 15: qf.StaleSubmissionsRequest
+	(*BuildLogArchiveRequest)(nil),  // 16: qf.BuildLogArchiveRequest
+	(*ReviewRequest)(nil),           // 17: qf.ReviewRequest
+	(*AssignmentFeedback)(nil),      // 18: qf.AssignmentFeedback
+	(*RepositoryRequest)(nil),       // 19: qf.RepositoryRequest
+	(*BuildLogRequest)(nil),         // 20: qf.BuildLogRequest
+	(*Users)(nil),                   // 21: qf.Users
+	(*Groups)(nil),                  // 22: qf.Groups
+	(*Courses)(nil),                 // 23: qf.Courses
+	(*Assignments)(nil),             // 24: qf.Assignments
+	(*Submission)(nil),              // 25: qf.Submission
+	(*Submissions)(nil),             // 26: qf.Submissions
+	(*CourseSubmissions)(nil),       // 27: qf.CourseSubmissions
+	(*Rebuild)(nil),                 // 28: qf.Rebuild
+	(*RebuildSummaries)(nil),        // 29: qf.RebuildSummaries
+	(*RebuildSummary)(nil),          // 30: qf.RebuildSummary
+	(*TestsHealth)(nil),             // 31: qf.TestsHealth
+	(*FlakyTests)(nil),              // 32: qf.FlakyTests
+	(*Leaderboard)(nil),             // 33: qf.Leaderboard
+	(*StaleSubmissions)(nil),        // 34: qf.StaleSubmissions
+	(*BuildLogArchive)(nil),         // 35: qf.BuildLogArchive
+	(*Review)(nil),                  // 36: qf.Review
+	(*AssignmentFeedbacks)(nil),     // 37: qf.AssignmentFeedbacks
+	(*Repositories)(nil),            // 38: qf.Repositories
+	(*RebuildProgress)(nil),         // 39: qf.RebuildProgress
+	(*BuildLog)(nil),                // 40: qf.BuildLog
 }
 var file_qf_quickfeed_proto_depIdxs = []int32{
 	0,  // 0: qf.QuickFeedService.GetUser:input_type -> qf.Void
@@ -113,44 +139,64 @@ var file_qf_quickfeed_proto_depIdxs = []int32{
 	9,  // 19: qf.QuickFeedService.GetSubmissionsByCourse:input_type -> qf.SubmissionRequest
 	10, // 20: qf.QuickFeedService.UpdateSubmission:input_type -> qf.Grade
 	11, // 21: qf.QuickFeedService.RebuildSubmissions:input_type -> qf.RebuildRequest
-	12, // 22: qf.QuickFeedService.CreateReview:input_type -> qf.ReviewRequest
-	12, // 23: qf.QuickFeedService.UpdateReview:input_type -> qf.ReviewRequest
-	13, // 24: qf.QuickFeedService.CreateAssignmentFeedback:input_type -> qf.AssignmentFeedback
-	3,  // 25: qf.QuickFeedService.GetAssignmentFeedback:input_type -> qf.CourseRequest
-	3,  // 26: qf.QuickFeedService.GetRepositories:input_type -> qf.CourseRequest
-	14, // 27: qf.QuickFeedService.IsEmptyRepo:input_type -> qf.RepositoryRequest
-	0,  // 28: qf.QuickFeedService.SubmissionStream:input_type -> qf.Void
-	1,  // 29: qf.QuickFeedService.GetUser:output_type -> qf.User
-	15, // 30: qf.QuickFeedService.GetUsers:output_type -> qf.Users
-	0,  // 31: qf.QuickFeedService.UpdateUser:output_type -> qf.Void
-	4,  // 32: qf.QuickFeedService.GetGroup:output_type -> qf.Group
-	16, // 33: qf.QuickFeedService.GetGroupsByCourse:output_type -> qf.Groups
-	4,  // 34: qf.QuickFeedService.CreateGroup:output_type -> qf.Group
-	4,  // 35: qf.QuickFeedService.UpdateGroup:output_type -> qf.Group
-	0,  // 36: qf.QuickFeedService.DeleteGroup:output_type -> qf.Void
-	5,  // 37: qf.QuickFeedService.GetCourse:output_type -> qf.Course
-	17, // 38: qf.QuickFeedService.GetCourses:output_type -> qf.Courses
-	0,  // 39: qf.QuickFeedService.UpdateCourse:output_type -> qf.Void
-	0,  // 40: qf.QuickFeedService.UpdateCourseVisibility:output_type -> qf.Void
-	18, // 41: qf.QuickFeedService.GetAssignments:output_type -> qf.Assignments
-	0,  // 42: qf.QuickFeedService.UpdateAssignments:output_type -> qf.Void
-	8,  // 43: qf.QuickFeedService.GetEnrollments:output_type -> qf.Enrollments
-	0,  // 44: qf.QuickFeedService.CreateEnrollment:output_type -> qf.Void
-	0,  // 45: qf.QuickFeedService.UpdateEnrollments:output_type -> qf.Void
-	19, // 46: qf.QuickFeedService.GetSubmission:output_type -> qf.Submission
-	20, // 47: qf.QuickFeedService.GetSubmissions:output_type -> qf.Submissions
-	21, // 48: qf.QuickFeedService.GetSubmissionsByCourse:output_type -> qf.CourseSubmissions
-	0,  // 49: qf.QuickFeedService.UpdateSubmission:output_type -> qf.Void
-	0,  // 50: qf.QuickFeedService.RebuildSubmissions:output_type -> qf.Void
-	22, // 51: qf.QuickFeedService.CreateReview:output_type -> qf.Review
-	22, // 52: qf.QuickFeedService.UpdateReview:output_type -> qf.Review
-	0,  // 53: qf.QuickFeedService.CreateAssignmentFeedback:output_type -> qf.Void
-	23, // 54: qf.QuickFeedService.GetAssignmentFeedback:output_type -> qf.AssignmentFeedbacks
-	24, // 55: qf.QuickFeedService.GetRepositories:output_type -> qf.Repositories
-	0,  // 56: qf.QuickFeedService.IsEmptyRepo:output_type -> qf.Void
-	19, // 57: qf.QuickFeedService.SubmissionStream:output_type -> qf.Submission
-	29, // [29:58] is the sub-list for method output_type
-	0,  // [0:29] is the sub-list for method input_type
+	12, // 22: qf.QuickFeedService.CancelRebuild:input_type -> qf.RebuildStatusRequest
+	3,  // 23: qf.QuickFeedService.GetRebuildSummaries:input_type -> qf.CourseRequest
+	12, // 24: qf.QuickFeedService.GetRebuildSummary:input_type -> qf.RebuildStatusRequest
+	3,  // 25: qf.QuickFeedService.GetTestsHealth:input_type -> qf.CourseRequest
+	13, // 26: qf.QuickFeedService.GetFlakyTests:input_type -> qf.FlakyTestsRequest
+	14, // 27: qf.QuickFeedService.GetLeaderboard:input_type -> qf.LeaderboardRequest
+	15, // 28: qf.QuickFeedService.GetStaleSubmissions:input_type -> qf.StaleSubmissionsRequest
+	16, // 29: qf.QuickFeedService.GetBuildLogArchive:input_type -> qf.BuildLogArchiveRequest
+	17, // 30: qf.QuickFeedService.CreateReview:input_type -> qf.ReviewRequest
+	17, // 31: qf.QuickFeedService.UpdateReview:input_type -> qf.ReviewRequest
+	18, // 32: qf.QuickFeedService.CreateAssignmentFeedback:input_type -> qf.AssignmentFeedback
+	3,  // 33: qf.QuickFeedService.GetAssignmentFeedback:input_type -> qf.CourseRequest
+	3,  // 34: qf.QuickFeedService.GetRepositories:input_type -> qf.CourseRequest
+	19, // 35: qf.QuickFeedService.IsEmptyRepo:input_type -> qf.RepositoryRequest
+	0,  // 36: qf.QuickFeedService.SubmissionStream:input_type -> qf.Void
+	12, // 37: qf.QuickFeedService.RebuildStream:input_type -> qf.RebuildStatusRequest
+	20, // 38: qf.QuickFeedService.BuildLogStream:input_type -> qf.BuildLogRequest
+	1,  // 39: qf.QuickFeedService.GetUser:output_type -> qf.User
+	21, // 40: qf.QuickFeedService.GetUsers:output_type -> qf.Users
+	0,  // 41: qf.QuickFeedService.UpdateUser:output_type -> qf.Void
+	4,  // 42: qf.QuickFeedService.GetGroup:output_type -> qf.Group
+	22, // 43: qf.QuickFeedService.GetGroupsByCourse:output_type -> qf.Groups
+	4,  // 44: qf.QuickFeedService.CreateGroup:output_type -> qf.Group
+	4,  // 45: qf.QuickFeedService.UpdateGroup:output_type -> qf.Group
+	0,  // 46: qf.QuickFeedService.DeleteGroup:output_type -> qf.Void
+	5,  // 47: qf.QuickFeedService.GetCourse:output_type -> qf.Course
+	23, // 48: qf.QuickFeedService.GetCourses:output_type -> qf.Courses
+	0,  // 49: qf.QuickFeedService.UpdateCourse:output_type -> qf.Void
+	0,  // 50: qf.QuickFeedService.UpdateCourseVisibility:output_type -> qf.Void
+	24, // 51: qf.QuickFeedService.GetAssignments:output_type -> qf.Assignments
+	0,  // 52: qf.QuickFeedService.UpdateAssignments:output_type -> qf.Void
+	8,  // 53: qf.QuickFeedService.GetEnrollments:output_type -> qf.Enrollments
+	0,  // 54: qf.QuickFeedService.CreateEnrollment:output_type -> qf.Void
+	0,  // 55: qf.QuickFeedService.UpdateEnrollments:output_type -> qf.Void
+	25, // 56: qf.QuickFeedService.GetSubmission:output_type -> qf.Submission
+	26, // 57: qf.QuickFeedService.GetSubmissions:output_type -> qf.Submissions
+	27, // 58: qf.QuickFeedService.GetSubmissionsByCourse:output_type -> qf.CourseSubmissions
+	0,  // 59: qf.QuickFeedService.UpdateSubmission:output_type -> qf.Void
+	28, // 60: qf.QuickFeedService.RebuildSubmissions:output_type -> qf.Rebuild
+	0,  // 61: qf.QuickFeedService.CancelRebuild:output_type -> qf.Void
+	29, // 62: qf.QuickFeedService.GetRebuildSummaries:output_type -> qf.RebuildSummaries
+	30, // 63: qf.QuickFeedService.GetRebuildSummary:output_type -> qf.RebuildSummary
+	31, // 64: qf.QuickFeedService.GetTestsHealth:output_type -> qf.TestsHealth
+	32, // 65: qf.QuickFeedService.GetFlakyTests:output_type -> qf.FlakyTests
+	33, // 66: qf.QuickFeedService.GetLeaderboard:output_type -> qf.Leaderboard
+	34, // 67: qf.QuickFeedService.GetStaleSubmissions:output_type -> qf.StaleSubmissions
+	35, // 68: qf.QuickFeedService.GetBuildLogArchive:output_type -> qf.BuildLogArchive
+	36, // 69: qf.QuickFeedService.CreateReview:output_type -> qf.Review
+	36, // 70: qf.QuickFeedService.UpdateReview:output_type -> qf.Review
+	0,  // 71: qf.QuickFeedService.CreateAssignmentFeedback:output_type -> qf.Void
+	37, // 72: qf.QuickFeedService.GetAssignmentFeedback:output_type -> qf.AssignmentFeedbacks
+	38, // 73: qf.QuickFeedService.GetRepositories:output_type -> qf.Repositories
+	0,  // 74: qf.QuickFeedService.IsEmptyRepo:output_type -> qf.Void
+	25, // 75: qf.QuickFeedService.SubmissionStream:output_type -> qf.Submission
+	39, // 76: qf.QuickFeedService.RebuildStream:output_type -> qf.RebuildProgress
+	40, // 77: qf.QuickFeedService.BuildLogStream:output_type -> qf.BuildLog
+	39, // [39:78] is the sub-list for method output_type
+	0,  // [0:39] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -70,6 +70,58 @@ func (SubmissionRequest_SubmissionType) EnumDescriptor() ([]byte, []int) {
 	return file_qf_requests_proto_rawDescGZIP(), []int{6, 0}
 }
 
+type LeaderboardRequest_Metric int32
+
+const (
+	LeaderboardRequest_NS_PER_OP     LeaderboardRequest_Metric = 0 // nanoseconds per operation
+	LeaderboardRequest_ALLOCS_PER_OP LeaderboardRequest_Metric = 1 // allocations per operation
+	LeaderboardRequest_BYTES_PER_OP  LeaderboardRequest_Metric = 2 // bytes allocated per operation
+	LeaderboardRequest_EXEC_TIME     LeaderboardRequest_Metric = 3 // execution time of the test
+)
+
+// Enum value maps for LeaderboardRequest_Metric.
+var (
+	LeaderboardRequest_Metric_name = map[int32]string{
+		0: "NS_PER_OP",
+		1: "ALLOCS_PER_OP",
+		2: "BYTES_PER_OP",
+		3: "EXEC_TIME",
+	}
+	LeaderboardRequest_Metric_value = map[string]int32{
+		"NS_PER_OP":     0,
+		"ALLOCS_PER_OP": 1,
+		"BYTES_PER_OP":  2,
+		"EXEC_TIME":     3,
+	}
+)
+
+func (x LeaderboardRequest_Metric) Enum() *LeaderboardRequest_Metric {
+	p := new(LeaderboardRequest_Metric)
+	*p = x
+	return p
+}
+
+func (x LeaderboardRequest_Metric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardRequest_Metric) Descriptor() protoreflect.EnumDescriptor {
+	return file_qf_requests_proto_enumTypes[1].Descriptor()
+}
+
+func (LeaderboardRequest_Metric) Type() protoreflect.EnumType {
+	return &file_qf_requests_proto_enumTypes[1]
+}
+
+func (x LeaderboardRequest_Metric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardRequest_Metric.Descriptor instead.
+func (LeaderboardRequest_Metric) EnumDescriptor() ([]byte, []int) {
+	return file_qf_requests_proto_rawDescGZIP(), []int{12, 0}
+}
+
 type CourseSubmissions struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Submissions   map[uint64]*Submissions `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -652,6 +704,10 @@ type RebuildRequest struct {
 	CourseID      uint64                 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
 	AssignmentID  uint64                 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
 	SubmissionID  uint64                 `protobuf:"varint,3,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	Force         bool                   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`            // run the tests even if results for the same commit and tests are cached
+	Stale         bool                   `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`            // only rebuild submissions graded with another revision of the tests repository
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dryRun,proto3" json:"dryRun,omitempty"`          // run the tests without recording the results; reported by GetRebuildSummary
+	TestsBranch   string                 `protobuf:"bytes,7,opt,name=testsBranch,proto3" json:"testsBranch,omitempty"` // branch of the tests repository to run in a dry run; empty for the current tests
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -707,6 +763,393 @@ func (x *RebuildRequest) GetSubmissionID() uint64 {
 	return 0
 }
 
+func (x *RebuildRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *RebuildRequest) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *RebuildRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RebuildRequest) GetTestsBranch() string {
+	if x != nil {
+		return x.TestsBranch
+	}
+	return ""
+}
+
+// StaleSubmissionsRequest selects the submissions for an assignment that were graded
+// with another revision of the tests repository than the current revision.
+type StaleSubmissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseID      uint64                 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID  uint64                 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaleSubmissionsRequest) Reset() {
+	*x = StaleSubmissionsRequest{}
+	mi := &file_qf_requests_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleSubmissionsRequest) ProtoMessage() {}
+
+func (x *StaleSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_requests_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*StaleSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_qf_requests_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StaleSubmissionsRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *StaleSubmissionsRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+// FlakyTestsRequest selects the assignment whose flaky tests to report.
+type FlakyTestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseID      uint64                 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID  uint64                 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlakyTestsRequest) Reset() {
+	*x = FlakyTestsRequest{}
+	mi := &file_qf_requests_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlakyTestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlakyTestsRequest) ProtoMessage() {}
+
+func (x *FlakyTestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_requests_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlakyTestsRequest.ProtoReflect.Descriptor instead.
+func (*FlakyTestsRequest) Descriptor() ([]byte, []int) {
+	return file_qf_requests_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FlakyTestsRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *FlakyTestsRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+// LeaderboardRequest selects the benchmark test and metric by which to rank an assignment's submissions.
+type LeaderboardRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	CourseID      uint64                    `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID  uint64                    `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	TestName      string                    `protobuf:"bytes,3,opt,name=testName,proto3" json:"testName,omitempty"`
+	Metric        LeaderboardRequest_Metric `protobuf:"varint,4,opt,name=metric,proto3,enum=qf.LeaderboardRequest_Metric" json:"metric,omitempty"` // lower values rank higher
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardRequest) Reset() {
+	*x = LeaderboardRequest{}
+	mi := &file_qf_requests_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardRequest) ProtoMessage() {}
+
+func (x *LeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_requests_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_qf_requests_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LeaderboardRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *LeaderboardRequest) GetTestName() string {
+	if x != nil {
+		return x.TestName
+	}
+	return ""
+}
+
+func (x *LeaderboardRequest) GetMetric() LeaderboardRequest_Metric {
+	if x != nil {
+		return x.Metric
+	}
+	return LeaderboardRequest_NS_PER_OP
+}
+
+type RebuildStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseID      uint64                 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	RebuildID     uint64                 `protobuf:"varint,2,opt,name=rebuildID,proto3" json:"rebuildID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildStatusRequest) Reset() {
+	*x = RebuildStatusRequest{}
+	mi := &file_qf_requests_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildStatusRequest) ProtoMessage() {}
+
+func (x *RebuildStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_requests_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildStatusRequest.ProtoReflect.Descriptor instead.
+func (*RebuildStatusRequest) Descriptor() ([]byte, []int) {
+	return file_qf_requests_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RebuildStatusRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *RebuildStatusRequest) GetRebuildID() uint64 {
+	if x != nil {
+		return x.RebuildID
+	}
+	return 0
+}
+
+// BuildLogRequest selects the user's or group's test run to follow; the group ID takes precedence.
+type BuildLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseID      uint64                 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	AssignmentID  uint64                 `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	UserID        uint64                 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID       uint64                 `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildLogRequest) Reset() {
+	*x = BuildLogRequest{}
+	mi := &file_qf_requests_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildLogRequest) ProtoMessage() {}
+
+func (x *BuildLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_requests_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildLogRequest.ProtoReflect.Descriptor instead.
+func (*BuildLogRequest) Descriptor() ([]byte, []int) {
+	return file_qf_requests_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BuildLogRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *BuildLogRequest) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *BuildLogRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *BuildLogRequest) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+// BuildLogArchiveRequest selects the archived build log of a submission's test run.
+// If no commit ID is given, the most recently archived log for the submission is selected.
+type BuildLogArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseID      uint64                 `protobuf:"varint,1,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	SubmissionID  uint64                 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CommitID      string                 `protobuf:"bytes,3,opt,name=commitID,proto3" json:"commitID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildLogArchiveRequest) Reset() {
+	*x = BuildLogArchiveRequest{}
+	mi := &file_qf_requests_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildLogArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildLogArchiveRequest) ProtoMessage() {}
+
+func (x *BuildLogArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_requests_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildLogArchiveRequest.ProtoReflect.Descriptor instead.
+func (*BuildLogArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_qf_requests_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BuildLogArchiveRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *BuildLogArchiveRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *BuildLogArchiveRequest) GetCommitID() string {
+	if x != nil {
+		return x.CommitID
+	}
+	return ""
+}
+
 type Void struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -715,7 +1158,7 @@ type Void struct {
 
 func (x *Void) Reset() {
 	*x = Void{}
-	mi := &file_qf_requests_proto_msgTypes[10]
+	mi := &file_qf_requests_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -727,7 +1170,7 @@ func (x *Void) String() string {
 func (*Void) ProtoMessage() {}
 
 func (x *Void) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_requests_proto_msgTypes[10]
+	mi := &file_qf_requests_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +1183,7 @@ func (x *Void) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Void.ProtoReflect.Descriptor instead.
 func (*Void) Descriptor() ([]byte, []int) {
-	return file_qf_requests_proto_rawDescGZIP(), []int{10}
+	return file_qf_requests_proto_rawDescGZIP(), []int{16}
 }
 
 var File_qf_requests_proto protoreflect.FileDescriptor
@@ -791,11 +1234,43 @@ const file_qf_requests_proto_rawDesc = "" +
 	"\x04URLs\x18\x01 \x03(\v2\x1a.qf.Repositories.URLsEntryR\x04URLs\x1a7\n" +
 	"\tURLsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\rR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"t\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xda\x01\n" +
 	"\x0eRebuildRequest\x12\x1a\n" +
 	"\bcourseID\x18\x01 \x01(\x04R\bcourseID\x12\"\n" +
 	"\fassignmentID\x18\x02 \x01(\x04R\fassignmentID\x12\"\n" +
-	"\fsubmissionID\x18\x03 \x01(\x04R\fsubmissionID\"\x06\n" +
+	"\fsubmissionID\x18\x03 \x01(\x04R\fsubmissionID\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\x12\x14\n" +
+	"\x05stale\x18\x05 \x01(\bR\x05stale\x12\x16\n" +
+	"\x06dryRun\x18\x06 \x01(\bR\x06dryRun\x12 \n" +
+	"\vtestsBranch\x18\a \x01(\tR\vtestsBranch\"Y\n" +
+	"\x17StaleSubmissionsRequest\x12\x1a\n" +
+	"\bcourseID\x18\x01 \x01(\x04R\bcourseID\x12\"\n" +
+	"\fassignmentID\x18\x02 \x01(\x04R\fassignmentID\"S\n" +
+	"\x11FlakyTestsRequest\x12\x1a\n" +
+	"\bcourseID\x18\x01 \x01(\x04R\bcourseID\x12\"\n" +
+	"\fassignmentID\x18\x02 \x01(\x04R\fassignmentID\"\xf4\x01\n" +
+	"\x12LeaderboardRequest\x12\x1a\n" +
+	"\bcourseID\x18\x01 \x01(\x04R\bcourseID\x12\"\n" +
+	"\fassignmentID\x18\x02 \x01(\x04R\fassignmentID\x12\x1a\n" +
+	"\btestName\x18\x03 \x01(\tR\btestName\x125\n" +
+	"\x06metric\x18\x04 \x01(\x0e2\x1d.qf.LeaderboardRequest.MetricR\x06metric\"K\n" +
+	"\x06Metric\x12\r\n" +
+	"\tNS_PER_OP\x10\x00\x12\x11\n" +
+	"\rALLOCS_PER_OP\x10\x01\x12\x10\n" +
+	"\fBYTES_PER_OP\x10\x02\x12\r\n" +
+	"\tEXEC_TIME\x10\x03\"P\n" +
+	"\x14RebuildStatusRequest\x12\x1a\n" +
+	"\bcourseID\x18\x01 \x01(\x04R\bcourseID\x12\x1c\n" +
+	"\trebuildID\x18\x02 \x01(\x04R\trebuildID\"\x83\x01\n" +
+	"\x0fBuildLogRequest\x12\x1a\n" +
+	"\bcourseID\x18\x01 \x01(\x04R\bcourseID\x12\"\n" +
+	"\fassignmentID\x18\x02 \x01(\x04R\fassignmentID\x12\x16\n" +
+	"\x06userID\x18\x03 \x01(\x04R\x06userID\x12\x18\n" +
+	"\agroupID\x18\x04 \x01(\x04R\agroupID\"t\n" +
+	"\x16BuildLogArchiveRequest\x12\x1a\n" +
+	"\bcourseID\x18\x01 \x01(\x04R\bcourseID\x12\"\n" +
+	"\fsubmissionID\x18\x02 \x01(\x04R\fsubmissionID\x12\x1a\n" +
+	"\bcommitID\x18\x03 \x01(\tR\bcommitID\"\x06\n" +
 	"\x04VoidB&Z!github.com/quickfeed/quickfeed/qf\xba\x02\x00b\x06proto3"
 
 var (
@@ -810,39 +1285,47 @@ func file_qf_requests_proto_rawDescGZIP() []byte {
 	return file_qf_requests_proto_rawDescData
 }
 
-var file_qf_requests_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_qf_requests_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_qf_requests_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_qf_requests_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_qf_requests_proto_goTypes = []any{
 	(SubmissionRequest_SubmissionType)(0), // 0: qf.SubmissionRequest.SubmissionType
-	(*CourseSubmissions)(nil),             // 1: qf.CourseSubmissions
-	(*ReviewRequest)(nil),                 // 2: qf.ReviewRequest
-	(*CourseRequest)(nil),                 // 3: qf.CourseRequest
-	(*GroupRequest)(nil),                  // 4: qf.GroupRequest
-	(*Organization)(nil),                  // 5: qf.Organization
-	(*EnrollmentRequest)(nil),             // 6: qf.EnrollmentRequest
-	(*SubmissionRequest)(nil),             // 7: qf.SubmissionRequest
-	(*RepositoryRequest)(nil),             // 8: qf.RepositoryRequest
-	(*Repositories)(nil),                  // 9: qf.Repositories
-	(*RebuildRequest)(nil),                // 10: qf.RebuildRequest
-	(*Void)(nil),                          // 11: qf.Void
-	nil,                                   // 12: qf.CourseSubmissions.SubmissionsEntry
-	nil,                                   // 13: qf.Repositories.URLsEntry
-	(*Review)(nil),                        // 14: qf.Review
-	(Enrollment_UserStatus)(0),            // 15: qf.Enrollment.UserStatus
-	(*Submissions)(nil),                   // 16: qf.Submissions
+	(LeaderboardRequest_Metric)(0),        // 1: qf.LeaderboardRequest.Metric
+	(*CourseSubmissions)(nil),             // 2: qf.CourseSubmissions
+	(*ReviewRequest)(nil),                 // 3: qf.ReviewRequest
+	(*CourseRequest)(nil),                 // 4: qf.CourseRequest
+	(*GroupRequest)(nil),                  // 5: qf.GroupRequest
+	(*Organization)(nil),                  // 6: qf.Organization
+	(*EnrollmentRequest)(nil),             // 7: qf.EnrollmentRequest
+	(*SubmissionRequest)(nil),             // 8: qf.SubmissionRequest
+	(*RepositoryRequest)(nil),             // 9: qf.RepositoryRequest
+	(*Repositories)(nil),                  // 10: qf.Repositories
+	(*RebuildRequest)(nil),                // 11: qf.RebuildRequest
+	(*StaleSubmissionsRequest)(nil),       // 12: qf.StaleSubmissionsRequest
+	(*FlakyTestsRequest)(nil),             // 13: qf.FlakyTestsRequest
+	(*LeaderboardRequest)(nil),            // 14: qf.LeaderboardRequest
+	(*RebuildStatusRequest)(nil),          // 15: qf.RebuildStatusRequest
+	(*BuildLogRequest)(nil),               // 16: qf.BuildLogRequest
+	(*BuildLogArchiveRequest)(nil),        // 17: qf.BuildLogArchiveRequest
+	(*Void)(nil),                          // 18: qf.Void
+	nil,                                   // 19: qf.CourseSubmissions.SubmissionsEntry
+	nil,                                   // 20: qf.Repositories.URLsEntry
+	(*Review)(nil),                        // 21: qf.Review
+	(Enrollment_UserStatus)(0),            // 22: qf.Enrollment.UserStatus
+	(*Submissions)(nil),                   // 23: qf.Submissions
 }
 var file_qf_requests_proto_depIdxs = []int32{
-	12, // 0: qf.CourseSubmissions.submissions:type_name -> qf.CourseSubmissions.SubmissionsEntry
-	14, // 1: qf.ReviewRequest.review:type_name -> qf.Review
-	15, // 2: qf.EnrollmentRequest.statuses:type_name -> qf.Enrollment.UserStatus
+	19, // 0: qf.CourseSubmissions.submissions:type_name -> qf.CourseSubmissions.SubmissionsEntry
+	21, // 1: qf.ReviewRequest.review:type_name -> qf.Review
+	22, // 2: qf.EnrollmentRequest.statuses:type_name -> qf.Enrollment.UserStatus
 	0,  // 3: qf.SubmissionRequest.Type:type_name -> qf.SubmissionRequest.SubmissionType
-	13, // 4: qf.Repositories.URLs:type_name -> qf.Repositories.URLsEntry
-	16, // 5: qf.CourseSubmissions.SubmissionsEntry.value:type_name -> qf.Submissions
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	20, // 4: qf.Repositories.URLs:type_name -> qf.Repositories.URLsEntry
+	1,  // 5: qf.LeaderboardRequest.metric:type_name -> qf.LeaderboardRequest.Metric
+	23, // 6: qf.CourseSubmissions.SubmissionsEntry.value:type_name -> qf.Submissions
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_qf_requests_proto_init() }
@@ -866,8 +1349,8 @@ func file_qf_requests_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_qf_requests_proto_rawDesc), len(file_qf_requests_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   13,
+			NumEnums:      2,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
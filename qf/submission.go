@@ -1,7 +1,12 @@
 package qf
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"time"
+
+	"github.com/quickfeed/quickfeed/kit/score"
 )
 
 func (s *Submission) IsApproved(userID uint64) bool {
@@ -118,3 +123,55 @@ func (s *Submissions) Clean(userID uint64) {
 		}
 	}
 }
+
+// HideTests hides the hidden tests of the submissions for assignments whose deadline has not passed.
+// Submissions for assignments that are not among the given assignments are also hidden.
+func (s *Submissions) HideTests(assignments []*Assignment, now time.Time) {
+	deadlinePassed := make(map[uint64]bool)
+	for _, assignment := range assignments {
+		deadlinePassed[assignment.GetID()] = assignment.SinceDeadline(now) >= 0
+	}
+	for _, submission := range s.GetSubmissions() {
+		if !deadlinePassed[submission.GetAssignmentID()] {
+			submission.HideTests()
+		}
+	}
+}
+
+// HideTests replaces the names of the submission's hidden tests with placeholders, removes
+// their details, and removes the lines of the build log that mention them. The scores of the
+// hidden tests are kept, since they count toward the submission's score.
+func (s *Submission) HideTests() {
+	hidden := HideScores(s.GetScores())
+	for _, sc := range s.GetScores() {
+		if sc.GetHidden() {
+			sc.TaskName = ""
+		}
+	}
+	if len(hidden) == 0 || s.GetBuildInfo() == nil {
+		return
+	}
+	lines := strings.Split(s.GetBuildInfo().GetBuildLog(), "\n")
+	lines = slices.DeleteFunc(lines, func(line string) bool { return MentionsTest(line, hidden) })
+	s.BuildInfo.BuildLog = strings.Join(lines, "\n")
+}
+
+// HideScores replaces the names of the given hidden scores with placeholders and removes
+// their details. It returns the original names of the hidden tests.
+func HideScores(scores []*score.Score) []string {
+	var hidden []string
+	for _, sc := range scores {
+		if !sc.GetHidden() {
+			continue
+		}
+		hidden = append(hidden, sc.GetTestName())
+		sc.TestName = fmt.Sprintf("Hidden test %d", len(hidden))
+		sc.TestDetails = ""
+	}
+	return hidden
+}
+
+// MentionsTest returns true if the given build log line mentions any of the given tests.
+func MentionsTest(line string, testNames []string) bool {
+	return slices.ContainsFunc(testNames, func(testName string) bool { return strings.Contains(line, testName) })
+}
@@ -336,6 +336,61 @@ func (Submission_Status) EnumDescriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{16, 0}
 }
 
+type Job_Status int32
+
+const (
+	Job_QUEUED    Job_Status = 0
+	Job_RUNNING   Job_Status = 1
+	Job_SUCCEEDED Job_Status = 2
+	Job_FAILED    Job_Status = 3
+	Job_CANCELLED Job_Status = 4
+)
+
+// Enum value maps for Job_Status.
+var (
+	Job_Status_name = map[int32]string{
+		0: "QUEUED",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+		4: "CANCELLED",
+	}
+	Job_Status_value = map[string]int32{
+		"QUEUED":    0,
+		"RUNNING":   1,
+		"SUCCEEDED": 2,
+		"FAILED":    3,
+		"CANCELLED": 4,
+	}
+)
+
+func (x Job_Status) Enum() *Job_Status {
+	p := new(Job_Status)
+	*p = x
+	return p
+}
+
+func (x Job_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Job_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_qf_types_proto_enumTypes[6].Descriptor()
+}
+
+func (Job_Status) Type() protoreflect.EnumType {
+	return &file_qf_types_proto_enumTypes[6]
+}
+
+func (x Job_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Job_Status.Descriptor instead.
+func (Job_Status) EnumDescriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{19, 0}
+}
+
 type GradingCriterion_Grade int32
 
 const (
@@ -369,11 +424,11 @@ func (x GradingCriterion_Grade) String() string {
 }
 
 func (GradingCriterion_Grade) Descriptor() protoreflect.EnumDescriptor {
-	return file_qf_types_proto_enumTypes[6].Descriptor()
+	return file_qf_types_proto_enumTypes[7].Descriptor()
 }
 
 func (GradingCriterion_Grade) Type() protoreflect.EnumType {
-	return &file_qf_types_proto_enumTypes[6]
+	return &file_qf_types_proto_enumTypes[7]
 }
 
 func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
@@ -382,7 +437,7 @@ func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GradingCriterion_Grade.Descriptor instead.
 func (GradingCriterion_Grade) EnumDescriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{21, 0}
+	return file_qf_types_proto_rawDescGZIP(), []int{34, 0}
 }
 
 type User struct {
@@ -721,6 +776,10 @@ type Course struct {
 	Enrollments         []*Enrollment          `protobuf:"bytes,13,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
 	Assignments         []*Assignment          `protobuf:"bytes,14,rep,name=assignments,proto3" json:"assignments,omitempty"`
 	Groups              []*Group               `protobuf:"bytes,15,rep,name=groups,proto3" json:"groups,omitempty"`
+	AutoRebuild         bool                   `protobuf:"varint,16,opt,name=autoRebuild,proto3" json:"autoRebuild,omitempty"`              // rebuild submissions when an assignment's tests change
+	SolutionRepository  string                 `protobuf:"bytes,17,opt,name=solutionRepository,proto3" json:"solutionRepository,omitempty"` // repository with the reference solution; the assignments repository if empty
+	SolutionBranch      string                 `protobuf:"bytes,18,opt,name=solutionBranch,proto3" json:"solutionBranch,omitempty"`         // branch with the reference solution; empty for the default branch
+	Leaderboard         bool                   `protobuf:"varint,19,opt,name=leaderboard,proto3" json:"leaderboard,omitempty"`              // students may view leaderboards ranking submissions by benchmark results
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -853,6 +912,34 @@ func (x *Course) GetGroups() []*Group {
 	return nil
 }
 
+func (x *Course) GetAutoRebuild() bool {
+	if x != nil {
+		return x.AutoRebuild
+	}
+	return false
+}
+
+func (x *Course) GetSolutionRepository() string {
+	if x != nil {
+		return x.SolutionRepository
+	}
+	return ""
+}
+
+func (x *Course) GetSolutionBranch() string {
+	if x != nil {
+		return x.SolutionBranch
+	}
+	return ""
+}
+
+func (x *Course) GetLeaderboard() bool {
+	if x != nil {
+		return x.Leaderboard
+	}
+	return false
+}
+
 type Courses struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
@@ -1266,13 +1353,22 @@ type Assignment struct {
 	AutoApprove       bool                   `protobuf:"varint,5,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
 	Order             uint32                 `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`
 	IsGroupLab        bool                   `protobuf:"varint,7,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
-	ScoreLimit        uint32                 `protobuf:"varint,8,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`               // minimal score limit for auto approval
-	Reviewers         uint32                 `protobuf:"varint,9,opt,name=reviewers,proto3" json:"reviewers,omitempty"`                 // number of reviewers that will review submissions for this assignment
-	ContainerTimeout  uint32                 `protobuf:"varint,10,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`  // container timeout for this assignment
-	Submissions       []*Submission          `protobuf:"bytes,11,rep,name=submissions,proto3" json:"submissions,omitempty"`             // submissions produced for this assignment
-	Tasks             []*Task                `protobuf:"bytes,12,rep,name=tasks,proto3" json:"tasks,omitempty"`                         // tasks associated with this assignment
-	GradingBenchmarks []*GradingBenchmark    `protobuf:"bytes,13,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"` // grading benchmarks for this assignment
-	ExpectedTests     []*TestInfo            `protobuf:"bytes,14,rep,name=ExpectedTests,proto3" json:"ExpectedTests,omitempty"`         // list of expected tests for this assignment
+	ScoreLimit        uint32                 `protobuf:"varint,8,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`                // minimal score limit for auto approval
+	Reviewers         uint32                 `protobuf:"varint,9,opt,name=reviewers,proto3" json:"reviewers,omitempty"`                  // number of reviewers that will review submissions for this assignment
+	ContainerTimeout  uint32                 `protobuf:"varint,10,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`   // container timeout for this assignment
+	Submissions       []*Submission          `protobuf:"bytes,11,rep,name=submissions,proto3" json:"submissions,omitempty"`              // submissions produced for this assignment
+	Tasks             []*Task                `protobuf:"bytes,12,rep,name=tasks,proto3" json:"tasks,omitempty"`                          // tasks associated with this assignment
+	GradingBenchmarks []*GradingBenchmark    `protobuf:"bytes,13,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"`  // grading benchmarks for this assignment
+	ExpectedTests     []*TestInfo            `protobuf:"bytes,14,rep,name=ExpectedTests,proto3" json:"ExpectedTests,omitempty"`          // list of expected tests for this assignment
+	MemoryLimit       uint32                 `protobuf:"varint,15,opt,name=memoryLimit,proto3" json:"memoryLimit,omitempty"`             // container memory limit in megabytes
+	CpuLimit          uint32                 `protobuf:"varint,16,opt,name=cpuLimit,proto3" json:"cpuLimit,omitempty"`                   // container CPU quota in thousandths of a CPU
+	PidsLimit         uint32                 `protobuf:"varint,17,opt,name=pidsLimit,proto3" json:"pidsLimit,omitempty"`                 // container limit on the number of processes
+	FileSizeLimit     uint32                 `protobuf:"varint,18,opt,name=fileSizeLimit,proto3" json:"fileSizeLimit,omitempty"`         // container limit on the size of each file written, in megabytes
+	TestRetries       uint32                 `protobuf:"varint,19,opt,name=testRetries,proto3" json:"testRetries,omitempty"`             // number of times failed tests are rerun in the same test run
+	CoverageThreshold uint32                 `protobuf:"varint,20,opt,name=coverageThreshold,proto3" json:"coverageThreshold,omitempty"` // code coverage percentage that obtains the full coverage score; 0 if coverage is not scored
+	CoverageWeight    uint32                 `protobuf:"varint,21,opt,name=coverageWeight,proto3" json:"coverageWeight,omitempty"`       // the weight of the coverage score; used to compute final grade
+	LintPenalty       uint32                 `protobuf:"varint,22,opt,name=lintPenalty,proto3" json:"lintPenalty,omitempty"`             // percentage points deducted from the score per lint finding
+	MaxLintPenalty    uint32                 `protobuf:"varint,23,opt,name=maxLintPenalty,proto3" json:"maxLintPenalty,omitempty"`       // maximum percentage points deducted for lint findings; 0 if not capped
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -1405,14 +1501,80 @@ func (x *Assignment) GetExpectedTests() []*TestInfo {
 	return nil
 }
 
+func (x *Assignment) GetMemoryLimit() uint32 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetCpuLimit() uint32 {
+	if x != nil {
+		return x.CpuLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetPidsLimit() uint32 {
+	if x != nil {
+		return x.PidsLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetFileSizeLimit() uint32 {
+	if x != nil {
+		return x.FileSizeLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetTestRetries() uint32 {
+	if x != nil {
+		return x.TestRetries
+	}
+	return 0
+}
+
+func (x *Assignment) GetCoverageThreshold() uint32 {
+	if x != nil {
+		return x.CoverageThreshold
+	}
+	return 0
+}
+
+func (x *Assignment) GetCoverageWeight() uint32 {
+	if x != nil {
+		return x.CoverageWeight
+	}
+	return 0
+}
+
+func (x *Assignment) GetLintPenalty() uint32 {
+	if x != nil {
+		return x.LintPenalty
+	}
+	return 0
+}
+
+func (x *Assignment) GetMaxLintPenalty() uint32 {
+	if x != nil {
+		return x.MaxLintPenalty
+	}
+	return 0
+}
+
 type TestInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID  uint64                 `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty" gorm:"uniqueIndex:testinfo"` // foreign key
-	TestName      string                 `protobuf:"bytes,3,opt,name=TestName,proto3" json:"TestName,omitempty" gorm:"uniqueIndex:testinfo"`          // name of the test
-	MaxScore      int32                  `protobuf:"varint,4,opt,name=MaxScore,proto3" json:"MaxScore,omitempty"`                                     // max score possible to get on this test
-	Weight        int32                  `protobuf:"varint,5,opt,name=Weight,proto3" json:"Weight,omitempty"`                                         // the weight of this test; used to compute final grade
-	Details       string                 `protobuf:"bytes,6,opt,name=Details,proto3" json:"Details,omitempty"`                                        // if populated, the frontend may display these details
+	AssignmentID  uint64                 `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty" gorm:"uniqueIndex:testinfo"`             // foreign key
+	TestName      string                 `protobuf:"bytes,3,opt,name=TestName,proto3" json:"TestName,omitempty" gorm:"uniqueIndex:testinfo"`                      // name of the test
+	MaxScore      int32                  `protobuf:"varint,4,opt,name=MaxScore,proto3" json:"MaxScore,omitempty"`                                                 // max score possible to get on this test
+	Weight        int32                  `protobuf:"varint,5,opt,name=Weight,proto3" json:"Weight,omitempty"`                                                     // the weight of this test; used to compute final grade
+	Details       string                 `protobuf:"bytes,6,opt,name=Details,proto3" json:"Details,omitempty"`                                                    // if populated, the frontend may display these details
+	Hidden        bool                   `protobuf:"varint,7,opt,name=Hidden,proto3" json:"Hidden,omitempty"`                                                     // the test is hidden from students until the assignment's deadline
+	Group         string                 `protobuf:"bytes,8,opt,name=Group,proto3" json:"Group,omitempty"`                                                        // slash-separated path of the nested score groups the test belongs to, if any
+	GroupWeights  []int32                `protobuf:"varint,9,rep,packed,name=GroupWeights,proto3" json:"GroupWeights,omitempty" gorm:"serializer:json;type:text"` // weights of the groups in Group, outermost first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1489,6 +1651,27 @@ func (x *TestInfo) GetDetails() string {
 	return ""
 }
 
+func (x *TestInfo) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *TestInfo) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *TestInfo) GetGroupWeights() []int32 {
+	if x != nil {
+		return x.GroupWeights
+	}
+	return nil
+}
+
 type Task struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ID              uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -2029,33 +2212,52 @@ func (x *Grade) GetStatus() Submission_Status {
 	return Submission_NONE
 }
 
-type GradingBenchmark struct {
+// Job is a persistent record of a test run for a push event or a rebuild request.
+type Job struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	CourseID      uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`         // foreign key
 	AssignmentID  uint64                 `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
-	ReviewID      uint64                 `protobuf:"varint,4,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`         // foreign key
-	Heading       string                 `protobuf:"bytes,5,opt,name=heading,proto3" json:"heading,omitempty"`
-	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
-	Criteria      []*GradingCriterion    `protobuf:"bytes,7,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+	RepositoryID  uint64                 `protobuf:"varint,4,opt,name=RepositoryID,proto3" json:"RepositoryID,omitempty"` // foreign key; the student or group repository to test
+	SubmissionID  uint64                 `protobuf:"varint,5,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // the submission to rebuild; only used for rebuild jobs
+	BranchName    string                 `protobuf:"bytes,6,opt,name=BranchName,proto3" json:"BranchName,omitempty"`      // empty for the repository's default branch
+	CommitID      string                 `protobuf:"bytes,7,opt,name=CommitID,proto3" json:"CommitID,omitempty"`
+	JobOwner      string                 `protobuf:"bytes,8,opt,name=JobOwner,proto3" json:"JobOwner,omitempty"` // login of the user or name of the group that triggered the job
+	Rebuild       bool                   `protobuf:"varint,9,opt,name=Rebuild,proto3" json:"Rebuild,omitempty"`
+	Status        Job_Status             `protobuf:"varint,10,opt,name=status,proto3,enum=qf.Job_Status" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,11,opt,name=Error,proto3" json:"Error,omitempty"` // reason for failure; only set for failed jobs
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	RebuildID     uint64                 `protobuf:"varint,14,opt,name=RebuildID,proto3" json:"RebuildID,omitempty"`                                       // foreign key; only used for jobs created by a rebuild of all submissions
+	Force         bool                   `protobuf:"varint,15,opt,name=Force,proto3" json:"Force,omitempty"`                                               // run the tests even if results for the same commit and tests are cached
+	PreviousScore uint32                 `protobuf:"varint,16,opt,name=PreviousScore,proto3" json:"PreviousScore,omitempty"`                               // the submission's score before the rebuild; only used for rebuild jobs
+	Score         uint32                 `protobuf:"varint,17,opt,name=Score,proto3" json:"Score,omitempty"`                                               // the score recorded by the job; only set for succeeded jobs
+	DryRun        bool                   `protobuf:"varint,18,opt,name=DryRun,proto3" json:"DryRun,omitempty"`                                             // run the tests without recording the results
+	TestsBranch   string                 `protobuf:"bytes,19,opt,name=TestsBranch,proto3" json:"TestsBranch,omitempty"`                                    // branch of the tests repository to run; empty for the current tests
+	NowPassing    []string               `protobuf:"bytes,20,rep,name=NowPassing,proto3" json:"NowPassing,omitempty" gorm:"serializer:json;type:text"`     // dry run: tests that failed in the recorded results
+	NowFailing    []string               `protobuf:"bytes,21,rep,name=NowFailing,proto3" json:"NowFailing,omitempty" gorm:"serializer:json;type:text"`     // dry run: tests that passed in the recorded results
+	Solution      bool                   `protobuf:"varint,22,opt,name=Solution,proto3" json:"Solution,omitempty"`                                         // run the tests against the course's reference solution
+	TestsCommit   string                 `protobuf:"bytes,23,opt,name=TestsCommit,proto3" json:"TestsCommit,omitempty"`                                    // solution run: commit of the tests repository that was run
+	FailingTests  []string               `protobuf:"bytes,24,rep,name=FailingTests,proto3" json:"FailingTests,omitempty" gorm:"serializer:json;type:text"` // solution run: tests without max score
+	MissingTests  []string               `protobuf:"bytes,25,rep,name=MissingTests,proto3" json:"MissingTests,omitempty" gorm:"serializer:json;type:text"` // solution run: expected tests that reported no score
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GradingBenchmark) Reset() {
-	*x = GradingBenchmark{}
+func (x *Job) Reset() {
+	*x = Job{}
 	mi := &file_qf_types_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GradingBenchmark) String() string {
+func (x *Job) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradingBenchmark) ProtoMessage() {}
+func (*Job) ProtoMessage() {}
 
-func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
+func (x *Job) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2067,202 +2269,1166 @@ func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
-func (*GradingBenchmark) Descriptor() ([]byte, []int) {
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GradingBenchmark) GetID() uint64 {
+func (x *Job) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetCourseID() uint64 {
+func (x *Job) GetCourseID() uint64 {
 	if x != nil {
 		return x.CourseID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetAssignmentID() uint64 {
+func (x *Job) GetAssignmentID() uint64 {
 	if x != nil {
 		return x.AssignmentID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetReviewID() uint64 {
+func (x *Job) GetRepositoryID() uint64 {
 	if x != nil {
-		return x.ReviewID
+		return x.RepositoryID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetHeading() string {
+func (x *Job) GetSubmissionID() uint64 {
 	if x != nil {
-		return x.Heading
+		return x.SubmissionID
 	}
-	return ""
+	return 0
 }
 
-func (x *GradingBenchmark) GetComment() string {
+func (x *Job) GetBranchName() string {
 	if x != nil {
-		return x.Comment
+		return x.BranchName
 	}
 	return ""
 }
 
-func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+func (x *Job) GetCommitID() string {
 	if x != nil {
-		return x.Criteria
+		return x.CommitID
 	}
-	return nil
+	return ""
 }
 
-type Benchmarks struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Benchmarks    []*GradingBenchmark    `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Job) GetJobOwner() string {
+	if x != nil {
+		return x.JobOwner
+	}
+	return ""
 }
 
-func (x *Benchmarks) Reset() {
-	*x = Benchmarks{}
-	mi := &file_qf_types_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Job) GetRebuild() bool {
+	if x != nil {
+		return x.Rebuild
+	}
+	return false
 }
 
-func (x *Benchmarks) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Job) GetStatus() Job_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Job_QUEUED
 }
 
-func (*Benchmarks) ProtoMessage() {}
-
-func (x *Benchmarks) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[20]
+func (x *Job) GetError() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Error
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
-func (*Benchmarks) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{20}
+func (x *Job) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+func (x *Job) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Benchmarks
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type GradingCriterion struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	BenchmarkID   uint64                 `protobuf:"varint,2,opt,name=BenchmarkID,proto3" json:"BenchmarkID,omitempty"` // foreign key
-	CourseID      uint64                 `protobuf:"varint,3,opt,name=CourseID,proto3" json:"CourseID,omitempty"`       // foreign key
-	Points        uint64                 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Grade         GradingCriterion_Grade `protobuf:"varint,6,opt,name=grade,proto3,enum=qf.GradingCriterion_Grade" json:"grade,omitempty"`
-	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Job) GetRebuildID() uint64 {
+	if x != nil {
+		return x.RebuildID
+	}
+	return 0
 }
 
-func (x *GradingCriterion) Reset() {
-	*x = GradingCriterion{}
-	mi := &file_qf_types_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Job) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
 }
 
-func (x *GradingCriterion) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Job) GetPreviousScore() uint32 {
+	if x != nil {
+		return x.PreviousScore
+	}
+	return 0
 }
 
-func (*GradingCriterion) ProtoMessage() {}
-
-func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[21]
+func (x *Job) GetScore() uint32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Score
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
-func (*GradingCriterion) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{21}
+func (x *Job) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
-func (x *GradingCriterion) GetID() uint64 {
+func (x *Job) GetTestsBranch() string {
 	if x != nil {
-		return x.ID
+		return x.TestsBranch
 	}
-	return 0
+	return ""
 }
 
-func (x *GradingCriterion) GetBenchmarkID() uint64 {
+func (x *Job) GetNowPassing() []string {
 	if x != nil {
-		return x.BenchmarkID
+		return x.NowPassing
 	}
-	return 0
+	return nil
 }
 
-func (x *GradingCriterion) GetCourseID() uint64 {
+func (x *Job) GetNowFailing() []string {
 	if x != nil {
-		return x.CourseID
+		return x.NowFailing
 	}
-	return 0
+	return nil
 }
 
-func (x *GradingCriterion) GetPoints() uint64 {
+func (x *Job) GetSolution() bool {
 	if x != nil {
-		return x.Points
+		return x.Solution
 	}
-	return 0
+	return false
 }
 
-func (x *GradingCriterion) GetDescription() string {
+func (x *Job) GetTestsCommit() string {
 	if x != nil {
-		return x.Description
+		return x.TestsCommit
 	}
 	return ""
 }
 
-func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
+func (x *Job) GetFailingTests() []string {
 	if x != nil {
-		return x.Grade
+		return x.FailingTests
 	}
-	return GradingCriterion_NONE
+	return nil
 }
 
-func (x *GradingCriterion) GetComment() string {
+func (x *Job) GetMissingTests() []string {
 	if x != nil {
-		return x.Comment
+		return x.MissingTests
 	}
-	return ""
+	return nil
 }
 
-type Review struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	ID                uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	SubmissionID      uint64                 `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
-	ReviewerID        uint64                 `protobuf:"varint,3,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty"`     // UserID of the reviewer
-	Feedback          string                 `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
+// Rebuild is a persistent record of a request to rebuild all submissions for an assignment.
+type Rebuild struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID      uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`         // foreign key
+	AssignmentID  uint64                 `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	Automatic     bool                   `protobuf:"varint,5,opt,name=Automatic,proto3" json:"Automatic,omitempty"`    // created by a push to the tests repository
+	DryRun        bool                   `protobuf:"varint,6,opt,name=DryRun,proto3" json:"DryRun,omitempty"`          // the results are reported, but not recorded
+	TestsBranch   string                 `protobuf:"bytes,7,opt,name=TestsBranch,proto3" json:"TestsBranch,omitempty"` // branch of the tests repository run by a dry run; empty for the current tests
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rebuild) Reset() {
+	*x = Rebuild{}
+	mi := &file_qf_types_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rebuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rebuild) ProtoMessage() {}
+
+func (x *Rebuild) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rebuild.ProtoReflect.Descriptor instead.
+func (*Rebuild) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Rebuild) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Rebuild) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Rebuild) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Rebuild) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Rebuild) GetAutomatic() bool {
+	if x != nil {
+		return x.Automatic
+	}
+	return false
+}
+
+func (x *Rebuild) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *Rebuild) GetTestsBranch() string {
+	if x != nil {
+		return x.TestsBranch
+	}
+	return ""
+}
+
+// RebuildSummary summarizes how a rebuild changed the scores of the rebuilt submissions.
+type RebuildSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rebuild       *Rebuild               `protobuf:"bytes,1,opt,name=rebuild,proto3" json:"rebuild,omitempty"`
+	Progress      *RebuildProgress       `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	Increased     uint32                 `protobuf:"varint,3,opt,name=increased,proto3" json:"increased,omitempty"` // number of succeeded jobs that increased the score
+	Decreased     uint32                 `protobuf:"varint,4,opt,name=decreased,proto3" json:"decreased,omitempty"` // number of succeeded jobs that decreased the score
+	Unchanged     uint32                 `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged,omitempty"` // number of succeeded jobs that kept the score
+	Changed       []*Job                 `protobuf:"bytes,6,rep,name=changed,proto3" json:"changed,omitempty"`      // succeeded jobs that changed the score or the status of a test
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildSummary) Reset() {
+	*x = RebuildSummary{}
+	mi := &file_qf_types_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildSummary) ProtoMessage() {}
+
+func (x *RebuildSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildSummary.ProtoReflect.Descriptor instead.
+func (*RebuildSummary) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RebuildSummary) GetRebuild() *Rebuild {
+	if x != nil {
+		return x.Rebuild
+	}
+	return nil
+}
+
+func (x *RebuildSummary) GetProgress() *RebuildProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *RebuildSummary) GetIncreased() uint32 {
+	if x != nil {
+		return x.Increased
+	}
+	return 0
+}
+
+func (x *RebuildSummary) GetDecreased() uint32 {
+	if x != nil {
+		return x.Decreased
+	}
+	return 0
+}
+
+func (x *RebuildSummary) GetUnchanged() uint32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *RebuildSummary) GetChanged() []*Job {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+type RebuildSummaries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summaries     []*RebuildSummary      `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"` // most recent rebuild first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildSummaries) Reset() {
+	*x = RebuildSummaries{}
+	mi := &file_qf_types_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildSummaries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildSummaries) ProtoMessage() {}
+
+func (x *RebuildSummaries) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildSummaries.ProtoReflect.Descriptor instead.
+func (*RebuildSummaries) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RebuildSummaries) GetSummaries() []*RebuildSummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+// FlakyTest reports how often a test passed only when rerun in the recorded submissions for an assignment.
+type FlakyTest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TestName      string                 `protobuf:"bytes,1,opt,name=testName,proto3" json:"testName,omitempty"`
+	Flaky         uint32                 `protobuf:"varint,2,opt,name=flaky,proto3" json:"flaky,omitempty"`             // number of submissions in which the test failed, but passed when rerun
+	Submissions   uint32                 `protobuf:"varint,3,opt,name=submissions,proto3" json:"submissions,omitempty"` // number of submissions with a score for the test
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlakyTest) Reset() {
+	*x = FlakyTest{}
+	mi := &file_qf_types_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlakyTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlakyTest) ProtoMessage() {}
+
+func (x *FlakyTest) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlakyTest.ProtoReflect.Descriptor instead.
+func (*FlakyTest) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FlakyTest) GetTestName() string {
+	if x != nil {
+		return x.TestName
+	}
+	return ""
+}
+
+func (x *FlakyTest) GetFlaky() uint32 {
+	if x != nil {
+		return x.Flaky
+	}
+	return 0
+}
+
+func (x *FlakyTest) GetSubmissions() uint32 {
+	if x != nil {
+		return x.Submissions
+	}
+	return 0
+}
+
+type FlakyTests struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tests         []*FlakyTest           `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"` // most flaky test first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlakyTests) Reset() {
+	*x = FlakyTests{}
+	mi := &file_qf_types_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlakyTests) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlakyTests) ProtoMessage() {}
+
+func (x *FlakyTests) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlakyTests.ProtoReflect.Descriptor instead.
+func (*FlakyTests) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FlakyTests) GetTests() []*FlakyTest {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+// LeaderboardEntry ranks a submission by the benchmark metric of a leaderboard.
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          uint32                 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`       // entries with equal values have the same rank
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`        // the student's login or the group's name
+	UserID        uint64                 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`   // zero for group submissions
+	GroupID       uint64                 `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"` // zero for individual submissions
+	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`    // the submission's value of the ranked metric
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_qf_types_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LeaderboardEntry) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Leaderboard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LeaderboardEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // best submission first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Leaderboard) Reset() {
+	*x = Leaderboard{}
+	mi := &file_qf_types_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Leaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leaderboard) ProtoMessage() {}
+
+func (x *Leaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leaderboard.ProtoReflect.Descriptor instead.
+func (*Leaderboard) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Leaderboard) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// TestsHealth reports the most recent runs of the course's tests against the reference solution.
+type TestsHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"` // most recent solution job for each assignment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestsHealth) Reset() {
+	*x = TestsHealth{}
+	mi := &file_qf_types_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestsHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestsHealth) ProtoMessage() {}
+
+func (x *TestsHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestsHealth.ProtoReflect.Descriptor instead.
+func (*TestsHealth) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TestsHealth) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// RebuildProgress reports the progress of a rebuild.
+type RebuildProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RebuildID     uint64                 `protobuf:"varint,1,opt,name=rebuildID,proto3" json:"rebuildID,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // number of submissions to rebuild
+	Succeeded     uint32                 `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        uint32                 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled     uint32                 `protobuf:"varint,5,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Job           *Job                   `protobuf:"bytes,6,opt,name=job,proto3" json:"job,omitempty"` // the most recently finished job; not set in the initial progress report
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildProgress) Reset() {
+	*x = RebuildProgress{}
+	mi := &file_qf_types_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildProgress) ProtoMessage() {}
+
+func (x *RebuildProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildProgress.ProtoReflect.Descriptor instead.
+func (*RebuildProgress) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RebuildProgress) GetRebuildID() uint64 {
+	if x != nil {
+		return x.RebuildID
+	}
+	return 0
+}
+
+func (x *RebuildProgress) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RebuildProgress) GetSucceeded() uint32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *RebuildProgress) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *RebuildProgress) GetCancelled() uint32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *RebuildProgress) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// BuildLog holds output from a running test job, without score lines.
+type BuildLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobID         uint64                 `protobuf:"varint,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	Output        string                 `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"` // one or more complete lines of output
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildLog) Reset() {
+	*x = BuildLog{}
+	mi := &file_qf_types_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildLog) ProtoMessage() {}
+
+func (x *BuildLog) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildLog.ProtoReflect.Descriptor instead.
+func (*BuildLog) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BuildLog) GetJobID() uint64 {
+	if x != nil {
+		return x.JobID
+	}
+	return 0
+}
+
+func (x *BuildLog) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// StaleSubmissions holds the submissions for an assignment that were graded
+// with another revision of the tests repository than the current revision.
+type StaleSubmissions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TestsCommit   string                 `protobuf:"bytes,1,opt,name=testsCommit,proto3" json:"testsCommit,omitempty"` // the current commit of the tests repository
+	Submissions   []*Submission          `protobuf:"bytes,2,rep,name=submissions,proto3" json:"submissions,omitempty"` // submissions with build info, without build logs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaleSubmissions) Reset() {
+	*x = StaleSubmissions{}
+	mi := &file_qf_types_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleSubmissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleSubmissions) ProtoMessage() {}
+
+func (x *StaleSubmissions) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleSubmissions.ProtoReflect.Descriptor instead.
+func (*StaleSubmissions) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StaleSubmissions) GetTestsCommit() string {
+	if x != nil {
+		return x.TestsCommit
+	}
+	return ""
+}
+
+func (x *StaleSubmissions) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// BuildLogArchive holds the complete output of a submission's test run.
+type BuildLogArchive struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionID  uint64                 `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	CommitID      string                 `protobuf:"bytes,2,opt,name=commitID,proto3" json:"commitID,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // gzip-compressed output
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildLogArchive) Reset() {
+	*x = BuildLogArchive{}
+	mi := &file_qf_types_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildLogArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildLogArchive) ProtoMessage() {}
+
+func (x *BuildLogArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildLogArchive.ProtoReflect.Descriptor instead.
+func (*BuildLogArchive) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BuildLogArchive) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *BuildLogArchive) GetCommitID() string {
+	if x != nil {
+		return x.CommitID
+	}
+	return ""
+}
+
+func (x *BuildLogArchive) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GradingBenchmark struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID      uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`         // foreign key
+	AssignmentID  uint64                 `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	ReviewID      uint64                 `protobuf:"varint,4,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`         // foreign key
+	Heading       string                 `protobuf:"bytes,5,opt,name=heading,proto3" json:"heading,omitempty"`
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Criteria      []*GradingCriterion    `protobuf:"bytes,7,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradingBenchmark) Reset() {
+	*x = GradingBenchmark{}
+	mi := &file_qf_types_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradingBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingBenchmark) ProtoMessage() {}
+
+func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
+func (*GradingBenchmark) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GradingBenchmark) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+type Benchmarks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Benchmarks    []*GradingBenchmark    `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Benchmarks) Reset() {
+	*x = Benchmarks{}
+	mi := &file_qf_types_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Benchmarks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Benchmarks) ProtoMessage() {}
+
+func (x *Benchmarks) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
+func (*Benchmarks) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+type GradingCriterion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	BenchmarkID   uint64                 `protobuf:"varint,2,opt,name=BenchmarkID,proto3" json:"BenchmarkID,omitempty"` // foreign key
+	CourseID      uint64                 `protobuf:"varint,3,opt,name=CourseID,proto3" json:"CourseID,omitempty"`       // foreign key
+	Points        uint64                 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Grade         GradingCriterion_Grade `protobuf:"varint,6,opt,name=grade,proto3,enum=qf.GradingCriterion_Grade" json:"grade,omitempty"`
+	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradingCriterion) Reset() {
+	*x = GradingCriterion{}
+	mi := &file_qf_types_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradingCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingCriterion) ProtoMessage() {}
+
+func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
+func (*GradingCriterion) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GradingCriterion) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetBenchmarkID() uint64 {
+	if x != nil {
+		return x.BenchmarkID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return GradingCriterion_NONE
+}
+
+func (x *GradingCriterion) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type Review struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ID                uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID      uint64                 `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
+	ReviewerID        uint64                 `protobuf:"varint,3,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty"`     // UserID of the reviewer
+	Feedback          string                 `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
 	Score             uint32                 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
 	GradingBenchmarks []*GradingBenchmark    `protobuf:"bytes,6,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty" gorm:"foreignKey:ReviewID"`
 	Edited            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=edited,proto3" json:"edited,omitempty" gorm:"serializer:timestamp;type:datetime"`
@@ -2272,7 +3438,7 @@ type Review struct {
 
 func (x *Review) Reset() {
 	*x = Review{}
-	mi := &file_qf_types_proto_msgTypes[22]
+	mi := &file_qf_types_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2284,7 +3450,7 @@ func (x *Review) String() string {
 func (*Review) ProtoMessage() {}
 
 func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[22]
+	mi := &file_qf_types_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2297,7 +3463,7 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Review.ProtoReflect.Descriptor instead.
 func (*Review) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{22}
+	return file_qf_types_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *Review) GetID() uint64 {
@@ -2364,7 +3530,7 @@ type AssignmentFeedback struct {
 
 func (x *AssignmentFeedback) Reset() {
 	*x = AssignmentFeedback{}
-	mi := &file_qf_types_proto_msgTypes[23]
+	mi := &file_qf_types_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2376,7 +3542,7 @@ func (x *AssignmentFeedback) String() string {
 func (*AssignmentFeedback) ProtoMessage() {}
 
 func (x *AssignmentFeedback) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[23]
+	mi := &file_qf_types_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2389,7 +3555,7 @@ func (x *AssignmentFeedback) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignmentFeedback.ProtoReflect.Descriptor instead.
 func (*AssignmentFeedback) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{23}
+	return file_qf_types_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *AssignmentFeedback) GetID() uint64 {
@@ -2451,7 +3617,7 @@ type FeedbackReceipt struct {
 
 func (x *FeedbackReceipt) Reset() {
 	*x = FeedbackReceipt{}
-	mi := &file_qf_types_proto_msgTypes[24]
+	mi := &file_qf_types_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2463,7 +3629,7 @@ func (x *FeedbackReceipt) String() string {
 func (*FeedbackReceipt) ProtoMessage() {}
 
 func (x *FeedbackReceipt) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[24]
+	mi := &file_qf_types_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2476,7 +3642,7 @@ func (x *FeedbackReceipt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeedbackReceipt.ProtoReflect.Descriptor instead.
 func (*FeedbackReceipt) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{24}
+	return file_qf_types_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *FeedbackReceipt) GetAssignmentID() uint64 {
@@ -2502,7 +3668,7 @@ type AssignmentFeedbacks struct {
 
 func (x *AssignmentFeedbacks) Reset() {
 	*x = AssignmentFeedbacks{}
-	mi := &file_qf_types_proto_msgTypes[25]
+	mi := &file_qf_types_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2514,7 +3680,7 @@ func (x *AssignmentFeedbacks) String() string {
 func (*AssignmentFeedbacks) ProtoMessage() {}
 
 func (x *AssignmentFeedbacks) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[25]
+	mi := &file_qf_types_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2527,7 +3693,7 @@ func (x *AssignmentFeedbacks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignmentFeedbacks.ProtoReflect.Descriptor instead.
 func (*AssignmentFeedbacks) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{25}
+	return file_qf_types_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AssignmentFeedbacks) GetFeedbacks() []*AssignmentFeedback {
@@ -2571,7 +3737,7 @@ const file_qf_types_proto_rawDesc = "" +
 	"\aPENDING\x10\x00\x12\f\n" +
 	"\bAPPROVED\x10\x01\"+\n" +
 	"\x06Groups\x12!\n" +
-	"\x06groups\x18\x01 \x03(\v2\t.qf.GroupR\x06groups\"\xcb\x04\n" +
+	"\x06groups\x18\x01 \x03(\v2\t.qf.GroupR\x06groups\"\xe7\x05\n" +
 	"\x06Course\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12(\n" +
 	"\x0fcourseCreatorID\x18\x02 \x01(\x04R\x0fcourseCreatorID\x12\x12\n" +
@@ -2587,7 +3753,11 @@ const file_qf_types_proto_rawDesc = "" +
 	"\benrolled\x18\f \x01(\x0e2\x19.qf.Enrollment.UserStatusB\x0fʵ\x03\v\xa2\x01\bgorm:\"-\"R\benrolled\x120\n" +
 	"\venrollments\x18\r \x03(\v2\x0e.qf.EnrollmentR\venrollments\x120\n" +
 	"\vassignments\x18\x0e \x03(\v2\x0e.qf.AssignmentR\vassignments\x12!\n" +
-	"\x06groups\x18\x0f \x03(\v2\t.qf.GroupR\x06groups\"/\n" +
+	"\x06groups\x18\x0f \x03(\v2\t.qf.GroupR\x06groups\x12 \n" +
+	"\vautoRebuild\x18\x10 \x01(\bR\vautoRebuild\x12.\n" +
+	"\x12solutionRepository\x18\x11 \x01(\tR\x12solutionRepository\x12&\n" +
+	"\x0esolutionBranch\x18\x12 \x01(\tR\x0esolutionBranch\x12 \n" +
+	"\vleaderboard\x18\x13 \x01(\bR\vleaderboard\"/\n" +
 	"\aCourses\x12$\n" +
 	"\acourses\x18\x01 \x03(\v2\n" +
 	".qf.CourseR\acourses\"\xf9\x03\n" +
@@ -2644,7 +3814,7 @@ const file_qf_types_proto_rawDesc = "" +
 	"\busedDays\x18\x04 \x01(\rR\busedDays\x12\x18\n" +
 	"\agroupID\x18\x05 \x01(\x04R\agroupID\"?\n" +
 	"\vEnrollments\x120\n" +
-	"\venrollments\x18\x01 \x03(\v2\x0e.qf.EnrollmentR\venrollments\"\xc2\x04\n" +
+	"\venrollments\x18\x01 \x03(\v2\x0e.qf.EnrollmentR\venrollments\"\x86\a\n" +
 	"\n" +
 	"Assignment\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x1a\n" +
@@ -2665,14 +3835,26 @@ const file_qf_types_proto_rawDesc = "" +
 	"\vsubmissions\x18\v \x03(\v2\x0e.qf.SubmissionR\vsubmissions\x12\x1e\n" +
 	"\x05tasks\x18\f \x03(\v2\b.qf.TaskR\x05tasks\x12B\n" +
 	"\x11gradingBenchmarks\x18\r \x03(\v2\x14.qf.GradingBenchmarkR\x11gradingBenchmarks\x122\n" +
-	"\rExpectedTests\x18\x0e \x03(\v2\f.qf.TestInfoR\rExpectedTests\"\xf0\x01\n" +
+	"\rExpectedTests\x18\x0e \x03(\v2\f.qf.TestInfoR\rExpectedTests\x12 \n" +
+	"\vmemoryLimit\x18\x0f \x01(\rR\vmemoryLimit\x12\x1a\n" +
+	"\bcpuLimit\x18\x10 \x01(\rR\bcpuLimit\x12\x1c\n" +
+	"\tpidsLimit\x18\x11 \x01(\rR\tpidsLimit\x12$\n" +
+	"\rfileSizeLimit\x18\x12 \x01(\rR\rfileSizeLimit\x12 \n" +
+	"\vtestRetries\x18\x13 \x01(\rR\vtestRetries\x12,\n" +
+	"\x11coverageThreshold\x18\x14 \x01(\rR\x11coverageThreshold\x12&\n" +
+	"\x0ecoverageWeight\x18\x15 \x01(\rR\x0ecoverageWeight\x12 \n" +
+	"\vlintPenalty\x18\x16 \x01(\rR\vlintPenalty\x12&\n" +
+	"\x0emaxLintPenalty\x18\x17 \x01(\rR\x0emaxLintPenalty\"\xeb\x02\n" +
 	"\bTestInfo\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12F\n" +
 	"\fAssignmentID\x18\x02 \x01(\x04B\"ʵ\x03\x1e\xa2\x01\x1bgorm:\"uniqueIndex:testinfo\"R\fAssignmentID\x12>\n" +
 	"\bTestName\x18\x03 \x01(\tB\"ʵ\x03\x1e\xa2\x01\x1bgorm:\"uniqueIndex:testinfo\"R\bTestName\x12\x1a\n" +
 	"\bMaxScore\x18\x04 \x01(\x05R\bMaxScore\x12\x16\n" +
 	"\x06Weight\x18\x05 \x01(\x05R\x06Weight\x12\x18\n" +
-	"\aDetails\x18\x06 \x01(\tR\aDetails\"\xc5\x01\n" +
+	"\aDetails\x18\x06 \x01(\tR\aDetails\x12\x16\n" +
+	"\x06Hidden\x18\a \x01(\bR\x06Hidden\x12\x14\n" +
+	"\x05Group\x18\b \x01(\tR\x05Group\x12K\n" +
+	"\fGroupWeights\x18\t \x03(\x05B'ʵ\x03#\xa2\x01 gorm:\"serializer:json;type:text\"R\fGroupWeights\"\xc5\x01\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\"\n" +
 	"\fassignmentID\x18\x02 \x01(\x04R\fassignmentID\x12(\n" +
@@ -2731,7 +3913,99 @@ const file_qf_types_proto_rawDesc = "" +
 	"\x05Grade\x12C\n" +
 	"\fSubmissionID\x18\x01 \x01(\x04B\x1fʵ\x03\x1b\xa2\x01\x18gorm:\"uniqueIndex:grade\"R\fSubmissionID\x127\n" +
 	"\x06UserID\x18\x02 \x01(\x04B\x1fʵ\x03\x1b\xa2\x01\x18gorm:\"uniqueIndex:grade\"R\x06UserID\x12-\n" +
-	"\x06Status\x18\x03 \x01(\x0e2\x15.qf.Submission.StatusR\x06Status\"\x8a\x02\n" +
+	"\x06Status\x18\x03 \x01(\x0e2\x15.qf.Submission.StatusR\x06Status\"\x86\t\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x1a\n" +
+	"\bCourseID\x18\x02 \x01(\x04R\bCourseID\x12\"\n" +
+	"\fAssignmentID\x18\x03 \x01(\x04R\fAssignmentID\x12\"\n" +
+	"\fRepositoryID\x18\x04 \x01(\x04R\fRepositoryID\x12\"\n" +
+	"\fSubmissionID\x18\x05 \x01(\x04R\fSubmissionID\x12\x1e\n" +
+	"\n" +
+	"BranchName\x18\x06 \x01(\tR\n" +
+	"BranchName\x12\x1a\n" +
+	"\bCommitID\x18\a \x01(\tR\bCommitID\x12\x1a\n" +
+	"\bJobOwner\x18\b \x01(\tR\bJobOwner\x12\x18\n" +
+	"\aRebuild\x18\t \x01(\bR\aRebuild\x12&\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x0e.qf.Job.StatusR\x06status\x12\x14\n" +
+	"\x05Error\x18\v \x01(\tR\x05Error\x12j\n" +
+	"\tCreatedAt\x18\f \x01(\v2\x1a.google.protobuf.TimestampB0ʵ\x03,\xa2\x01)gorm:\"serializer:timestamp;type:datetime\"R\tCreatedAt\x12j\n" +
+	"\tUpdatedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampB0ʵ\x03,\xa2\x01)gorm:\"serializer:timestamp;type:datetime\"R\tUpdatedAt\x12\x1c\n" +
+	"\tRebuildID\x18\x0e \x01(\x04R\tRebuildID\x12\x14\n" +
+	"\x05Force\x18\x0f \x01(\bR\x05Force\x12$\n" +
+	"\rPreviousScore\x18\x10 \x01(\rR\rPreviousScore\x12\x14\n" +
+	"\x05Score\x18\x11 \x01(\rR\x05Score\x12\x16\n" +
+	"\x06DryRun\x18\x12 \x01(\bR\x06DryRun\x12 \n" +
+	"\vTestsBranch\x18\x13 \x01(\tR\vTestsBranch\x12G\n" +
+	"\n" +
+	"NowPassing\x18\x14 \x03(\tB'ʵ\x03#\xa2\x01 gorm:\"serializer:json;type:text\"R\n" +
+	"NowPassing\x12G\n" +
+	"\n" +
+	"NowFailing\x18\x15 \x03(\tB'ʵ\x03#\xa2\x01 gorm:\"serializer:json;type:text\"R\n" +
+	"NowFailing\x12\x1a\n" +
+	"\bSolution\x18\x16 \x01(\bR\bSolution\x12 \n" +
+	"\vTestsCommit\x18\x17 \x01(\tR\vTestsCommit\x12K\n" +
+	"\fFailingTests\x18\x18 \x03(\tB'ʵ\x03#\xa2\x01 gorm:\"serializer:json;type:text\"R\fFailingTests\x12K\n" +
+	"\fMissingTests\x18\x19 \x03(\tB'ʵ\x03#\xa2\x01 gorm:\"serializer:json;type:text\"R\fMissingTests\"K\n" +
+	"\x06Status\x12\n" +
+	"\n" +
+	"\x06QUEUED\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\x12\r\n" +
+	"\tCANCELLED\x10\x04\"\x9d\x02\n" +
+	"\aRebuild\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x1a\n" +
+	"\bCourseID\x18\x02 \x01(\x04R\bCourseID\x12\"\n" +
+	"\fAssignmentID\x18\x03 \x01(\x04R\fAssignmentID\x12j\n" +
+	"\tCreatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB0ʵ\x03,\xa2\x01)gorm:\"serializer:timestamp;type:datetime\"R\tCreatedAt\x12\x1c\n" +
+	"\tAutomatic\x18\x05 \x01(\bR\tAutomatic\x12\x16\n" +
+	"\x06DryRun\x18\x06 \x01(\bR\x06DryRun\x12 \n" +
+	"\vTestsBranch\x18\a \x01(\tR\vTestsBranch\"\xe5\x01\n" +
+	"\x0eRebuildSummary\x12%\n" +
+	"\arebuild\x18\x01 \x01(\v2\v.qf.RebuildR\arebuild\x12/\n" +
+	"\bprogress\x18\x02 \x01(\v2\x13.qf.RebuildProgressR\bprogress\x12\x1c\n" +
+	"\tincreased\x18\x03 \x01(\rR\tincreased\x12\x1c\n" +
+	"\tdecreased\x18\x04 \x01(\rR\tdecreased\x12\x1c\n" +
+	"\tunchanged\x18\x05 \x01(\rR\tunchanged\x12!\n" +
+	"\achanged\x18\x06 \x03(\v2\a.qf.JobR\achanged\"D\n" +
+	"\x10RebuildSummaries\x120\n" +
+	"\tsummaries\x18\x01 \x03(\v2\x12.qf.RebuildSummaryR\tsummaries\"_\n" +
+	"\tFlakyTest\x12\x1a\n" +
+	"\btestName\x18\x01 \x01(\tR\btestName\x12\x14\n" +
+	"\x05flaky\x18\x02 \x01(\rR\x05flaky\x12 \n" +
+	"\vsubmissions\x18\x03 \x01(\rR\vsubmissions\"1\n" +
+	"\n" +
+	"FlakyTests\x12#\n" +
+	"\x05tests\x18\x01 \x03(\v2\r.qf.FlakyTestR\x05tests\"\x82\x01\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\rR\x04rank\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06userID\x18\x03 \x01(\x04R\x06userID\x12\x18\n" +
+	"\agroupID\x18\x04 \x01(\x04R\agroupID\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\"=\n" +
+	"\vLeaderboard\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.qf.LeaderboardEntryR\aentries\"*\n" +
+	"\vTestsHealth\x12\x1b\n" +
+	"\x04jobs\x18\x01 \x03(\v2\a.qf.JobR\x04jobs\"\xb4\x01\n" +
+	"\x0fRebuildProgress\x12\x1c\n" +
+	"\trebuildID\x18\x01 \x01(\x04R\trebuildID\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\x12\x1c\n" +
+	"\tsucceeded\x18\x03 \x01(\rR\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\rR\x06failed\x12\x1c\n" +
+	"\tcancelled\x18\x05 \x01(\rR\tcancelled\x12\x19\n" +
+	"\x03job\x18\x06 \x01(\v2\a.qf.JobR\x03job\"8\n" +
+	"\bBuildLog\x12\x14\n" +
+	"\x05jobID\x18\x01 \x01(\x04R\x05jobID\x12\x16\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\"f\n" +
+	"\x10StaleSubmissions\x12 \n" +
+	"\vtestsCommit\x18\x01 \x01(\tR\vtestsCommit\x120\n" +
+	"\vsubmissions\x18\x02 \x03(\v2\x0e.qf.SubmissionR\vsubmissions\"k\n" +
+	"\x0fBuildLogArchive\x12\"\n" +
+	"\fsubmissionID\x18\x01 \x01(\x04R\fsubmissionID\x12\x1a\n" +
+	"\bcommitID\x18\x02 \x01(\tR\bcommitID\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\x8a\x02\n" +
 	"\x10GradingBenchmark\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\x04R\x02ID\x12\x1a\n" +
 	"\bCourseID\x18\x02 \x01(\x04R\bCourseID\x12\"\n" +
@@ -2795,8 +4069,8 @@ func file_qf_types_proto_rawDescGZIP() []byte {
 	return file_qf_types_proto_rawDescData
 }
 
-var file_qf_types_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_qf_types_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_qf_types_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_qf_types_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_qf_types_proto_goTypes = []any{
 	(Group_GroupStatus)(0),        // 0: qf.Group.GroupStatus
 	(Repository_Type)(0),          // 1: qf.Repository.Type
@@ -2804,88 +4078,115 @@ var file_qf_types_proto_goTypes = []any{
 	(Enrollment_DisplayState)(0),  // 3: qf.Enrollment.DisplayState
 	(PullRequest_Stage)(0),        // 4: qf.PullRequest.Stage
 	(Submission_Status)(0),        // 5: qf.Submission.Status
-	(GradingCriterion_Grade)(0),   // 6: qf.GradingCriterion.Grade
-	(*User)(nil),                  // 7: qf.User
-	(*Users)(nil),                 // 8: qf.Users
-	(*Group)(nil),                 // 9: qf.Group
-	(*Groups)(nil),                // 10: qf.Groups
-	(*Course)(nil),                // 11: qf.Course
-	(*Courses)(nil),               // 12: qf.Courses
-	(*Repository)(nil),            // 13: qf.Repository
-	(*Enrollment)(nil),            // 14: qf.Enrollment
-	(*UsedSlipDays)(nil),          // 15: qf.UsedSlipDays
-	(*Enrollments)(nil),           // 16: qf.Enrollments
-	(*Assignment)(nil),            // 17: qf.Assignment
-	(*TestInfo)(nil),              // 18: qf.TestInfo
-	(*Task)(nil),                  // 19: qf.Task
-	(*Issue)(nil),                 // 20: qf.Issue
-	(*PullRequest)(nil),           // 21: qf.PullRequest
-	(*Assignments)(nil),           // 22: qf.Assignments
-	(*Submission)(nil),            // 23: qf.Submission
-	(*Submissions)(nil),           // 24: qf.Submissions
-	(*Grade)(nil),                 // 25: qf.Grade
-	(*GradingBenchmark)(nil),      // 26: qf.GradingBenchmark
-	(*Benchmarks)(nil),            // 27: qf.Benchmarks
-	(*GradingCriterion)(nil),      // 28: qf.GradingCriterion
-	(*Review)(nil),                // 29: qf.Review
-	(*AssignmentFeedback)(nil),    // 30: qf.AssignmentFeedback
-	(*FeedbackReceipt)(nil),       // 31: qf.FeedbackReceipt
-	(*AssignmentFeedbacks)(nil),   // 32: qf.AssignmentFeedbacks
-	(*timestamppb.Timestamp)(nil), // 33: google.protobuf.Timestamp
-	(*score.BuildInfo)(nil),       // 34: score.BuildInfo
-	(*score.Score)(nil),           // 35: score.Score
+	(Job_Status)(0),               // 6: qf.Job.Status
+	(GradingCriterion_Grade)(0),   // 7: qf.GradingCriterion.Grade
+	(*User)(nil),                  // 8: qf.User
+	(*Users)(nil),                 // 9: qf.Users
+	(*Group)(nil),                 // 10: qf.Group
+	(*Groups)(nil),                // 11: qf.Groups
+	(*Course)(nil),                // 12: qf.Course
+	(*Courses)(nil),               // 13: qf.Courses
+	(*Repository)(nil),            // 14: qf.Repository
+	(*Enrollment)(nil),            // 15: qf.Enrollment
+	(*UsedSlipDays)(nil),          // 16: qf.UsedSlipDays
+	(*Enrollments)(nil),           // 17: qf.Enrollments
+	(*Assignment)(nil),            // 18: qf.Assignment
+	(*TestInfo)(nil),              // 19: qf.TestInfo
+	(*Task)(nil),                  // 20: qf.Task
+	(*Issue)(nil),                 // 21: qf.Issue
+	(*PullRequest)(nil),           // 22: qf.PullRequest
+	(*Assignments)(nil),           // 23: qf.Assignments
+	(*Submission)(nil),            // 24: qf.Submission
+	(*Submissions)(nil),           // 25: qf.Submissions
+	(*Grade)(nil),                 // 26: qf.Grade
+	(*Job)(nil),                   // 27: qf.Job
+	(*Rebuild)(nil),               // 28: qf.Rebuild
+	(*RebuildSummary)(nil),        // 29: qf.RebuildSummary
+	(*RebuildSummaries)(nil),      // 30: qf.RebuildSummaries
+	(*FlakyTest)(nil),             // 31: qf.FlakyTest
+	(*FlakyTests)(nil),            // 32: qf.FlakyTests
+	(*LeaderboardEntry)(nil),      // 33: qf.LeaderboardEntry
+	(*Leaderboard)(nil),           // 34: qf.Leaderboard
+	(*TestsHealth)(nil),           // 35: qf.TestsHealth
+	(*RebuildProgress)(nil),       // 36: qf.RebuildProgress
+	(*BuildLog)(nil),              // 37: qf.BuildLog
+	(*StaleSubmissions)(nil),      // 38: qf.StaleSubmissions
+	(*BuildLogArchive)(nil),       // 39: qf.BuildLogArchive
+	(*GradingBenchmark)(nil),      // 40: qf.GradingBenchmark
+	(*Benchmarks)(nil),            // 41: qf.Benchmarks
+	(*GradingCriterion)(nil),      // 42: qf.GradingCriterion
+	(*Review)(nil),                // 43: qf.Review
+	(*AssignmentFeedback)(nil),    // 44: qf.AssignmentFeedback
+	(*FeedbackReceipt)(nil),       // 45: qf.FeedbackReceipt
+	(*AssignmentFeedbacks)(nil),   // 46: qf.AssignmentFeedbacks
+	(*timestamppb.Timestamp)(nil), // 47: google.protobuf.Timestamp
+	(*score.BuildInfo)(nil),       // 48: score.BuildInfo
+	(*score.Score)(nil),           // 49: score.Score
 }
 var file_qf_types_proto_depIdxs = []int32{
-	14, // 0: qf.User.Enrollments:type_name -> qf.Enrollment
-	31, // 1: qf.User.FeedbackReceipts:type_name -> qf.FeedbackReceipt
-	7,  // 2: qf.Users.users:type_name -> qf.User
+	15, // 0: qf.User.Enrollments:type_name -> qf.Enrollment
+	45, // 1: qf.User.FeedbackReceipts:type_name -> qf.FeedbackReceipt
+	8,  // 2: qf.Users.users:type_name -> qf.User
 	0,  // 3: qf.Group.status:type_name -> qf.Group.GroupStatus
-	7,  // 4: qf.Group.users:type_name -> qf.User
-	14, // 5: qf.Group.enrollments:type_name -> qf.Enrollment
-	15, // 6: qf.Group.usedSlipDays:type_name -> qf.UsedSlipDays
-	9,  // 7: qf.Groups.groups:type_name -> qf.Group
+	8,  // 4: qf.Group.users:type_name -> qf.User
+	15, // 5: qf.Group.enrollments:type_name -> qf.Enrollment
+	16, // 6: qf.Group.usedSlipDays:type_name -> qf.UsedSlipDays
+	10, // 7: qf.Groups.groups:type_name -> qf.Group
 	2,  // 8: qf.Course.enrolled:type_name -> qf.Enrollment.UserStatus
-	14, // 9: qf.Course.enrollments:type_name -> qf.Enrollment
-	17, // 10: qf.Course.assignments:type_name -> qf.Assignment
-	9,  // 11: qf.Course.groups:type_name -> qf.Group
-	11, // 12: qf.Courses.courses:type_name -> qf.Course
+	15, // 9: qf.Course.enrollments:type_name -> qf.Enrollment
+	18, // 10: qf.Course.assignments:type_name -> qf.Assignment
+	10, // 11: qf.Course.groups:type_name -> qf.Group
+	12, // 12: qf.Courses.courses:type_name -> qf.Course
 	1,  // 13: qf.Repository.repoType:type_name -> qf.Repository.Type
-	20, // 14: qf.Repository.issues:type_name -> qf.Issue
-	7,  // 15: qf.Enrollment.user:type_name -> qf.User
-	11, // 16: qf.Enrollment.course:type_name -> qf.Course
-	9,  // 17: qf.Enrollment.group:type_name -> qf.Group
+	21, // 14: qf.Repository.issues:type_name -> qf.Issue
+	8,  // 15: qf.Enrollment.user:type_name -> qf.User
+	12, // 16: qf.Enrollment.course:type_name -> qf.Course
+	10, // 17: qf.Enrollment.group:type_name -> qf.Group
 	2,  // 18: qf.Enrollment.status:type_name -> qf.Enrollment.UserStatus
 	3,  // 19: qf.Enrollment.state:type_name -> qf.Enrollment.DisplayState
-	33, // 20: qf.Enrollment.lastActivityDate:type_name -> google.protobuf.Timestamp
-	15, // 21: qf.Enrollment.usedSlipDays:type_name -> qf.UsedSlipDays
-	14, // 22: qf.Enrollments.enrollments:type_name -> qf.Enrollment
-	33, // 23: qf.Assignment.deadline:type_name -> google.protobuf.Timestamp
-	23, // 24: qf.Assignment.submissions:type_name -> qf.Submission
-	19, // 25: qf.Assignment.tasks:type_name -> qf.Task
-	26, // 26: qf.Assignment.gradingBenchmarks:type_name -> qf.GradingBenchmark
-	18, // 27: qf.Assignment.ExpectedTests:type_name -> qf.TestInfo
-	20, // 28: qf.Task.issues:type_name -> qf.Issue
+	47, // 20: qf.Enrollment.lastActivityDate:type_name -> google.protobuf.Timestamp
+	16, // 21: qf.Enrollment.usedSlipDays:type_name -> qf.UsedSlipDays
+	15, // 22: qf.Enrollments.enrollments:type_name -> qf.Enrollment
+	47, // 23: qf.Assignment.deadline:type_name -> google.protobuf.Timestamp
+	24, // 24: qf.Assignment.submissions:type_name -> qf.Submission
+	20, // 25: qf.Assignment.tasks:type_name -> qf.Task
+	40, // 26: qf.Assignment.gradingBenchmarks:type_name -> qf.GradingBenchmark
+	19, // 27: qf.Assignment.ExpectedTests:type_name -> qf.TestInfo
+	21, // 28: qf.Task.issues:type_name -> qf.Issue
 	4,  // 29: qf.PullRequest.stage:type_name -> qf.PullRequest.Stage
-	17, // 30: qf.Assignments.assignments:type_name -> qf.Assignment
-	25, // 31: qf.Submission.Grades:type_name -> qf.Grade
-	33, // 32: qf.Submission.approvedDate:type_name -> google.protobuf.Timestamp
-	29, // 33: qf.Submission.reviews:type_name -> qf.Review
-	34, // 34: qf.Submission.BuildInfo:type_name -> score.BuildInfo
-	35, // 35: qf.Submission.Scores:type_name -> score.Score
-	23, // 36: qf.Submissions.submissions:type_name -> qf.Submission
+	18, // 30: qf.Assignments.assignments:type_name -> qf.Assignment
+	26, // 31: qf.Submission.Grades:type_name -> qf.Grade
+	47, // 32: qf.Submission.approvedDate:type_name -> google.protobuf.Timestamp
+	43, // 33: qf.Submission.reviews:type_name -> qf.Review
+	48, // 34: qf.Submission.BuildInfo:type_name -> score.BuildInfo
+	49, // 35: qf.Submission.Scores:type_name -> score.Score
+	24, // 36: qf.Submissions.submissions:type_name -> qf.Submission
 	5,  // 37: qf.Grade.Status:type_name -> qf.Submission.Status
-	28, // 38: qf.GradingBenchmark.criteria:type_name -> qf.GradingCriterion
-	26, // 39: qf.Benchmarks.benchmarks:type_name -> qf.GradingBenchmark
-	6,  // 40: qf.GradingCriterion.grade:type_name -> qf.GradingCriterion.Grade
-	26, // 41: qf.Review.gradingBenchmarks:type_name -> qf.GradingBenchmark
-	33, // 42: qf.Review.edited:type_name -> google.protobuf.Timestamp
-	33, // 43: qf.AssignmentFeedback.CreatedAt:type_name -> google.protobuf.Timestamp
-	30, // 44: qf.AssignmentFeedbacks.feedbacks:type_name -> qf.AssignmentFeedback
-	45, // [45:45] is the sub-list for method output_type
-	45, // [45:45] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+	6,  // 38: qf.Job.status:type_name -> qf.Job.Status
+	47, // 39: qf.Job.CreatedAt:type_name -> google.protobuf.Timestamp
+	47, // 40: qf.Job.UpdatedAt:type_name -> google.protobuf.Timestamp
+	47, // 41: qf.Rebuild.CreatedAt:type_name -> google.protobuf.Timestamp
+	28, // 42: qf.RebuildSummary.rebuild:type_name -> qf.Rebuild
+	36, // 43: qf.RebuildSummary.progress:type_name -> qf.RebuildProgress
+	27, // 44: qf.RebuildSummary.changed:type_name -> qf.Job
+	29, // 45: qf.RebuildSummaries.summaries:type_name -> qf.RebuildSummary
+	31, // 46: qf.FlakyTests.tests:type_name -> qf.FlakyTest
+	33, // 47: qf.Leaderboard.entries:type_name -> qf.LeaderboardEntry
+	27, // 48: qf.TestsHealth.jobs:type_name -> qf.Job
+	27, // 49: qf.RebuildProgress.job:type_name -> qf.Job
+	24, // 50: qf.StaleSubmissions.submissions:type_name -> qf.Submission
+	42, // 51: qf.GradingBenchmark.criteria:type_name -> qf.GradingCriterion
+	40, // 52: qf.Benchmarks.benchmarks:type_name -> qf.GradingBenchmark
+	7,  // 53: qf.GradingCriterion.grade:type_name -> qf.GradingCriterion.Grade
+	40, // 54: qf.Review.gradingBenchmarks:type_name -> qf.GradingBenchmark
+	47, // 55: qf.Review.edited:type_name -> google.protobuf.Timestamp
+	47, // 56: qf.AssignmentFeedback.CreatedAt:type_name -> google.protobuf.Timestamp
+	44, // 57: qf.AssignmentFeedbacks.feedbacks:type_name -> qf.AssignmentFeedback
+	58, // [58:58] is the sub-list for method output_type
+	58, // [58:58] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_qf_types_proto_init() }
@@ -2898,8 +4199,8 @@ func file_qf_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_qf_types_proto_rawDesc), len(file_qf_types_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   26,
+			NumEnums:      8,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
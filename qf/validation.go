@@ -1,5 +1,7 @@
 package qf
 
+import "strings"
+
 // IsValid on void message always returns true.
 func (*Void) IsValid() bool {
 	return true
@@ -10,13 +12,15 @@ func (grp *Group) IsValid() bool {
 	return grp.GetCourseID() > 0 && grp.GetName() != "" && len(grp.GetUsers()) > 0
 }
 
-// IsValid ensures that all required fields of a course are set.
+// IsValid ensures that all required fields of a course are set,
+// and that the solution repository, if set, is a repository name rather than a path.
 func (c *Course) IsValid() bool {
 	return c.GetName() != "" &&
 		c.GetCode() != "" &&
 		c.GetScmOrganizationID() != 0 &&
 		c.GetYear() != 0 &&
-		c.GetTag() != ""
+		c.GetTag() != "" &&
+		!strings.ContainsAny(c.GetSolutionRepository(), "/\\")
 }
 
 // IsValid ensures that UserID is set.
@@ -87,9 +91,44 @@ func (req *EnrollmentRequest) IsValid() bool {
 }
 
 // IsValid ensures that both CourseID and AssignmentID are set.
+// A dry run must not select a submission, and only a dry run may select a tests branch.
 func (req *RebuildRequest) IsValid() bool {
 	aid, cid := req.GetAssignmentID(), req.GetCourseID()
-	return aid > 0 && cid > 0
+	if req.GetDryRun() {
+		// dry runs rebuild all submissions for the assignment
+		return aid > 0 && cid > 0 && req.GetSubmissionID() == 0
+	}
+	return aid > 0 && cid > 0 && req.GetTestsBranch() == ""
+}
+
+// IsValid ensures that both CourseID and RebuildID are set.
+func (req *RebuildStatusRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetRebuildID() > 0
+}
+
+// IsValid ensures that CourseID, AssignmentID, and either UserID or GroupID are set.
+func (req *BuildLogRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0 && (req.GetUserID() > 0 || req.GetGroupID() > 0)
+}
+
+// IsValid ensures that CourseID and SubmissionID are set.
+func (req *BuildLogArchiveRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that both CourseID and AssignmentID are set.
+func (req *StaleSubmissionsRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that both CourseID and AssignmentID are set.
+func (req *FlakyTestsRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that course ID, assignment ID and test name are set.
+func (req *LeaderboardRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0 && req.GetTestName() != ""
 }
 
 // IsValid ensures that an SCM organization name is set.
@@ -24,6 +24,25 @@ func isTeacher(ctx context.Context, courseID uint64) bool {
 	return claims.Courses[courseID] == qf.Enrollment_TEACHER
 }
 
+// canFollowBuildLog returns true if the user is a teacher in the request's course,
+// or a student in the course that owns the requested test run, alone or as a group member.
+func canFollowBuildLog(ctx context.Context, req *qf.BuildLogRequest) bool {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return false
+	}
+	if claims.IsCourseTeacher(req.GetCourseID()) {
+		return true
+	}
+	if !claims.IsCourseStudent(req.GetCourseID()) {
+		return false
+	}
+	if req.GetGroupID() > 0 {
+		return claims.IsInGroup(req)
+	}
+	return claims.SameUser(req)
+}
+
 // courseStatus returns the user status in the given course.
 func courseStatus(ctx context.Context, courseID uint64) qf.Enrollment_UserStatus {
 	claims, ok := auth.ClaimsFromContext(ctx)
@@ -0,0 +1,55 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// testRun identifies the test runs of an assignment in a user's or group's repository.
+type testRun struct {
+	assignmentID uint64
+	repositoryID uint64
+}
+
+// testRun returns the test run selected by the request.
+func (s *QuickFeedService) testRun(request *qf.BuildLogRequest) (testRun, error) {
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return testRun{}, err
+	}
+	if assignment.GetCourseID() != request.GetCourseID() {
+		return testRun{}, fmt.Errorf("assignment %d does not belong to course %d", assignment.GetID(), request.GetCourseID())
+	}
+	course, err := s.db.GetCourse(request.GetCourseID())
+	if err != nil {
+		return testRun{}, err
+	}
+	var repo *qf.Repository
+	if request.GetGroupID() > 0 {
+		repo, err = s.getRepo(course, request.GetGroupID(), qf.Repository_GROUP)
+	} else {
+		repo, err = s.getRepo(course, request.GetUserID(), qf.Repository_USER)
+	}
+	if err != nil {
+		return testRun{}, err
+	}
+	return testRun{assignmentID: assignment.GetID(), repositoryID: repo.GetID()}, nil
+}
+
+// sendBuildLog sends the output of the running job to the users following the job's test run.
+func (s *QuickFeedService) sendBuildLog(job *qf.Job, output string) {
+	userIDs := s.buildLogWatchers.users(testRun{assignmentID: job.GetAssignmentID(), repositoryID: job.GetRepositoryID()})
+	if len(userIDs) == 0 {
+		return
+	}
+	s.streams.BuildLog.SendTo(&qf.BuildLog{JobID: job.GetID(), Output: output}, userIDs...)
+}
+
+// closeBuildLogs closes the build log streams of the users following the finished job's test run.
+// The job's results, if any, have already been recorded and sent on the users' submission streams.
+func (s *QuickFeedService) closeBuildLogs(job *qf.Job) {
+	for _, userID := range s.buildLogWatchers.remove(testRun{assignmentID: job.GetAssignmentID(), repositoryID: job.GetRepositoryID()}) {
+		s.streams.BuildLog.CloseBy(userID)
+	}
+}
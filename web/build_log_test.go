@@ -0,0 +1,144 @@
+package web
+
+import (
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web/auth"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestBuildLogStreamAccess(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	s := NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	teacher, course, assignment, student := qtest.SetupCourseAssignmentTeacherStudent(t, db)
+
+	teacherClaims := &auth.Claims{UserID: teacher.GetID(), Courses: map[uint64]qf.Enrollment_UserStatus{course.GetID(): qf.Enrollment_TEACHER}}
+	studentClaims := &auth.Claims{UserID: student.GetID(), Courses: map[uint64]qf.Enrollment_UserStatus{course.GetID(): qf.Enrollment_STUDENT}, Groups: []uint64{7}}
+	tests := []struct {
+		name    string
+		claims  *auth.Claims
+		request *qf.BuildLogRequest
+		wantErr error
+	}{
+		{
+			name:    "Invalid",
+			claims:  studentClaims,
+			request: &qf.BuildLogRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID()},
+			wantErr: connect.NewError(connect.CodeInvalidArgument, errors.New("invalid payload")),
+		},
+		{
+			name:    "OtherStudent",
+			claims:  studentClaims,
+			request: &qf.BuildLogRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID(), UserID: teacher.GetID()},
+			wantErr: connect.NewError(connect.CodePermissionDenied, errors.New("access denied for BuildLogStream")),
+		},
+		{
+			name:    "OtherGroup",
+			claims:  studentClaims,
+			request: &qf.BuildLogRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID(), GroupID: 8},
+			wantErr: connect.NewError(connect.CodePermissionDenied, errors.New("access denied for BuildLogStream")),
+		},
+		{
+			name:    "OtherCourse",
+			claims:  studentClaims,
+			request: &qf.BuildLogRequest{CourseID: course.GetID() + 1, AssignmentID: assignment.GetID(), UserID: student.GetID()},
+			wantErr: connect.NewError(connect.CodePermissionDenied, errors.New("access denied for BuildLogStream")),
+		},
+		{
+			name:    "StudentWithoutRepository",
+			claims:  studentClaims,
+			request: &qf.BuildLogRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID(), UserID: student.GetID()},
+			wantErr: connect.NewError(connect.CodeNotFound, errors.New("unknown test run")),
+		},
+		{
+			name:    "TeacherWithoutRepository",
+			claims:  teacherClaims,
+			request: &qf.BuildLogRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID(), GroupID: 8},
+			wantErr: connect.NewError(connect.CodeNotFound, errors.New("unknown test run")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.BuildLogStream(tt.claims.Context(t.Context()), tt.request, nil)
+			qtest.CheckError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSendBuildLog(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	s := NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+
+	const userID, otherUserID = 1, 2
+	run := testRun{assignmentID: 1, repositoryID: 2}
+	stream := qtest.NewMockStream[qf.BuildLog](t)
+	s.streams.BuildLog.Add(stream, userID)
+	s.buildLogWatchers.watch(userID, run)
+	otherStream := qtest.NewMockStream[qf.BuildLog](t)
+	s.streams.BuildLog.Add(otherStream, otherUserID)
+	s.buildLogWatchers.watch(otherUserID, testRun{assignmentID: 1, repositoryID: 3})
+
+	done := make(chan error)
+	go func() { done <- stream.Run() }()
+	go func() { _ = otherStream.Run() }()
+
+	job := &qf.Job{ID: 5, AssignmentID: run.assignmentID, RepositoryID: run.repositoryID}
+	s.sendBuildLog(job, "=== RUN   TestA\n")
+	s.sendBuildLog(job, "--- PASS: TestA\n")
+	s.closeBuildLogs(job)
+	if err := <-done; err == nil {
+		t.Error("stream not closed when the job finished")
+	}
+	want := []*qf.BuildLog{
+		{JobID: 5, Output: "=== RUN   TestA\n"},
+		{JobID: 5, Output: "--- PASS: TestA\n"},
+	}
+	qtest.Diff(t, "BuildLog mismatch", stream.Messages, want, protocmp.Transform())
+	if users := s.buildLogWatchers.users(run); len(users) != 0 {
+		t.Errorf("users(%+v) = %v, want none after the job finished", run, users)
+	}
+	if users := s.buildLogWatchers.users(testRun{assignmentID: 1, repositoryID: 3}); len(users) != 1 {
+		t.Errorf("users() = %v, want the other user", users)
+	}
+	otherStream.Close()
+}
+
+func TestGetBuildLogArchive(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	s := NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	_, course, assignment, student := qtest.SetupCourseAssignmentTeacherStudent(t, db)
+	submission := &qf.Submission{AssignmentID: assignment.GetID(), UserID: student.GetID(), CommitHash: "abc123"}
+	qtest.CreateSubmission(t, db, submission)
+
+	request := &qf.BuildLogArchiveRequest{CourseID: course.GetID(), SubmissionID: submission.GetID()}
+	_, err := s.GetBuildLogArchive(t.Context(), request)
+	qtest.CheckError(t, err, connect.NewError(connect.CodeUnimplemented, errors.New("build logs are not archived")))
+
+	archive := ci.NewLogArchive(t.TempDir(), 0)
+	s.ArchiveBuildLogs(archive)
+	_, err = s.GetBuildLogArchive(t.Context(), request)
+	qtest.CheckError(t, err, connect.NewError(connect.CodeNotFound, errors.New("no archived build log for submission")))
+
+	content := []byte("compressed build log")
+	if err := archive.Save(submission.GetID(), "abc123", content); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.GetBuildLogArchive(t.Context(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &qf.BuildLogArchive{SubmissionID: submission.GetID(), CommitID: "abc123", Content: content}
+	qtest.Diff(t, "BuildLogArchive mismatch", got, want, protocmp.Transform())
+
+	_, err = s.GetBuildLogArchive(t.Context(), &qf.BuildLogArchiveRequest{CourseID: course.GetID() + 1, SubmissionID: submission.GetID()})
+	qtest.CheckError(t, err, connect.NewError(connect.CodeNotFound, errors.New("unknown submission")))
+}
@@ -0,0 +1,111 @@
+package web_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"github.com/quickfeed/quickfeed/web/auth"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHiddenTests(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	q := web.NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	teacher, course, _, student := qtest.SetupCourseAssignmentTeacherStudent(t, db)
+
+	const (
+		buildLog       = "=== RUN   TestAdd\n--- PASS: TestAdd\n=== RUN   TestAddOverflow\n--- FAIL: TestAddOverflow\nFAIL"
+		hiddenBuildLog = "=== RUN   TestAdd\n--- PASS: TestAdd\nFAIL"
+	)
+	expectedTests := func() []*qf.TestInfo {
+		return []*qf.TestInfo{
+			{TestName: "TestAdd", MaxScore: 10, Weight: 1},
+			{TestName: "TestAddOverflow", MaxScore: 10, Weight: 1, Hidden: true},
+		}
+	}
+	// the hidden tests of the open assignment are hidden from students until its deadline
+	open := &qf.Assignment{CourseID: course.GetID(), Name: "lab2", Order: 2, Deadline: timestamppb.New(time.Now().Add(24 * time.Hour)), ExpectedTests: expectedTests()}
+	closed := &qf.Assignment{CourseID: course.GetID(), Name: "lab3", Order: 3, Deadline: timestamppb.New(time.Now().Add(-time.Hour)), ExpectedTests: expectedTests()}
+	for _, assignment := range []*qf.Assignment{open, closed} {
+		qtest.CreateAssignment(t, db, assignment)
+		qtest.CreateSubmission(t, db, &qf.Submission{
+			AssignmentID: assignment.GetID(),
+			UserID:       student.GetID(),
+			Score:        50,
+			BuildInfo:    &score.BuildInfo{BuildLog: buildLog},
+			Scores: []*score.Score{
+				{TestName: "TestAdd", Score: 10, MaxScore: 10, Weight: 1},
+				{TestName: "TestAddOverflow", Score: 0, MaxScore: 10, Weight: 1, TestDetails: "expected overflow error", Hidden: true},
+			},
+		})
+	}
+
+	teacherCtx := (&auth.Claims{UserID: teacher.GetID(), Courses: map[uint64]qf.Enrollment_UserStatus{course.GetID(): qf.Enrollment_TEACHER}}).Context(t.Context())
+	studentCtx := (&auth.Claims{UserID: student.GetID(), Courses: map[uint64]qf.Enrollment_UserStatus{course.GetID(): qf.Enrollment_STUDENT}}).Context(t.Context())
+	submissionRequest := &qf.SubmissionRequest{CourseID: course.GetID(), FetchMode: &qf.SubmissionRequest_UserID{UserID: student.GetID()}}
+	courseRequest := &qf.CourseRequest{CourseID: course.GetID()}
+
+	visible := []*score.Score{
+		{TestName: "TestAdd", Score: 10, MaxScore: 10, Weight: 1},
+		{TestName: "TestAddOverflow", Score: 0, MaxScore: 10, Weight: 1, TestDetails: "expected overflow error", Hidden: true},
+	}
+	hidden := []*score.Score{
+		{TestName: "TestAdd", Score: 10, MaxScore: 10, Weight: 1},
+		{TestName: "Hidden test 1", Score: 0, MaxScore: 10, Weight: 1, Hidden: true},
+	}
+	tests := []struct {
+		name           string
+		teacher        bool
+		assignmentID   uint64
+		wantScores     []*score.Score
+		wantBuildLog   string
+		wantTestsCount int
+	}{
+		{name: "TeacherOpen", teacher: true, assignmentID: open.GetID(), wantScores: visible, wantBuildLog: buildLog, wantTestsCount: 2},
+		{name: "StudentOpen", teacher: false, assignmentID: open.GetID(), wantScores: hidden, wantBuildLog: hiddenBuildLog, wantTestsCount: 1},
+		{name: "StudentClosed", teacher: false, assignmentID: closed.GetID(), wantScores: visible, wantBuildLog: buildLog, wantTestsCount: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := studentCtx
+			if tt.teacher {
+				ctx = teacherCtx
+			}
+			submissions, err := q.GetSubmissions(ctx, submissionRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got *qf.Submission
+			for _, submission := range submissions.GetSubmissions() {
+				if submission.GetAssignmentID() == tt.assignmentID {
+					got = submission
+				}
+			}
+			if got == nil {
+				t.Fatalf("GetSubmissions() has no submission for assignment %d", tt.assignmentID)
+			}
+			qtest.Diff(t, "scores mismatch", got.GetScores(), tt.wantScores, protocmp.Transform(), protocmp.IgnoreFields(&score.Score{}, "ID", "SubmissionID"))
+			if got := got.GetBuildInfo().GetBuildLog(); got != tt.wantBuildLog {
+				t.Errorf("BuildLog = %q, want %q", got, tt.wantBuildLog)
+			}
+
+			assignments, err := q.GetAssignments(ctx, courseRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, assignment := range assignments.GetAssignments() {
+				if assignment.GetID() == tt.assignmentID && len(assignment.GetExpectedTests()) != tt.wantTestsCount {
+					t.Errorf("GetAssignments() returned %d expected tests, want %d", len(assignment.GetExpectedTests()), tt.wantTestsCount)
+				}
+			}
+		})
+	}
+}
@@ -7,41 +7,51 @@ import (
 	"github.com/quickfeed/quickfeed/ci"
 	"github.com/quickfeed/quickfeed/database"
 	"github.com/quickfeed/quickfeed/internal/qlog"
+	"github.com/quickfeed/quickfeed/qf"
 	"github.com/quickfeed/quickfeed/scm"
 	"github.com/quickfeed/quickfeed/web/auth"
-	"github.com/quickfeed/quickfeed/web/stream"
 	"go.uber.org/zap"
 )
 
-// maxConcurrentTestRuns is the maximum number of concurrent test runs.
-const maxConcurrentTestRuns = 5
+// RebuildFunc enqueues jobs to rebuild the stale submissions for the given assignment.
+type RebuildFunc func(assignment *qf.Assignment) (*qf.Rebuild, error)
 
 // GitHubWebHook holds references and data for handling webhook events.
 type GitHubWebHook struct {
-	logger  *zap.SugaredLogger
-	db      database.Database
-	scmMgr  *scm.Manager
-	runner  ci.Runner
-	secret  string
-	streams *stream.StreamServices
-	sem     chan struct{} // counting semaphore: limit concurrent test runs to maxConcurrentTestRuns
-	dup     *Duplicates
-	tm      *auth.TokenManager
+	logger *zap.SugaredLogger
+	db     database.Database
+	scmMgr *scm.Manager
+	queue  *ci.Queue
+	secret string
+	dup    *Duplicates
+	tm     *auth.TokenManager
+	// rebuild is called for assignments whose tests are changed by a push to the tests repository
+	rebuild RebuildFunc
 }
 
 // NewGitHubWebHook creates a new webhook to handle POST requests from GitHub to the QuickFeed server.
-func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, mgr *scm.Manager, runner ci.Runner, secret string, streams *stream.StreamServices, tm *auth.TokenManager) *GitHubWebHook {
-	return &GitHubWebHook{
-		logger:  logger,
-		db:      db,
-		scmMgr:  mgr,
-		runner:  runner,
-		secret:  secret,
-		streams: streams,
-		sem:     make(chan struct{}, maxConcurrentTestRuns),
-		dup:     NewDuplicateMap(),
-		tm:      tm,
+// Test runs for pushed assignments are enqueued on the given job queue.
+func NewGitHubWebHook(logger *zap.SugaredLogger, db database.Database, mgr *scm.Manager, queue *ci.Queue, secret string, tm *auth.TokenManager) *GitHubWebHook {
+	wh := &GitHubWebHook{
+		logger: logger,
+		db:     db,
+		scmMgr: mgr,
+		queue:  queue,
+		secret: secret,
+		dup:    NewDuplicateMap(),
+		tm:     tm,
 	}
+	if queue != nil {
+		queue.Handle(wh.handlePullRequestResults)
+	}
+	return wh
+}
+
+// RebuildOnTestsChange registers the function used to rebuild submissions for assignments
+// whose tests are changed by a push to the tests repository.
+// Rebuilds are only triggered for courses with automatic rebuilds enabled.
+func (wh *GitHubWebHook) RebuildOnTestsChange(rebuild RebuildFunc) {
+	wh.rebuild = rebuild
 }
 
 // Handle take POST requests from GitHub, representing Push events
@@ -75,18 +85,16 @@ func (wh GitHubWebHook) Handle() http.HandlerFunc {
 				return
 			}
 
-			// The counting semaphore limits concurrency to maxConcurrentTestRuns.
-			// This should also allow webhook events to return quickly to GitHub, avoiding timeouts.
-			// Note however, if we receive a large number of push events, we may be creating
-			// a large number of goroutines. If this becomes a problem, we can add rate limiting
-			// on the number of goroutines created, by returning a http.StatusTooManyRequests.
+			// Pushes to the tests repository clone the tests and build the course's image before
+			// enqueueing any jobs; hence, the push is handled in the background, such that GitHub
+			// gets a response before its delivery timeout. The commitID stays in the duplicate map
+			// until the push is handled, such that redeliveries in the meantime are ignored.
 			go func() {
-				wh.sem <- struct{}{} // acquire semaphore
 				wh.handlePush(e)
-				<-wh.sem // release semaphore
 				// remove commitID from duplicate map (to avoid memory leak)
 				wh.dup.Remove(commitID)
 			}()
+			w.WriteHeader(http.StatusAccepted)
 
 		case *github.PullRequestEvent:
 			switch e.GetAction() {
@@ -8,13 +8,11 @@ import (
 	"text/template"
 	"time"
 
-	"github.com/quickfeed/quickfeed/ci"
 	"github.com/quickfeed/quickfeed/database"
 	"github.com/quickfeed/quickfeed/internal/qtest"
 	"github.com/quickfeed/quickfeed/qf"
 	"github.com/quickfeed/quickfeed/scm"
 	"github.com/quickfeed/quickfeed/web/auth"
-	"github.com/quickfeed/quickfeed/web/stream"
 )
 
 func TestReceiveInstallationEvent(t *testing.T) {
@@ -321,7 +319,7 @@ func setupWebhook(t *testing.T, db database.Database) (*GitHubWebHook, *httptest
 	if err != nil {
 		t.Fatal(err)
 	}
-	wh := NewGitHubWebHook(qtest.Logger(t), db, mgr, &ci.Local{}, "", stream.NewStreamServices(), tm)
+	wh := NewGitHubWebHook(qtest.Logger(t), db, mgr, nil, "", tm)
 
 	router := http.NewServeMux()
 	router.HandleFunc("/hook/", wh.Handle())
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v62/github"
 	"github.com/quickfeed/quickfeed/assignments"
@@ -14,18 +15,30 @@ import (
 	"github.com/quickfeed/quickfeed/kit/score"
 	"github.com/quickfeed/quickfeed/qf"
 	"github.com/quickfeed/quickfeed/scm"
+	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 )
 
+// handlePullRequestResults is called by the job queue when the results of a test run have been recorded.
+// Jobs for a non-default branch of a group repository are associated with a pull request.
+func (wh GitHubWebHook) handlePullRequestResults(ctx context.Context, scmClient scm.SCM, job *qf.Job, rd *ci.RunData, results *score.Results, _ *qf.Submission) {
+	if job.GetBranchName() == "" || !rd.Repo.IsGroupRepo() || results == nil {
+		return
+	}
+	// Attempt to find the pull request for the branch, if it exists,
+	// and then assign reviewers to it, if the branch task score is higher than the assignment score limit
+	wh.handlePullRequestPush(ctx, scmClient, job.GetBranchName(), results, rd)
+}
+
 // handlePullRequestPush attempts to find a pull request associated with a non-default branch push event.
 // If successful, it then finds the relevant task, and uses it to retrieve the relevant task score.
 // If a passing score is reached, it assigns reviewers to the pull request.
 // It also uses the test results and task to generate a feedback comment for the pull request.
-func (wh GitHubWebHook) handlePullRequestPush(ctx context.Context, scmClient scm.SCM, payload *github.PushEvent, results *score.Results, rd *ci.RunData) {
-	wh.logger.Debugf("Attempting to find pull request for ref: %s, in repository: %s",
-		payload.GetRef(), payload.GetRepo().GetFullName())
+func (wh GitHubWebHook) handlePullRequestPush(ctx context.Context, scmClient scm.SCM, branch string, results *score.Results, rd *ci.RunData) {
+	wh.logger.Debugf("Attempting to find pull request for branch: %s, in repository: %s",
+		branch, rd.Repo.Name())
 
-	pullRequest, err := wh.getPullRequest(payload)
+	pullRequest, err := wh.getPullRequest(branch, rd.Repo.GetScmRepositoryID())
 	if err != nil {
 		wh.logger.Errorf("Failed to retrieve pull request data from push payload: %v", err)
 		return
@@ -54,7 +67,7 @@ func (wh GitHubWebHook) handlePullRequestPush(ctx context.Context, scmClient scm
 	opt := &scm.IssueCommentOptions{
 		Organization: rd.Course.GetScmOrganizationName(),
 		Repository:   repoName,
-		Body:         results.MarkdownComment(task.GetName(), scoreLimit),
+		Body:         feedbackComment(results, task.GetName(), rd.Assignment, time.Now()),
 		Number:       int(prNumber),
 	}
 	wh.logger.Debugf("Creating feedback comment on pull request #%d, in repository: %s", prNumber, repoName)
@@ -79,17 +92,32 @@ func (wh GitHubWebHook) handlePullRequestPush(ctx context.Context, scmClient scm
 	wh.logger.Debugf("Successfully handled push to pull request #%d, in repository: %s", prNumber, repoName)
 }
 
-// getPullRequest retrieves the pull request from the database based on the push event payload.
-func (wh GitHubWebHook) getPullRequest(payload *github.PushEvent) (*qf.PullRequest, error) {
+// feedbackComment returns the test results feedback comment for the given task.
+// The comment is visible to the students; hence, before the assignment's deadline,
+// the names and details of the hidden tests are replaced with placeholders.
+func feedbackComment(results *score.Results, taskName string, assignment *qf.Assignment, now time.Time) string {
+	if assignment.SinceDeadline(now) < 0 {
+		scores := make([]*score.Score, len(results.Scores))
+		for i, sc := range results.Scores {
+			scores[i] = proto.CloneOf(sc)
+		}
+		qf.HideScores(scores)
+		results = &score.Results{BuildInfo: results.BuildInfo, Scores: scores}
+	}
+	return results.MarkdownComment(taskName, assignment.GetScoreLimit())
+}
+
+// getPullRequest retrieves the pull request from the database for the given branch and repository.
+func (wh GitHubWebHook) getPullRequest(branch string, scmRepoID uint64) (*qf.PullRequest, error) {
 	pullRequest, err := wh.db.GetPullRequest(&qf.PullRequest{
-		SourceBranch:    branchName(payload.GetRef()),
-		ScmRepositoryID: uint64(payload.GetRepo().GetID()),
+		SourceBranch:    branch,
+		ScmRepositoryID: scmRepoID,
 	})
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			// This can happen if someone pushes to a branch group assignment, without having a PR created for it
 			// If this happens, QF should not do anything
-			return nil, fmt.Errorf("no pull request found for ref: %s", payload.GetRef())
+			return nil, fmt.Errorf("no pull request found for branch: %s", branch)
 		}
 		return nil, fmt.Errorf("failed to get pull request from database: %w", err)
 	}
@@ -1,9 +1,13 @@
 package hooks
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/quickfeed/quickfeed/kit/score"
 	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -57,3 +61,37 @@ func TestFindIssue(t *testing.T) {
 		})
 	}
 }
+
+func TestFeedbackCommentHidesTests(t *testing.T) {
+	now := time.Now()
+	newResults := func() *score.Results {
+		return &score.Results{Scores: []*score.Score{
+			{TestName: "TestVisible", TaskName: "1", Score: 1, MaxScore: 1, Weight: 1},
+			{TestName: "TestSecretEdgeCases", TaskName: "1", Score: 0, MaxScore: 1, Weight: 1, Hidden: true, TestDetails: "secret details"},
+		}}
+	}
+	hiddenNames := []string{"TestSecretEdgeCases", "secret details"}
+
+	open := &qf.Assignment{Deadline: timestamppb.New(now.Add(time.Hour)), ScoreLimit: 80}
+	results := newResults()
+	body := feedbackComment(results, "1", open, now)
+	for _, name := range hiddenNames {
+		if strings.Contains(body, name) {
+			t.Errorf("feedbackComment() before deadline contains %q:\n%s", name, body)
+		}
+	}
+	for _, want := range []string{"TestVisible", "Hidden test 1", "**50.0%**"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("feedbackComment() before deadline does not contain %q:\n%s", want, body)
+		}
+	}
+	if got := results.Scores[1].GetTestName(); got != "TestSecretEdgeCases" {
+		t.Errorf("feedbackComment() modified the results: TestName = %q, want %q", got, "TestSecretEdgeCases")
+	}
+
+	closed := &qf.Assignment{Deadline: timestamppb.New(now.Add(-time.Hour)), ScoreLimit: 80}
+	body = feedbackComment(newResults(), "1", closed, now)
+	if !strings.Contains(body, "TestSecretEdgeCases") {
+		t.Errorf("feedbackComment() after deadline does not contain %q:\n%s", "TestSecretEdgeCases", body)
+	}
+}
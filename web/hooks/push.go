@@ -7,7 +7,6 @@ import (
 
 	"github.com/google/go-github/v62/github"
 	"github.com/quickfeed/quickfeed/assignments"
-	"github.com/quickfeed/quickfeed/ci"
 	"github.com/quickfeed/quickfeed/qf"
 	"github.com/quickfeed/quickfeed/scm"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -52,7 +51,17 @@ func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 	case repo.IsTestsRepo():
 		// the push event is for the 'tests' repo, which means that we
 		// should update the course data (assignments) in the database
-		assignments.UpdateFromTestsRepo(wh.logger, wh.runner, wh.db, scmClient, course)
+		if err := assignments.UpdateFromTestsRepo(wh.logger, wh.queue.Runner(), wh.db, scmClient, course); err != nil {
+			wh.logger.Errorf("Failed to update course %s from '%s' repository: %v", course.GetCode(), qf.TestsRepo, err)
+			return
+		}
+		changed := wh.testedAssignments(payload, course)
+		if course.HasSolution() {
+			wh.validateTests(changed, repo, course, payload)
+		}
+		if course.GetAutoRebuild() {
+			wh.rebuildChangedAssignments(changed, course)
+		}
 
 	case repo.IsAssignmentsRepo():
 		// the push event is for the 'assignments' repo; we need to update the local working copy
@@ -79,7 +88,7 @@ func (wh GitHubWebHook) handlePush(payload *github.PushEvent) {
 		wh.logger.Debugf("Processing push event for repo %s", payload.GetRepo().GetName())
 		assignments := wh.extractAssignments(payload, course)
 		for _, assignment := range assignments {
-			wh.runAssignmentTests(scmClient, assignment, repo, course, payload)
+			wh.enqueueAssignmentTests(assignment, repo, course, payload)
 		}
 
 	default:
@@ -114,15 +123,8 @@ func (wh GitHubWebHook) ignorePush(payload *github.PushEvent, repo *qf.Repositor
 // and determines the assignments that have been changed in this commit by
 // querying the database based on the lab name.
 func (wh GitHubWebHook) extractAssignments(payload *github.PushEvent, course *qf.Course) []*qf.Assignment {
-	modifiedAssignments := make(map[string]bool)
-	for _, commit := range payload.Commits {
-		extractChanges(commit.Modified, modifiedAssignments)
-		extractChanges(commit.Added, modifiedAssignments)
-		extractChanges(commit.Removed, modifiedAssignments)
-	}
-
 	var assignments []*qf.Assignment
-	for name := range modifiedAssignments {
+	for name := range changedDirs(payload) {
 		// get assignment based on course id and assignment name
 		assignment, err := wh.db.GetAssignment(&qf.Assignment{Name: name, CourseID: course.GetID()})
 		if err != nil {
@@ -134,46 +136,87 @@ func (wh GitHubWebHook) extractAssignments(payload *github.PushEvent, course *qf
 	return assignments
 }
 
-// runAssignmentTests runs the tests for the given assignment pushed to repo.
-func (wh GitHubWebHook) runAssignmentTests(scmClient scm.SCM, assignment *qf.Assignment, repo *qf.Repository, course *qf.Course, payload *github.PushEvent) {
-	runData := &ci.RunData{
-		Course:     course,
-		Assignment: assignment,
-		Repo:       repo,
-		BranchName: branchName(payload.GetRef()),
-		CommitID:   payload.GetHeadCommit().GetID(),
-		JobOwner:   payload.GetSender().GetLogin(),
-	}
-	if assignment.GradedManually() {
-		wh.logger.Debugf("Assignment %s for course %s is manually reviewed", assignment.GetName(), course.GetName())
-		if _, err := runData.RecordResults(wh.logger, wh.db, nil); err != nil {
-			wh.logger.Error(err)
+// testedAssignments returns the assignments with tests whose directories
+// in the tests repository are changed by the push.
+// Changes to other directories, e.g., scripts, and to root-level files are ignored.
+func (wh GitHubWebHook) testedAssignments(payload *github.PushEvent, course *qf.Course) []*qf.Assignment {
+	var assignments []*qf.Assignment
+	for name := range changedDirs(payload) {
+		assignment, err := wh.db.GetAssignment(&qf.Assignment{Name: name, CourseID: course.GetID()})
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				wh.logger.Errorf("Could not get assignment '%s' for course %d from database: %v", name, course.GetID(), err)
+			}
+			// not an assignment directory
+			continue
 		}
-		return
+		if assignment.GradedManually() {
+			continue
+		}
+		assignments = append(assignments, assignment)
 	}
-	ctx, cancel := assignment.WithTimeout(ci.DefaultContainerTimeout)
-	defer cancel()
-	results, err := runData.RunTests(ctx, wh.logger, scmClient, wh.runner)
-	if err != nil {
-		wh.logger.Error(err)
-		return
+	return assignments
+}
+
+// validateTests enqueues jobs to run the tests of the given assignments against
+// the course's reference solution. The jobs' results make up the tests health report.
+func (wh GitHubWebHook) validateTests(assignments []*qf.Assignment, testsRepo *qf.Repository, course *qf.Course, payload *github.PushEvent) {
+	for _, assignment := range assignments {
+		job := &qf.Job{
+			CourseID:     course.GetID(),
+			AssignmentID: assignment.GetID(),
+			RepositoryID: testsRepo.GetID(),
+			BranchName:   course.GetSolutionBranch(),
+			JobOwner:     payload.GetSender().GetLogin(),
+			Solution:     true,
+		}
+		if err := wh.queue.Enqueue(job); err != nil {
+			wh.logger.Errorf("Failed to validate tests for assignment %s for course %s: %v", assignment.GetName(), course.GetCode(), err)
+		}
 	}
-	submission, err := runData.RecordResults(wh.logger, wh.db, results)
-	if err != nil {
-		wh.logger.Error(err)
+}
+
+// rebuildChangedAssignments rebuilds the stale submissions for the given assignments.
+func (wh GitHubWebHook) rebuildChangedAssignments(assignments []*qf.Assignment, course *qf.Course) {
+	if wh.rebuild == nil {
 		return
 	}
-	// If we fail to get owners, we ignore sending on the stream.
-	if userIDs, err := runData.GetOwners(wh.db); err == nil {
-		// Note that streaming the submission as-is will send all grades
-		// to all participants for a given group submission.
-		wh.streams.Submission.SendTo(submission, userIDs...)
+	for _, assignment := range assignments {
+		rebuild, err := wh.rebuild(assignment)
+		if err != nil {
+			wh.logger.Errorf("Failed to rebuild assignment %s for course %s: %v", assignment.GetName(), course.GetCode(), err)
+			continue
+		}
+		wh.logger.Debugf("Rebuilding stale submissions for assignment %s for course %s (rebuild %d)", assignment.GetName(), course.GetCode(), rebuild.GetID())
+	}
+}
+
+// changedDirs returns the names of the top-level directories with files changed by the push.
+func changedDirs(payload *github.PushEvent) map[string]bool {
+	changed := make(map[string]bool)
+	for _, commit := range payload.Commits {
+		extractChanges(commit.Modified, changed)
+		extractChanges(commit.Added, changed)
+		extractChanges(commit.Removed, changed)
+	}
+	return changed
+}
+
+// enqueueAssignmentTests enqueues a job to run the tests for the given assignment pushed to repo.
+func (wh GitHubWebHook) enqueueAssignmentTests(assignment *qf.Assignment, repo *qf.Repository, course *qf.Course, payload *github.PushEvent) {
+	job := &qf.Job{
+		CourseID:     course.GetID(),
+		AssignmentID: assignment.GetID(),
+		RepositoryID: repo.GetID(),
+		CommitID:     payload.GetHeadCommit().GetID(),
+		JobOwner:     payload.GetSender().GetLogin(),
 	}
 	// Non-default branch indicates push to a group repo with an associated pull request.
-	if !isDefaultBranch(payload) && repo.IsGroupRepo() {
-		// Attempt to find the pull request for the branch, if it exists,
-		// and then assign reviewers to it, if the branch task score is higher than the assignment score limit
-		wh.handlePullRequestPush(ctx, scmClient, payload, results, runData)
+	if !isDefaultBranch(payload) {
+		job.BranchName = branchName(payload.GetRef())
+	}
+	if err := wh.queue.Enqueue(job); err != nil {
+		wh.logger.Error(err)
 	}
 }
 
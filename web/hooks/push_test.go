@@ -10,7 +10,6 @@ import (
 	"github.com/quickfeed/quickfeed/internal/qtest"
 	"github.com/quickfeed/quickfeed/qf"
 	"github.com/quickfeed/quickfeed/scm"
-	"github.com/quickfeed/quickfeed/web/stream"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -19,7 +18,7 @@ func TestExtractAssignments(t *testing.T) {
 	course := qtest.MockCourses[0]
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
-	wh := NewGitHubWebHook(qtest.Logger(t), db, &scm.Manager{}, &ci.Local{}, "secret", stream.NewStreamServices(), nil)
+	wh := NewGitHubWebHook(qtest.Logger(t), db, &scm.Manager{}, nil, "secret", nil)
 	admin := qtest.CreateFakeUser(t, db)
 	qtest.CreateCourse(t, db, admin, course)
 
@@ -106,7 +105,7 @@ func TestLastActivityDate(t *testing.T) {
 	course := qtest.MockCourses[0]
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
-	wh := NewGitHubWebHook(qtest.Logger(t), db, &scm.Manager{}, &ci.Local{}, "secret", stream.NewStreamServices(), nil)
+	wh := NewGitHubWebHook(qtest.Logger(t), db, &scm.Manager{}, nil, "secret", nil)
 	admin := qtest.CreateFakeUser(t, db)
 	qtest.CreateCourse(t, db, admin, course)
 
@@ -230,7 +229,7 @@ func TestDefaultBranch(t *testing.T) {
 func TestIgnorePush(t *testing.T) {
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
-	wh := NewGitHubWebHook(qtest.Logger(t), db, &scm.Manager{}, &ci.Local{}, "secret", stream.NewStreamServices(), nil)
+	wh := NewGitHubWebHook(qtest.Logger(t), db, &scm.Manager{}, nil, "secret", nil)
 
 	repo := qf.RepoURL{ProviderURL: "github.com", Organization: "dat520-2024"}
 	usrRepo := &qf.Repository{RepoType: qf.Repository_USER, HTMLURL: repo.StudentRepoURL("user")}
@@ -277,3 +276,107 @@ func TestIgnorePush(t *testing.T) {
 		})
 	}
 }
+
+func TestRebuildChangedAssignments(t *testing.T) {
+	course := qtest.MockCourses[0]
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	wh := NewGitHubWebHook(qtest.Logger(t), db, &scm.Manager{}, nil, "secret", nil)
+	var rebuilt []string
+	wh.RebuildOnTestsChange(func(assignment *qf.Assignment) (*qf.Rebuild, error) {
+		rebuilt = append(rebuilt, assignment.GetName())
+		return &qf.Rebuild{AssignmentID: assignment.GetID()}, nil
+	})
+	admin := qtest.CreateFakeUser(t, db)
+	qtest.CreateCourse(t, db, admin, course)
+	for _, assignment := range []*qf.Assignment{
+		{CourseID: course.GetID(), Order: 1, Name: "lab1"},
+		{CourseID: course.GetID(), Order: 2, Name: "lab2"},
+		{CourseID: course.GetID(), Order: 3, Name: "lab3", Reviewers: 1},
+	} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	payload := &github.PushEvent{
+		Commits: []*github.HeadCommit{
+			{
+				Modified: []string{"lab1/lab1_test.go", "README.md"},
+				Added:    []string{"scripts/run.sh"},
+			},
+			{
+				Removed: []string{"lab3/lab3_test.go"},
+			},
+		},
+	}
+	wh.rebuildChangedAssignments(wh.testedAssignments(payload, course), course)
+	// lab2 is unchanged, lab3 is graded manually and scripts is not an assignment
+	if diff := cmp.Diff([]string{"lab1"}, rebuilt); diff != "" {
+		t.Errorf("rebuildChangedAssignments() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidateTests(t *testing.T) {
+	course := &qf.Course{
+		Name:               "Test Course",
+		Code:               "DAT320",
+		Year:               2026,
+		Tag:                "Autumn",
+		ScmOrganizationID:  1,
+		SolutionRepository: "solutions",
+		SolutionBranch:     "main",
+	}
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	logger := qtest.Logger(t)
+	queue := ci.NewQueue(logger, db, &scm.Manager{}, &ci.Local{})
+	wh := NewGitHubWebHook(logger, db, &scm.Manager{}, queue, "secret", nil)
+	admin := qtest.CreateFakeUser(t, db)
+	qtest.CreateCourse(t, db, admin, course)
+	testsRepo := &qf.Repository{
+		ScmOrganizationID: course.GetScmOrganizationID(),
+		ScmRepositoryID:   1,
+		RepoType:          qf.Repository_TESTS,
+		HTMLURL:           "https://github.com/dat320/tests",
+	}
+	if err := db.CreateRepository(testsRepo); err != nil {
+		t.Fatal(err)
+	}
+	for _, assignment := range []*qf.Assignment{
+		{CourseID: course.GetID(), Order: 1, Name: "lab1"},
+		{CourseID: course.GetID(), Order: 2, Name: "lab2"},
+		{CourseID: course.GetID(), Order: 3, Name: "lab3", Reviewers: 1},
+	} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	payload := &github.PushEvent{
+		Sender: &github.User{Login: github.String("teacher")},
+		Commits: []*github.HeadCommit{
+			{Modified: []string{"lab2/lab2_test.go", "lab3/lab3_test.go"}},
+		},
+	}
+	wh.validateTests(wh.testedAssignments(payload, course), testsRepo, course, payload)
+
+	jobs, err := db.GetJobs(&qf.Job{CourseID: course.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// lab1 is unchanged and lab3 is graded manually
+	wantJobs := []*qf.Job{
+		{
+			CourseID:     course.GetID(),
+			AssignmentID: 2,
+			RepositoryID: testsRepo.GetID(),
+			BranchName:   "main",
+			JobOwner:     "teacher",
+			Solution:     true,
+		},
+	}
+	if diff := cmp.Diff(wantJobs, jobs, protocmp.Transform(), protocmp.IgnoreFields(&qf.Job{}, "ID", "CreatedAt", "UpdatedAt")); diff != "" {
+		t.Errorf("validateTests() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -7,11 +7,9 @@ import (
 	"testing"
 	"testing/synctest"
 
-	"github.com/quickfeed/quickfeed/ci"
 	"github.com/quickfeed/quickfeed/internal/qtest"
 	"github.com/quickfeed/quickfeed/qf"
 	"github.com/quickfeed/quickfeed/scm"
-	"github.com/quickfeed/quickfeed/web/stream"
 )
 
 type mockSCM struct {
@@ -42,7 +40,7 @@ func TestSyncStudentRepos(t *testing.T) {
 		db, cleanup := qtest.TestDB(t)
 		defer cleanup()
 
-		wh := NewGitHubWebHook(qtest.Logger(t), db, nil, &ci.Local{}, "secret", stream.NewStreamServices(), nil)
+		wh := NewGitHubWebHook(qtest.Logger(t), db, nil, nil, "secret", nil)
 		admin := qtest.CreateFakeUser(t, db)
 		user2 := qtest.CreateFakeUser(t, db)
 		course := qtest.MockCourses[0]
@@ -100,7 +98,7 @@ func TestSyncStudentReposWithErrors(t *testing.T) {
 		db, cleanup := qtest.TestDB(t)
 		defer cleanup()
 
-		wh := NewGitHubWebHook(qtest.Logger(t), db, nil, &ci.Local{}, "secret", stream.NewStreamServices(), nil)
+		wh := NewGitHubWebHook(qtest.Logger(t), db, nil, nil, "secret", nil)
 		admin := qtest.CreateFakeUser(t, db)
 		user2 := qtest.CreateFakeUser(t, db)
 		course := qtest.MockCourses[0]
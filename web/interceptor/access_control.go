@@ -179,11 +179,13 @@ var methodCheckers = map[string]accessChecker{
 	"UpdateUser":               checkUpdateUser,
 	"GetEnrollments":           checkUserOrStudentOrTeacherOrAdmin,
 	"GetSubmissions":           checkGetSubmissions,
+	"BuildLogStream":           checkGetSubmissions, // Streams are not intercepted; access is also checked by the handler.
 	"GetSubmission":            checkTeacher,
 	"CreateGroup":              checkGroupOrTeacher,
 	"GetGroup":                 checkGroupOrTeacher,
 	"GetAssignments":           checkStudentOrTeacher,
 	"GetRepositories":          checkStudentOrTeacher,
+	"GetLeaderboard":           checkStudentOrTeacher,
 	"UpdateGroup":              checkTeacher,
 	"DeleteGroup":              checkTeacher,
 	"GetGroupsByCourse":        checkTeacher,
@@ -192,6 +194,14 @@ var methodCheckers = map[string]accessChecker{
 	"UpdateAssignments":        checkTeacher,
 	"UpdateSubmission":         checkUpdateSubmission,
 	"RebuildSubmissions":       checkTeacher,
+	"CancelRebuild":            checkTeacher,
+	"GetRebuildSummaries":      checkTeacher,
+	"GetRebuildSummary":        checkTeacher,
+	"GetTestsHealth":           checkTeacher,
+	"GetFlakyTests":            checkTeacher,
+	"GetBuildLogArchive":       checkTeacher,
+	"GetStaleSubmissions":      checkTeacher,
+	"RebuildStream":            checkTeacher,
 	"CreateReview":             checkTeacher,
 	"UpdateReview":             checkTeacher,
 	"CreateAssignmentFeedback": checkStudentOrTeacher,
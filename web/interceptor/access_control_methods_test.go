@@ -31,10 +31,12 @@ func TestAccessControlMethodsChecker(t *testing.T) {
 		"UpdateUser":               true,
 		"GetEnrollments":           true,
 		"GetSubmissions":           true,
+		"BuildLogStream":           true,
 		"CreateGroup":              true,
 		"GetGroup":                 true,
 		"GetAssignments":           true,
 		"GetRepositories":          true,
+		"GetLeaderboard":           true,
 		"UpdateGroup":              true,
 		"DeleteGroup":              true,
 		"GetGroupsByCourse":        true,
@@ -43,6 +45,14 @@ func TestAccessControlMethodsChecker(t *testing.T) {
 		"UpdateAssignments":        true,
 		"UpdateSubmission":         true,
 		"RebuildSubmissions":       true,
+		"CancelRebuild":            true,
+		"GetRebuildSummaries":      true,
+		"GetRebuildSummary":        true,
+		"GetTestsHealth":           true,
+		"GetFlakyTests":            true,
+		"GetBuildLogArchive":       true,
+		"GetStaleSubmissions":      true,
+		"RebuildStream":            true,
 		"CreateReview":             true,
 		"UpdateReview":             true,
 		"IsEmptyRepo":              true,
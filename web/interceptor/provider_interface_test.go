@@ -244,6 +244,7 @@ func TestMethodCheckerRequestTypes(t *testing.T) {
 
 		// checkGetSubmissions methods
 		"GetSubmissions": "qf.SubmissionRequest",
+		"BuildLogStream": "qf.BuildLogRequest",
 
 		// checkTeacher methods
 		"GetSubmission":          "qf.SubmissionRequest",
@@ -254,6 +255,14 @@ func TestMethodCheckerRequestTypes(t *testing.T) {
 		"UpdateEnrollments":      "qf.Enrollments",
 		"UpdateAssignments":      "qf.CourseRequest",
 		"RebuildSubmissions":     "qf.RebuildRequest",
+		"CancelRebuild":          "qf.RebuildStatusRequest",
+		"GetRebuildSummaries":    "qf.CourseRequest",
+		"GetRebuildSummary":      "qf.RebuildStatusRequest",
+		"GetTestsHealth":         "qf.CourseRequest",
+		"GetFlakyTests":          "qf.FlakyTestsRequest",
+		"GetBuildLogArchive":     "qf.BuildLogArchiveRequest",
+		"GetStaleSubmissions":    "qf.StaleSubmissionsRequest",
+		"RebuildStream":          "qf.RebuildStatusRequest",
 		"CreateReview":           "qf.ReviewRequest",
 		"UpdateReview":           "qf.ReviewRequest",
 		"GetAssignmentFeedback":  "qf.CourseRequest",
@@ -263,6 +272,7 @@ func TestMethodCheckerRequestTypes(t *testing.T) {
 
 		// checkStudentOrTeacher methods
 		"GetAssignments":           "qf.CourseRequest",
+		"GetLeaderboard":           "qf.LeaderboardRequest",
 		"CreateAssignmentFeedback": "qf.AssignmentFeedback",
 
 		// checkGroupOrTeacher methods
@@ -21,45 +21,67 @@ func TestImplementsValidation(t *testing.T) {
 		validator bool
 		found     bool
 	}{
-		"qf.Assignment":          {cleaner: F, validator: F},
-		"qf.AssignmentFeedback":  {cleaner: F, validator: T},
-		"qf.AssignmentFeedbacks": {cleaner: F, validator: F},
-		"qf.Assignments":         {cleaner: F, validator: F},
-		"qf.Benchmarks":          {cleaner: F, validator: F},
-		"qf.Course":              {cleaner: T, validator: T},
-		"qf.CourseRequest":       {cleaner: F, validator: T},
-		"qf.CourseSubmissions":   {cleaner: F, validator: F},
-		"qf.Courses":             {cleaner: T, validator: F},
-		"qf.Enrollment":          {cleaner: T, validator: T},
-		"qf.EnrollmentRequest":   {cleaner: F, validator: T},
-		"qf.Enrollments":         {cleaner: T, validator: T},
-		"qf.FeedbackReceipt":     {cleaner: F, validator: F},
-		"qf.Grade":               {cleaner: F, validator: T},
-		"qf.GradingBenchmark":    {cleaner: F, validator: T},
-		"qf.GradingCriterion":    {cleaner: F, validator: T},
-		"qf.Group":               {cleaner: T, validator: T},
-		"qf.GroupRequest":        {cleaner: F, validator: T},
-		"qf.Groups":              {cleaner: T, validator: F},
-		"qf.Issue":               {cleaner: F, validator: F},
-		"qf.Organization":        {cleaner: F, validator: T},
-		"qf.PullRequest":         {cleaner: F, validator: F},
-		"qf.RebuildRequest":      {cleaner: F, validator: T},
-		"qf.Repositories":        {cleaner: F, validator: F},
-		"qf.Repository":          {cleaner: F, validator: F},
-		"qf.RepositoryRequest":   {cleaner: F, validator: T},
-		"qf.Review":              {cleaner: F, validator: T},
-		"qf.ReviewRequest":       {cleaner: F, validator: T},
-		"qf.Submission":          {cleaner: F, validator: F},
-		"qf.SubmissionRequest":   {cleaner: F, validator: T},
-		"qf.Submissions":         {cleaner: F, validator: F},
-		"qf.Task":                {cleaner: F, validator: F},
-		"qf.TestInfo":            {cleaner: F, validator: F},
-		"qf.UsedSlipDays":        {cleaner: F, validator: F},
-		"qf.User":                {cleaner: T, validator: T},
-		"qf.Users":               {cleaner: T, validator: F},
-		"qf.Void":                {cleaner: F, validator: T},
-		"score.BuildInfo":        {cleaner: F, validator: F},
-		"score.Score":            {cleaner: F, validator: F},
+		"qf.Assignment":              {cleaner: F, validator: F},
+		"qf.AssignmentFeedback":      {cleaner: F, validator: T},
+		"qf.AssignmentFeedbacks":     {cleaner: F, validator: F},
+		"qf.Assignments":             {cleaner: F, validator: F},
+		"qf.Benchmarks":              {cleaner: F, validator: F},
+		"qf.BuildLog":                {cleaner: F, validator: F},
+		"qf.BuildLogArchive":         {cleaner: F, validator: F},
+		"qf.BuildLogArchiveRequest":  {cleaner: F, validator: T},
+		"qf.BuildLogRequest":         {cleaner: F, validator: T},
+		"qf.Course":                  {cleaner: T, validator: T},
+		"qf.CourseRequest":           {cleaner: F, validator: T},
+		"qf.CourseSubmissions":       {cleaner: F, validator: F},
+		"qf.Courses":                 {cleaner: T, validator: F},
+		"qf.Enrollment":              {cleaner: T, validator: T},
+		"qf.EnrollmentRequest":       {cleaner: F, validator: T},
+		"qf.Enrollments":             {cleaner: T, validator: T},
+		"qf.FeedbackReceipt":         {cleaner: F, validator: F},
+		"qf.FlakyTest":               {cleaner: F, validator: F},
+		"qf.FlakyTests":              {cleaner: F, validator: F},
+		"qf.FlakyTestsRequest":       {cleaner: F, validator: T},
+		"qf.Grade":                   {cleaner: F, validator: T},
+		"qf.GradingBenchmark":        {cleaner: F, validator: T},
+		"qf.GradingCriterion":        {cleaner: F, validator: T},
+		"qf.Group":                   {cleaner: T, validator: T},
+		"qf.GroupRequest":            {cleaner: F, validator: T},
+		"qf.Groups":                  {cleaner: T, validator: F},
+		"qf.Issue":                   {cleaner: F, validator: F},
+		"qf.Job":                     {cleaner: F, validator: F},
+		"qf.Leaderboard":             {cleaner: F, validator: F},
+		"qf.LeaderboardEntry":        {cleaner: F, validator: F},
+		"qf.LeaderboardRequest":      {cleaner: F, validator: T},
+		"qf.Organization":            {cleaner: F, validator: T},
+		"qf.PullRequest":             {cleaner: F, validator: F},
+		"qf.Rebuild":                 {cleaner: F, validator: F},
+		"qf.RebuildProgress":         {cleaner: F, validator: F},
+		"qf.RebuildSummaries":        {cleaner: F, validator: F},
+		"qf.RebuildSummary":          {cleaner: F, validator: F},
+		"qf.RebuildRequest":          {cleaner: F, validator: T},
+		"qf.RebuildStatusRequest":    {cleaner: F, validator: T},
+		"qf.Repositories":            {cleaner: F, validator: F},
+		"qf.Repository":              {cleaner: F, validator: F},
+		"qf.RepositoryRequest":       {cleaner: F, validator: T},
+		"qf.Review":                  {cleaner: F, validator: T},
+		"qf.ReviewRequest":           {cleaner: F, validator: T},
+		"qf.StaleSubmissions":        {cleaner: F, validator: F},
+		"qf.StaleSubmissionsRequest": {cleaner: F, validator: T},
+		"qf.Submission":              {cleaner: F, validator: F},
+		"qf.SubmissionRequest":       {cleaner: F, validator: T},
+		"qf.Submissions":             {cleaner: F, validator: F},
+		"qf.Task":                    {cleaner: F, validator: F},
+		"qf.TestInfo":                {cleaner: F, validator: F},
+		"qf.TestsHealth":             {cleaner: F, validator: F},
+		"qf.UsedSlipDays":            {cleaner: F, validator: F},
+		"qf.User":                    {cleaner: T, validator: T},
+		"qf.Users":                   {cleaner: T, validator: F},
+		"qf.Void":                    {cleaner: F, validator: T},
+		"score.BuildInfo":            {cleaner: F, validator: F},
+		"score.Coverage":             {cleaner: F, validator: F},
+		"score.LintFinding":          {cleaner: F, validator: F},
+		"score.PackageCoverage":      {cleaner: F, validator: F},
+		"score.Score":                {cleaner: F, validator: F},
 	}
 
 	protoregistry.GlobalTypes.RangeMessages(func(desc protoreflect.MessageType) bool {
@@ -106,71 +128,80 @@ func TestIsValid(t *testing.T) {
 		request validator
 		want    bool
 	}{
-		"AssignmentFeedback/EmptyImprovement":    {request: &qf.AssignmentFeedback{CourseID: 1, AssignmentID: 1, LikedContent: "A", TimeSpent: 1}, want: false},
-		"AssignmentFeedback/EmptyLikedContent":   {request: &qf.AssignmentFeedback{CourseID: 1, AssignmentID: 1, ImprovementSuggestions: "B", TimeSpent: 1}, want: false},
-		"AssignmentFeedback/Invalid":             {request: &qf.AssignmentFeedback{}, want: false},
-		"AssignmentFeedback/MissingAssignmentID": {request: &qf.AssignmentFeedback{CourseID: 1, LikedContent: "A", ImprovementSuggestions: "B", TimeSpent: 1}, want: false},
-		"AssignmentFeedback/MissingCourseID":     {request: &qf.AssignmentFeedback{AssignmentID: 1, LikedContent: "A", ImprovementSuggestions: "B", TimeSpent: 1}, want: false},
-		"AssignmentFeedback/Valid":               {request: &qf.AssignmentFeedback{CourseID: 1, AssignmentID: 1, LikedContent: "A", ImprovementSuggestions: "B", TimeSpent: 1}, want: true},
-		"AssignmentFeedback/ZeroTimeSpent":       {request: &qf.AssignmentFeedback{CourseID: 1, AssignmentID: 1, LikedContent: "A", ImprovementSuggestions: "B"}, want: false},
-		"Course/Invalid":                         {request: &qf.Course{}, want: false},
-		"Course/Valid":                           {request: &qf.Course{Name: "A", Code: "B", ScmOrganizationID: 1, Year: 2021, Tag: "C"}, want: true},
-		"CourseRequest/Invalid":                  {request: &qf.CourseRequest{CourseID: 0}, want: false},
-		"CourseRequest/Valid":                    {request: &qf.CourseRequest{CourseID: 1}, want: true},
-		"Enrollment/Invalid":                     {request: &qf.Enrollment{}, want: false},
-		"Enrollment/Status/Invalid":              {request: &qf.Enrollment{Status: 10, UserID: 1, CourseID: 1}, want: false},
-		"Enrollment/StatusNone":                  {request: &qf.Enrollment{Status: qf.Enrollment_NONE, UserID: 1, CourseID: 1}, want: true},
-		"Enrollment/StatusPending":               {request: &qf.Enrollment{Status: qf.Enrollment_PENDING, UserID: 1, CourseID: 1}, want: true},
-		"Enrollment/StatusStudent":               {request: &qf.Enrollment{Status: qf.Enrollment_STUDENT, UserID: 1, CourseID: 1}, want: true},
-		"Enrollment/StatusTeacher":               {request: &qf.Enrollment{Status: qf.Enrollment_TEACHER, UserID: 1, CourseID: 1}, want: true},
-		"EnrollmentRequest/CourseID":             {request: &qf.EnrollmentRequest{FetchMode: &qf.EnrollmentRequest_CourseID{CourseID: 1}}, want: true},
-		"EnrollmentRequest/CourseID/Invalid":     {request: &qf.EnrollmentRequest{FetchMode: &qf.EnrollmentRequest_CourseID{CourseID: 0}}, want: false},
-		"EnrollmentRequest/Invalid":              {request: &qf.EnrollmentRequest{}, want: false},
-		"EnrollmentRequest/UserID":               {request: &qf.EnrollmentRequest{FetchMode: &qf.EnrollmentRequest_UserID{UserID: 1}}, want: true},
-		"EnrollmentRequest/UserID/Invalid":       {request: &qf.EnrollmentRequest{FetchMode: &qf.EnrollmentRequest_UserID{UserID: 0}}, want: false},
-		"Enrollments/DifferentCourseIDs":         {request: &qf.Enrollments{Enrollments: []*qf.Enrollment{{CourseID: 1, UserID: 1}, {CourseID: 2, UserID: 2}}}, want: false},
-		"Enrollments/Invalid":                    {request: &qf.Enrollments{}, want: false},
-		"Enrollments/InvalidEnrollment":          {request: &qf.Enrollments{Enrollments: []*qf.Enrollment{{CourseID: 1, UserID: 0}}}, want: false},
-		"Enrollments/Valid":                      {request: &qf.Enrollments{Enrollments: []*qf.Enrollment{{CourseID: 1, UserID: 1, Status: qf.Enrollment_STUDENT}}}, want: true},
-		"GradingBenchmark/EmptyHeading":          {request: &qf.GradingBenchmark{AssignmentID: 1}, want: false},
-		"GradingBenchmark/Invalid":               {request: &qf.GradingBenchmark{}, want: false},
-		"GradingBenchmark/MissingAssignmentID":   {request: &qf.GradingBenchmark{Heading: "A"}, want: false},
-		"GradingBenchmark/Valid":                 {request: &qf.GradingBenchmark{AssignmentID: 1, Heading: "A"}, want: true},
-		"GradingCriterion/EmptyDescription":      {request: &qf.GradingCriterion{BenchmarkID: 1}, want: false},
-		"GradingCriterion/Invalid":               {request: &qf.GradingCriterion{}, want: false},
-		"GradingCriterion/MissingBenchmarkID":    {request: &qf.GradingCriterion{Description: "A"}, want: false},
-		"GradingCriterion/Valid":                 {request: &qf.GradingCriterion{BenchmarkID: 1, Description: "A"}, want: true},
-		"Group/Invalid":                          {request: &qf.Group{}, want: false},
-		"Group/Valid":                            {request: &qf.Group{Name: "A", CourseID: 1, Users: []*qf.User{{ID: 1}}}, want: true},
-		"GroupRequest/GroupID":                   {request: &qf.GroupRequest{CourseID: 1, GroupID: 1}, want: true},
-		"GroupRequest/Invalid":                   {request: &qf.GroupRequest{CourseID: 1, UserID: 1, GroupID: 1}, want: false},
-		"GroupRequest/UserID":                    {request: &qf.GroupRequest{CourseID: 1, UserID: 1}, want: true},
-		"Organization/Invalid":                   {request: &qf.Organization{}, want: false},
-		"Organization/Valid":                     {request: &qf.Organization{ScmOrganizationName: "A"}, want: true},
-		"RebuildRequest/Invalid":                 {request: &qf.RebuildRequest{CourseID: 1}, want: false},
-		"RebuildRequest/Valid":                   {request: &qf.RebuildRequest{CourseID: 1, AssignmentID: 1}, want: true},
-		"RepositoryRequest/GroupID":              {request: &qf.RepositoryRequest{CourseID: 1, GroupID: 1}, want: true},
-		"RepositoryRequest/Invalid":              {request: &qf.RepositoryRequest{CourseID: 1}, want: false},
-		"RepositoryRequest/UserID":               {request: &qf.RepositoryRequest{CourseID: 1, UserID: 1}, want: true},
-		"RepositoryRequest/UserID/GroupID":       {request: &qf.RepositoryRequest{CourseID: 1, UserID: 1, GroupID: 1}, want: false},
-		"Review/Invalid":                         {request: &qf.Review{}, want: false},
-		"Review/Valid":                           {request: &qf.Review{ReviewerID: 1, SubmissionID: 1}, want: true},
-		"ReviewRequest/MissingReview":            {request: &qf.ReviewRequest{CourseID: 1}, want: false},
-		"ReviewRequest/Valid":                    {request: &qf.ReviewRequest{CourseID: 1, Review: &qf.Review{ReviewerID: 1, SubmissionID: 1}}, want: true},
-		"SubmissionRequest/GroupID":              {request: &qf.SubmissionRequest{CourseID: 1, FetchMode: &qf.SubmissionRequest_GroupID{GroupID: 1}}, want: true},
-		"SubmissionRequest/Invalid":              {request: &qf.SubmissionRequest{CourseID: 1}, want: false},
-		"SubmissionRequest/MissingCourseID":      {request: &qf.SubmissionRequest{FetchMode: &qf.SubmissionRequest_SubmissionID{SubmissionID: 1}}, want: false},
-		"SubmissionRequest/SubmissionID":         {request: &qf.SubmissionRequest{CourseID: 1, FetchMode: &qf.SubmissionRequest_SubmissionID{SubmissionID: 1}}, want: true},
-		"SubmissionRequest/Type":                 {request: &qf.SubmissionRequest{CourseID: 1, FetchMode: &qf.SubmissionRequest_Type{Type: qf.SubmissionRequest_ALL}}, want: true},
-		"SubmissionRequest/UserID":               {request: &qf.SubmissionRequest{CourseID: 1, FetchMode: &qf.SubmissionRequest_UserID{UserID: 1}}, want: true},
-		"Grade/MissingSubmission":                {request: &qf.Grade{UserID: 1}, want: false},
-		"Grade/Valid":                            {request: &qf.Grade{UserID: 1, SubmissionID: 1}, want: true},
-		"Grade/ValidGroupSubmission":             {request: &qf.Grade{SubmissionID: 1}, want: true},
-		"Grade/ValidStatusNone":                  {request: &qf.Grade{SubmissionID: 1, Status: qf.Submission_NONE}, want: true},
-		"Grade/ValidStatusApproved":              {request: &qf.Grade{SubmissionID: 1, Status: qf.Submission_APPROVED}, want: true},
-		"User/Invalid":                           {request: &qf.User{ID: 0}, want: false},
-		"User/Valid":                             {request: &qf.User{ID: 1}, want: true},
-		"Void/Valid":                             {request: &qf.Void{}, want: true},
+		"AssignmentFeedback/EmptyImprovement":     {request: &qf.AssignmentFeedback{CourseID: 1, AssignmentID: 1, LikedContent: "A", TimeSpent: 1}, want: false},
+		"AssignmentFeedback/EmptyLikedContent":    {request: &qf.AssignmentFeedback{CourseID: 1, AssignmentID: 1, ImprovementSuggestions: "B", TimeSpent: 1}, want: false},
+		"AssignmentFeedback/Invalid":              {request: &qf.AssignmentFeedback{}, want: false},
+		"AssignmentFeedback/MissingAssignmentID":  {request: &qf.AssignmentFeedback{CourseID: 1, LikedContent: "A", ImprovementSuggestions: "B", TimeSpent: 1}, want: false},
+		"AssignmentFeedback/MissingCourseID":      {request: &qf.AssignmentFeedback{AssignmentID: 1, LikedContent: "A", ImprovementSuggestions: "B", TimeSpent: 1}, want: false},
+		"AssignmentFeedback/Valid":                {request: &qf.AssignmentFeedback{CourseID: 1, AssignmentID: 1, LikedContent: "A", ImprovementSuggestions: "B", TimeSpent: 1}, want: true},
+		"AssignmentFeedback/ZeroTimeSpent":        {request: &qf.AssignmentFeedback{CourseID: 1, AssignmentID: 1, LikedContent: "A", ImprovementSuggestions: "B"}, want: false},
+		"Course/Invalid":                          {request: &qf.Course{}, want: false},
+		"Course/Valid":                            {request: &qf.Course{Name: "A", Code: "B", ScmOrganizationID: 1, Year: 2021, Tag: "C"}, want: true},
+		"Course/ValidSolution":                    {request: &qf.Course{Name: "A", Code: "B", ScmOrganizationID: 1, Year: 2021, Tag: "C", SolutionRepository: "solutions"}, want: true},
+		"Course/InvalidSolutionPath":              {request: &qf.Course{Name: "A", Code: "B", ScmOrganizationID: 1, Year: 2021, Tag: "C", SolutionRepository: "../solutions"}, want: false},
+		"CourseRequest/Invalid":                   {request: &qf.CourseRequest{CourseID: 0}, want: false},
+		"CourseRequest/Valid":                     {request: &qf.CourseRequest{CourseID: 1}, want: true},
+		"Enrollment/Invalid":                      {request: &qf.Enrollment{}, want: false},
+		"Enrollment/Status/Invalid":               {request: &qf.Enrollment{Status: 10, UserID: 1, CourseID: 1}, want: false},
+		"Enrollment/StatusNone":                   {request: &qf.Enrollment{Status: qf.Enrollment_NONE, UserID: 1, CourseID: 1}, want: true},
+		"Enrollment/StatusPending":                {request: &qf.Enrollment{Status: qf.Enrollment_PENDING, UserID: 1, CourseID: 1}, want: true},
+		"Enrollment/StatusStudent":                {request: &qf.Enrollment{Status: qf.Enrollment_STUDENT, UserID: 1, CourseID: 1}, want: true},
+		"Enrollment/StatusTeacher":                {request: &qf.Enrollment{Status: qf.Enrollment_TEACHER, UserID: 1, CourseID: 1}, want: true},
+		"EnrollmentRequest/CourseID":              {request: &qf.EnrollmentRequest{FetchMode: &qf.EnrollmentRequest_CourseID{CourseID: 1}}, want: true},
+		"EnrollmentRequest/CourseID/Invalid":      {request: &qf.EnrollmentRequest{FetchMode: &qf.EnrollmentRequest_CourseID{CourseID: 0}}, want: false},
+		"EnrollmentRequest/Invalid":               {request: &qf.EnrollmentRequest{}, want: false},
+		"EnrollmentRequest/UserID":                {request: &qf.EnrollmentRequest{FetchMode: &qf.EnrollmentRequest_UserID{UserID: 1}}, want: true},
+		"EnrollmentRequest/UserID/Invalid":        {request: &qf.EnrollmentRequest{FetchMode: &qf.EnrollmentRequest_UserID{UserID: 0}}, want: false},
+		"Enrollments/DifferentCourseIDs":          {request: &qf.Enrollments{Enrollments: []*qf.Enrollment{{CourseID: 1, UserID: 1}, {CourseID: 2, UserID: 2}}}, want: false},
+		"Enrollments/Invalid":                     {request: &qf.Enrollments{}, want: false},
+		"Enrollments/InvalidEnrollment":           {request: &qf.Enrollments{Enrollments: []*qf.Enrollment{{CourseID: 1, UserID: 0}}}, want: false},
+		"Enrollments/Valid":                       {request: &qf.Enrollments{Enrollments: []*qf.Enrollment{{CourseID: 1, UserID: 1, Status: qf.Enrollment_STUDENT}}}, want: true},
+		"FlakyTestsRequest/Invalid":               {request: &qf.FlakyTestsRequest{CourseID: 1}, want: false},
+		"FlakyTestsRequest/Valid":                 {request: &qf.FlakyTestsRequest{CourseID: 1, AssignmentID: 1}, want: true},
+		"GradingBenchmark/EmptyHeading":           {request: &qf.GradingBenchmark{AssignmentID: 1}, want: false},
+		"GradingBenchmark/Invalid":                {request: &qf.GradingBenchmark{}, want: false},
+		"GradingBenchmark/MissingAssignmentID":    {request: &qf.GradingBenchmark{Heading: "A"}, want: false},
+		"GradingBenchmark/Valid":                  {request: &qf.GradingBenchmark{AssignmentID: 1, Heading: "A"}, want: true},
+		"GradingCriterion/EmptyDescription":       {request: &qf.GradingCriterion{BenchmarkID: 1}, want: false},
+		"GradingCriterion/Invalid":                {request: &qf.GradingCriterion{}, want: false},
+		"GradingCriterion/MissingBenchmarkID":     {request: &qf.GradingCriterion{Description: "A"}, want: false},
+		"GradingCriterion/Valid":                  {request: &qf.GradingCriterion{BenchmarkID: 1, Description: "A"}, want: true},
+		"Group/Invalid":                           {request: &qf.Group{}, want: false},
+		"Group/Valid":                             {request: &qf.Group{Name: "A", CourseID: 1, Users: []*qf.User{{ID: 1}}}, want: true},
+		"GroupRequest/GroupID":                    {request: &qf.GroupRequest{CourseID: 1, GroupID: 1}, want: true},
+		"GroupRequest/Invalid":                    {request: &qf.GroupRequest{CourseID: 1, UserID: 1, GroupID: 1}, want: false},
+		"GroupRequest/UserID":                     {request: &qf.GroupRequest{CourseID: 1, UserID: 1}, want: true},
+		"LeaderboardRequest/Invalid":              {request: &qf.LeaderboardRequest{CourseID: 1, AssignmentID: 1}, want: false},
+		"LeaderboardRequest/Valid":                {request: &qf.LeaderboardRequest{CourseID: 1, AssignmentID: 1, TestName: "TestA"}, want: true},
+		"Organization/Invalid":                    {request: &qf.Organization{}, want: false},
+		"Organization/Valid":                      {request: &qf.Organization{ScmOrganizationName: "A"}, want: true},
+		"RebuildRequest/Invalid":                  {request: &qf.RebuildRequest{CourseID: 1}, want: false},
+		"RebuildRequest/Valid":                    {request: &qf.RebuildRequest{CourseID: 1, AssignmentID: 1}, want: true},
+		"RebuildRequest/DryRun":                   {request: &qf.RebuildRequest{CourseID: 1, AssignmentID: 1, DryRun: true, TestsBranch: "new-tests"}, want: true},
+		"RebuildRequest/DryRunSubmission":         {request: &qf.RebuildRequest{CourseID: 1, AssignmentID: 1, SubmissionID: 1, DryRun: true}, want: false},
+		"RebuildRequest/TestsBranchWithoutDryRun": {request: &qf.RebuildRequest{CourseID: 1, AssignmentID: 1, TestsBranch: "new-tests"}, want: false},
+		"RepositoryRequest/GroupID":               {request: &qf.RepositoryRequest{CourseID: 1, GroupID: 1}, want: true},
+		"RepositoryRequest/Invalid":               {request: &qf.RepositoryRequest{CourseID: 1}, want: false},
+		"RepositoryRequest/UserID":                {request: &qf.RepositoryRequest{CourseID: 1, UserID: 1}, want: true},
+		"RepositoryRequest/UserID/GroupID":        {request: &qf.RepositoryRequest{CourseID: 1, UserID: 1, GroupID: 1}, want: false},
+		"Review/Invalid":                          {request: &qf.Review{}, want: false},
+		"Review/Valid":                            {request: &qf.Review{ReviewerID: 1, SubmissionID: 1}, want: true},
+		"ReviewRequest/MissingReview":             {request: &qf.ReviewRequest{CourseID: 1}, want: false},
+		"ReviewRequest/Valid":                     {request: &qf.ReviewRequest{CourseID: 1, Review: &qf.Review{ReviewerID: 1, SubmissionID: 1}}, want: true},
+		"SubmissionRequest/GroupID":               {request: &qf.SubmissionRequest{CourseID: 1, FetchMode: &qf.SubmissionRequest_GroupID{GroupID: 1}}, want: true},
+		"SubmissionRequest/Invalid":               {request: &qf.SubmissionRequest{CourseID: 1}, want: false},
+		"SubmissionRequest/MissingCourseID":       {request: &qf.SubmissionRequest{FetchMode: &qf.SubmissionRequest_SubmissionID{SubmissionID: 1}}, want: false},
+		"SubmissionRequest/SubmissionID":          {request: &qf.SubmissionRequest{CourseID: 1, FetchMode: &qf.SubmissionRequest_SubmissionID{SubmissionID: 1}}, want: true},
+		"SubmissionRequest/Type":                  {request: &qf.SubmissionRequest{CourseID: 1, FetchMode: &qf.SubmissionRequest_Type{Type: qf.SubmissionRequest_ALL}}, want: true},
+		"SubmissionRequest/UserID":                {request: &qf.SubmissionRequest{CourseID: 1, FetchMode: &qf.SubmissionRequest_UserID{UserID: 1}}, want: true},
+		"Grade/MissingSubmission":                 {request: &qf.Grade{UserID: 1}, want: false},
+		"Grade/Valid":                             {request: &qf.Grade{UserID: 1, SubmissionID: 1}, want: true},
+		"Grade/ValidGroupSubmission":              {request: &qf.Grade{SubmissionID: 1}, want: true},
+		"Grade/ValidStatusNone":                   {request: &qf.Grade{SubmissionID: 1, Status: qf.Submission_NONE}, want: true},
+		"Grade/ValidStatusApproved":               {request: &qf.Grade{SubmissionID: 1, Status: qf.Submission_APPROVED}, want: true},
+		"User/Invalid":                            {request: &qf.User{ID: 0}, want: false},
+		"User/Valid":                              {request: &qf.User{ID: 1}, want: true},
+		"Void/Valid":                              {request: &qf.Void{}, want: true},
 	}
 	// Run tests in sorted order for easier reading of test results.
 	for _, name := range slices.Sorted(maps.Keys(tests)) {
@@ -0,0 +1,108 @@
+package web
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// leaderboard ranks the latest submissions for the requested assignment by the requested metric
+// of the requested test, lowest value first. Submissions for which the test did not report
+// the metric, and submissions of students or groups no longer in the course, are not ranked.
+// If hideTests is true, hidden tests are not ranked until the assignment's deadline has passed;
+// the leaderboard is then empty, as for unknown tests, such that hidden test names are not revealed.
+func (s *QuickFeedService) leaderboard(req *qf.LeaderboardRequest, hideTests bool) (*qf.Leaderboard, error) {
+	courseID, assignmentID := req.GetCourseID(), req.GetAssignmentID()
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: assignmentID, CourseID: courseID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignment %d for course %d: %w", assignmentID, courseID, err)
+	}
+	if hideTests && slices.Contains(assignment.HiddenTests(time.Now()), req.GetTestName()) {
+		return &qf.Leaderboard{}, nil
+	}
+	submissions, err := s.db.GetSubmissions(&qf.Submission{AssignmentID: assignmentID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get submissions for assignment %d: %w", assignmentID, err)
+	}
+	scores, err := s.db.GetAssignmentScores(assignmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scores for assignment %d: %w", assignmentID, err)
+	}
+	enrollments, err := s.db.GetEnrollmentsByCourse(courseID, qf.Enrollment_STUDENT, qf.Enrollment_TEACHER)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enrollments for course %d: %w", courseID, err)
+	}
+	groups, err := s.db.GetGroupsByCourse(courseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get groups for course %d: %w", courseID, err)
+	}
+	userNames := make(map[uint64]string)
+	for _, enrollment := range enrollments {
+		userNames[enrollment.GetUserID()] = enrollment.GetUser().GetLogin()
+	}
+	groupNames := make(map[uint64]string)
+	for _, group := range groups {
+		groupNames[group.GetID()] = group.GetName()
+	}
+	values := make(map[uint64]float64)
+	for _, sc := range scores {
+		if sc.GetTestName() != req.GetTestName() {
+			continue
+		}
+		if value, ok := metricValue(sc, req.GetMetric()); ok {
+			values[sc.GetSubmissionID()] = value
+		}
+	}
+
+	leaderboard := &qf.Leaderboard{}
+	for _, submission := range submissions {
+		value, ok := values[submission.GetID()]
+		if !ok {
+			continue
+		}
+		entry := &qf.LeaderboardEntry{Value: value}
+		if submission.GetGroupID() > 0 {
+			entry.GroupID = submission.GetGroupID()
+			entry.Name = groupNames[entry.GetGroupID()]
+		} else {
+			entry.UserID = submission.GetUserID()
+			entry.Name = userNames[entry.GetUserID()]
+		}
+		if entry.GetName() != "" {
+			leaderboard.Entries = append(leaderboard.Entries, entry)
+		}
+	}
+	slices.SortFunc(leaderboard.Entries, func(a, b *qf.LeaderboardEntry) int {
+		return cmp.Or(cmp.Compare(a.GetValue(), b.GetValue()), cmp.Compare(a.GetName(), b.GetName()))
+	})
+	for i, entry := range leaderboard.Entries {
+		entry.Rank = uint32(i + 1)
+		if i > 0 && entry.GetValue() == leaderboard.Entries[i-1].GetValue() {
+			// equal values share the rank of the first entry with that value
+			entry.Rank = leaderboard.Entries[i-1].GetRank()
+		}
+	}
+	return leaderboard, nil
+}
+
+// metricValue returns the value of the given metric reported by the test score,
+// and false if the test did not report the metric. The benchmark metrics are only
+// reported if the test ran a benchmark; zero allocations per operation is a valid value.
+func metricValue(sc *score.Score, metric qf.LeaderboardRequest_Metric) (float64, bool) {
+	ranBenchmark := sc.GetNsPerOp() > 0
+	switch metric {
+	case qf.LeaderboardRequest_NS_PER_OP:
+		return sc.GetNsPerOp(), ranBenchmark
+	case qf.LeaderboardRequest_ALLOCS_PER_OP:
+		return float64(sc.GetAllocsPerOp()), ranBenchmark
+	case qf.LeaderboardRequest_BYTES_PER_OP:
+		return float64(sc.GetBytesPerOp()), ranBenchmark
+	case qf.LeaderboardRequest_EXEC_TIME:
+		return float64(sc.GetExecTime()), sc.GetExecTime() > 0
+	}
+	return 0, false
+}
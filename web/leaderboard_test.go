@@ -0,0 +1,140 @@
+package web_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"github.com/quickfeed/quickfeed/web/auth"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetLeaderboard(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	q := web.NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	teacher, course, assignment, alice := qtest.SetupCourseAssignmentTeacherStudent(t, db)
+	alice.Login = "alice"
+	qtest.UpdateUser(t, db, alice)
+	bob := qtest.CreateFakeCustomUser(t, db, &qf.User{Login: "bob"})
+	qtest.EnrollStudent(t, db, bob, course)
+	carol := qtest.CreateFakeCustomUser(t, db, &qf.User{Login: "carol"})
+	qtest.EnrollStudent(t, db, carol, course)
+	group := qtest.CreateFakeGroup(t, db, course, 2)
+
+	submissions := []*qf.Submission{
+		{UserID: alice.GetID(), Scores: []*score.Score{
+			{TestName: "TestCache", Score: 5, MaxScore: 10, Weight: 1, NsPerOp: 200, AllocsPerOp: 0},
+		}},
+		{UserID: bob.GetID(), Scores: []*score.Score{
+			{TestName: "TestCache", Score: 10, MaxScore: 10, Weight: 1, NsPerOp: 100, AllocsPerOp: 3},
+			{TestName: "TestOther", Score: 10, MaxScore: 10, Weight: 1, NsPerOp: 1},
+		}},
+		// carol's benchmark did not run; she is not ranked
+		{UserID: carol.GetID(), Scores: []*score.Score{
+			{TestName: "TestCache", Score: 0, MaxScore: 10, Weight: 1},
+		}},
+		{GroupID: group.GetID(), Scores: []*score.Score{
+			{TestName: "TestCache", Score: 10, MaxScore: 10, Weight: 1, NsPerOp: 100, AllocsPerOp: 2},
+		}},
+	}
+	for _, submission := range submissions {
+		submission.AssignmentID = assignment.GetID()
+		qtest.CreateSubmission(t, db, submission)
+	}
+
+	teacherCtx := (&auth.Claims{UserID: teacher.GetID(), Courses: map[uint64]qf.Enrollment_UserStatus{course.GetID(): qf.Enrollment_TEACHER}}).Context(t.Context())
+	studentCtx := (&auth.Claims{UserID: alice.GetID(), Courses: map[uint64]qf.Enrollment_UserStatus{course.GetID(): qf.Enrollment_STUDENT}}).Context(t.Context())
+	request := &qf.LeaderboardRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID(), TestName: "TestCache"}
+
+	// students cannot view the leaderboard until the course enables leaderboards
+	_, err := q.GetLeaderboard(studentCtx, request)
+	qtest.CheckError(t, err, connect.NewError(connect.CodePermissionDenied, errors.New("leaderboards are not enabled for this course")))
+
+	got, err := q.GetLeaderboard(teacherCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &qf.Leaderboard{Entries: []*qf.LeaderboardEntry{
+		{Rank: 1, Name: "bob", UserID: bob.GetID(), Value: 100},
+		{Rank: 1, Name: group.GetName(), GroupID: group.GetID(), Value: 100},
+		{Rank: 3, Name: "alice", UserID: alice.GetID(), Value: 200},
+	}}
+	qtest.Diff(t, "ns/op leaderboard mismatch", got, want, protocmp.Transform())
+
+	course.Leaderboard = true
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	request.Metric = qf.LeaderboardRequest_ALLOCS_PER_OP
+	got, err = q.GetLeaderboard(studentCtx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = &qf.Leaderboard{Entries: []*qf.LeaderboardEntry{
+		{Rank: 1, Name: "alice", UserID: alice.GetID(), Value: 0},
+		{Rank: 2, Name: group.GetName(), GroupID: group.GetID(), Value: 2},
+		{Rank: 3, Name: "bob", UserID: bob.GetID(), Value: 3},
+	}}
+	qtest.Diff(t, "allocs/op leaderboard mismatch", got, want, protocmp.Transform())
+}
+
+func TestGetLeaderboardHiddenTest(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	q := web.NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	teacher, course, _, alice := qtest.SetupCourseAssignmentTeacherStudent(t, db)
+	alice.Login = "alice"
+	qtest.UpdateUser(t, db, alice)
+	course.Leaderboard = true
+	if err := db.UpdateCourse(course); err != nil {
+		t.Fatal(err)
+	}
+	// the hidden test is not ranked for students until the assignment's deadline
+	assignment := &qf.Assignment{
+		CourseID: course.GetID(),
+		Name:     "lab2",
+		Order:    2,
+		Deadline: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		ExpectedTests: []*qf.TestInfo{
+			{TestName: "TestCache", MaxScore: 10, Weight: 1},
+			{TestName: "TestCacheHidden", MaxScore: 10, Weight: 1, Hidden: true},
+		},
+	}
+	qtest.CreateAssignment(t, db, assignment)
+	qtest.CreateSubmission(t, db, &qf.Submission{AssignmentID: assignment.GetID(), UserID: alice.GetID(), Scores: []*score.Score{
+		{TestName: "TestCache", Score: 10, MaxScore: 10, Weight: 1, NsPerOp: 100},
+		{TestName: "TestCacheHidden", Score: 10, MaxScore: 10, Weight: 1, NsPerOp: 200, Hidden: true},
+	}})
+
+	teacherCtx := (&auth.Claims{UserID: teacher.GetID(), Courses: map[uint64]qf.Enrollment_UserStatus{course.GetID(): qf.Enrollment_TEACHER}}).Context(t.Context())
+	studentCtx := (&auth.Claims{UserID: alice.GetID(), Courses: map[uint64]qf.Enrollment_UserStatus{course.GetID(): qf.Enrollment_STUDENT}}).Context(t.Context())
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		testName string
+		want     *qf.Leaderboard
+	}{
+		{name: "StudentVisibleTest", ctx: studentCtx, testName: "TestCache", want: &qf.Leaderboard{Entries: []*qf.LeaderboardEntry{{Rank: 1, Name: "alice", UserID: alice.GetID(), Value: 100}}}},
+		{name: "StudentHiddenTest", ctx: studentCtx, testName: "TestCacheHidden", want: &qf.Leaderboard{}},
+		{name: "TeacherHiddenTest", ctx: teacherCtx, testName: "TestCacheHidden", want: &qf.Leaderboard{Entries: []*qf.LeaderboardEntry{{Rank: 1, Name: "alice", UserID: alice.GetID(), Value: 200}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := q.GetLeaderboard(tt.ctx, &qf.LeaderboardRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID(), TestName: tt.testName})
+			if err != nil {
+				t.Fatal(err)
+			}
+			qtest.Diff(t, "leaderboard mismatch", got, tt.want, protocmp.Transform())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package web
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -26,21 +27,57 @@ type QuickFeedService struct {
 	db     database.Database
 	scmMgr *scm.Manager
 	runner ci.Runner
+	queue  *ci.Queue
+	logs   *ci.LogArchive // nil if build logs are not archived
 	tm     *auth.TokenManager
 	qfconnect.UnimplementedQuickFeedServiceHandler
-	streams *stream.StreamServices
+	streams          *stream.StreamServices
+	rebuildWatchers  watchers[uint64] // rebuild IDs
+	buildLogWatchers watchers[testRun]
 }
 
 // NewQuickFeedService returns a QuickFeedService object.
 func NewQuickFeedService(logger *zap.Logger, db database.Database, mgr *scm.Manager, runner ci.Runner, tm *auth.TokenManager) *QuickFeedService {
-	return &QuickFeedService{
+	s := &QuickFeedService{
 		logger:  logger.Sugar(),
 		db:      db,
 		scmMgr:  mgr,
 		runner:  runner,
+		queue:   ci.NewQueue(logger.Sugar(), db, mgr, runner),
 		tm:      tm,
 		streams: stream.NewStreamServices(),
 	}
+	s.queue.Handle(s.sendSubmission)
+	s.queue.HandleFinished(s.sendRebuildProgress)
+	s.queue.HandleFinished(s.closeBuildLogs)
+	s.queue.HandleOutput(s.sendBuildLog)
+	return s
+}
+
+// ArchiveBuildLogs enables archival of the complete build logs of test runs in the given archive.
+// ArchiveBuildLogs must be called before StartJobQueue.
+func (s *QuickFeedService) ArchiveBuildLogs(archive *ci.LogArchive) {
+	s.logs = archive
+	s.queue.ArchiveLogs(archive)
+}
+
+// CacheResults enables caching of test results in the given cache, such that tests are not run again
+// for the same student commit and tests, unless a rebuild is forced.
+// CacheResults must be called before StartJobQueue.
+func (s *QuickFeedService) CacheResults(cache *ci.ResultsCache) {
+	s.queue.CacheResults(cache)
+}
+
+// StartJobQueue starts executing queued test run jobs, including jobs
+// that were interrupted when the server was last stopped.
+func (s *QuickFeedService) StartJobQueue() error {
+	return s.queue.Start()
+}
+
+// StopJobQueue stops executing test run jobs. Running jobs are
+// returned to the queue and restarted by the next StartJobQueue.
+func (s *QuickFeedService) StopJobQueue() {
+	s.queue.Close()
 }
 
 // GetUser will return current user with active course enrollments
@@ -372,9 +409,16 @@ func (s *QuickFeedService) GetSubmissions(ctx context.Context, in *qf.Submission
 	}
 	submissions := &qf.Submissions{Submissions: subs}
 	id := userID(ctx)
-	// If the user is not a teacher, remove score and reviews from submissions that are not released.
+	// If the user is not a teacher, remove score and reviews from submissions that are not released,
+	// and hide the hidden tests of assignments whose deadline has not passed.
 	if !s.isTeacher(id, in.GetCourseID()) {
 		submissions.Clean(id)
+		assignments, err := s.db.GetAssignmentsByCourse(in.GetCourseID())
+		if err != nil {
+			s.logger.Errorf("GetSubmissions failed: %v", err)
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("no submissions found"))
+		}
+		submissions.HideTests(assignments, time.Now())
 	}
 	return submissions, nil
 }
@@ -413,23 +457,141 @@ func (s *QuickFeedService) UpdateSubmission(_ context.Context, in *qf.Grade) (*q
 // RebuildSubmissions re-runs the tests for the given assignment and course.
 // A single submission is executed again if the request specifies a submission ID
 // or all submissions if no submission ID is specified.
-func (s *QuickFeedService) RebuildSubmissions(_ context.Context, in *qf.RebuildRequest) (*qf.Void, error) {
+// Rebuilding all submissions returns immediately with the rebuild's ID,
+// which can be used to follow its progress with RebuildStream.
+// A dry run of all submissions does not record the results; use GetRebuildSummary to get its report.
+func (s *QuickFeedService) RebuildSubmissions(ctx context.Context, in *qf.RebuildRequest) (*qf.Rebuild, error) {
 	if in.GetSubmissionID() > 0 {
 		// Submission ID > 0 ==> rebuild single submission for given CourseID and AssignmentID
-		if err := s.internalRebuildSubmission(in); err != nil {
+		if err := s.internalRebuildSubmission(ctx, in); err != nil {
 			s.logger.Errorf("RebuildSubmission failed: %v", err)
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to rebuild submission"))
 		}
-	} else {
-		// Submission ID == 0 ==> rebuild all for given CourseID and AssignmentID
-		if err := s.internalRebuildAllSubmissions(in); err != nil {
-			s.logger.Errorf("RebuildSubmissions failed: %v", err)
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to rebuild submissions"))
-		}
+		return &qf.Rebuild{CourseID: in.GetCourseID(), AssignmentID: in.GetAssignmentID()}, nil
+	}
+	// Submission ID == 0 ==> rebuild all for given CourseID and AssignmentID
+	rebuild, err := s.internalRebuildAllSubmissions(in, false)
+	if err != nil {
+		s.logger.Errorf("RebuildSubmissions failed: %v", err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to rebuild submissions"))
+	}
+	return rebuild, nil
+}
+
+// CancelRebuild cancels the remaining jobs of the given rebuild.
+func (s *QuickFeedService) CancelRebuild(_ context.Context, in *qf.RebuildStatusRequest) (*qf.Void, error) {
+	rebuild, err := s.db.GetRebuild(in.GetRebuildID())
+	if err != nil || rebuild.GetCourseID() != in.GetCourseID() {
+		s.logger.Errorf("CancelRebuild failed: unknown rebuild %d for course %d: %v", in.GetRebuildID(), in.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("unknown rebuild"))
+	}
+	if err := s.queue.Cancel(&qf.Job{RebuildID: rebuild.GetID()}); err != nil {
+		s.logger.Errorf("CancelRebuild failed: %v", err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to cancel rebuild"))
 	}
 	return &qf.Void{}, nil
 }
 
+// GetRebuildSummaries returns summaries of the course's most recent rebuilds,
+// including how the rebuilds changed the scores of the rebuilt submissions.
+func (s *QuickFeedService) GetRebuildSummaries(_ context.Context, in *qf.CourseRequest) (*qf.RebuildSummaries, error) {
+	summaries, err := s.rebuildSummaries(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetRebuildSummaries failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get rebuild summaries"))
+	}
+	return summaries, nil
+}
+
+// GetRebuildSummary returns the summary of the given rebuild, e.g., the report of a dry run.
+func (s *QuickFeedService) GetRebuildSummary(_ context.Context, in *qf.RebuildStatusRequest) (*qf.RebuildSummary, error) {
+	rebuild, err := s.db.GetRebuild(in.GetRebuildID())
+	if err != nil || rebuild.GetCourseID() != in.GetCourseID() {
+		s.logger.Errorf("GetRebuildSummary failed: unknown rebuild %d for course %d: %v", in.GetRebuildID(), in.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("unknown rebuild"))
+	}
+	summary, err := s.rebuildSummary(rebuild)
+	if err != nil {
+		s.logger.Errorf("GetRebuildSummary failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get rebuild summary"))
+	}
+	return summary, nil
+}
+
+// GetTestsHealth returns the results of the most recent runs of the course's tests
+// against the reference solution, one for each assignment.
+func (s *QuickFeedService) GetTestsHealth(_ context.Context, in *qf.CourseRequest) (*qf.TestsHealth, error) {
+	health, err := s.testsHealth(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetTestsHealth failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get tests health"))
+	}
+	return health, nil
+}
+
+// GetBuildLogArchive returns the complete build log of the given submission's test run.
+func (s *QuickFeedService) GetBuildLogArchive(_ context.Context, in *qf.BuildLogArchiveRequest) (*qf.BuildLogArchive, error) {
+	if s.logs == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("build logs are not archived"))
+	}
+	submission, err := s.db.GetLastSubmission(in.GetCourseID(), &qf.Submission{ID: in.GetSubmissionID()})
+	if err != nil {
+		s.logger.Errorf("GetBuildLogArchive failed: unknown submission %d for course %d: %v", in.GetSubmissionID(), in.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("unknown submission"))
+	}
+	content, commitID, err := s.logs.Load(submission.GetID(), in.GetCommitID())
+	if err != nil {
+		s.logger.Errorf("GetBuildLogArchive failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("no archived build log for submission"))
+	}
+	return &qf.BuildLogArchive{
+		SubmissionID: submission.GetID(),
+		CommitID:     commitID,
+		Content:      content,
+	}, nil
+}
+
+// GetLeaderboard ranks the latest submissions for the given assignment by a benchmark metric of the given test.
+// Students may only view the leaderboard if the course has enabled leaderboards.
+func (s *QuickFeedService) GetLeaderboard(ctx context.Context, in *qf.LeaderboardRequest) (*qf.Leaderboard, error) {
+	course, err := s.db.GetCourse(in.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetLeaderboard failed: unknown course %d: %v", in.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("unknown course"))
+	}
+	if !course.GetLeaderboard() && !isTeacher(ctx, in.GetCourseID()) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("leaderboards are not enabled for this course"))
+	}
+	leaderboard, err := s.leaderboard(in, !isTeacher(ctx, in.GetCourseID()))
+	if err != nil {
+		s.logger.Errorf("GetLeaderboard failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get leaderboard"))
+	}
+	return leaderboard, nil
+}
+
+// GetStaleSubmissions returns the submissions for the given assignment that were graded
+// with another revision of the tests repository than the current revision.
+func (s *QuickFeedService) GetStaleSubmissions(_ context.Context, in *qf.StaleSubmissionsRequest) (*qf.StaleSubmissions, error) {
+	stale, err := s.staleSubmissions(in.GetCourseID(), in.GetAssignmentID())
+	if err != nil {
+		s.logger.Errorf("GetStaleSubmissions failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get stale submissions"))
+	}
+	return stale, nil
+}
+
+// GetFlakyTests returns the tests for the given assignment that failed, but passed when rerun,
+// in the assignment's recorded submissions.
+func (s *QuickFeedService) GetFlakyTests(_ context.Context, in *qf.FlakyTestsRequest) (*qf.FlakyTests, error) {
+	flaky, err := s.flakyTests(in.GetCourseID(), in.GetAssignmentID())
+	if err != nil {
+		s.logger.Errorf("GetFlakyTests failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get flaky tests"))
+	}
+	return flaky, nil
+}
+
 // CreateReview adds a new submission review.
 func (s *QuickFeedService) CreateReview(_ context.Context, in *qf.ReviewRequest) (*qf.Review, error) {
 	review := in.GetReview()
@@ -473,12 +635,19 @@ func (s *QuickFeedService) GetAssignmentFeedback(_ context.Context, in *qf.Cours
 }
 
 // GetAssignments returns a list of all assignments for the given course.
-func (s *QuickFeedService) GetAssignments(_ context.Context, in *qf.CourseRequest) (*qf.Assignments, error) {
+func (s *QuickFeedService) GetAssignments(ctx context.Context, in *qf.CourseRequest) (*qf.Assignments, error) {
 	assignments, err := s.db.GetAssignmentsByCourse(in.GetCourseID())
 	if err != nil {
 		s.logger.Errorf("GetAssignments failed: %v", err)
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("no assignments found for course"))
 	}
+	// If the user is not a teacher, hide the hidden tests of assignments whose deadline has not passed.
+	if !isTeacher(ctx, in.GetCourseID()) {
+		now := time.Now()
+		for _, assignment := range assignments {
+			assignment.HideTests(now)
+		}
+	}
 	return &qf.Assignments{Assignments: assignments}, nil
 }
 
@@ -495,7 +664,9 @@ func (s *QuickFeedService) UpdateAssignments(ctx context.Context, in *qf.CourseR
 		s.logger.Errorf("UpdateAssignments failed: could not create scm client for organization %s: %v", course.GetScmOrganizationName(), err)
 		return nil, scmConnectErr
 	}
-	assignments.UpdateFromTestsRepo(s.logger, s.runner, s.db, scmClient, course)
+	if err := assignments.UpdateFromTestsRepo(s.logger, s.runner, s.db, scmClient, course); err != nil {
+		s.logger.Errorf("UpdateAssignments failed: %v", err)
+	}
 
 	clonedAssignmentsRepo, err := scmClient.Clone(ctx, &scm.CloneOptions{
 		Organization: course.GetScmOrganizationName(),
@@ -587,3 +758,55 @@ func (s *QuickFeedService) SubmissionStream(ctx context.Context, _ *qf.Void, st
 	s.streams.Submission.Add(stream, userID(ctx))
 	return stream.Run()
 }
+
+// RebuildStream sends the progress of the given rebuild to the client, starting
+// with the current progress, followed by an update for every finished job.
+// The stream is closed when the client disconnects.
+func (s *QuickFeedService) RebuildStream(ctx context.Context, in *qf.RebuildStatusRequest, st *connect.ServerStream[qf.RebuildProgress]) error {
+	// streams are not subject to the access control interceptor; hence, access is checked here
+	if !isTeacher(ctx, in.GetCourseID()) {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("access denied for RebuildStream"))
+	}
+	rebuild, err := s.db.GetRebuild(in.GetRebuildID())
+	if err != nil || rebuild.GetCourseID() != in.GetCourseID() {
+		s.logger.Errorf("RebuildStream failed: unknown rebuild %d for course %d: %v", in.GetRebuildID(), in.GetCourseID(), err)
+		return connect.NewError(connect.CodeNotFound, errors.New("unknown rebuild"))
+	}
+	progress, err := s.rebuildProgress(rebuild.GetID())
+	if err != nil {
+		s.logger.Errorf("RebuildStream failed: %v", err)
+		return connect.NewError(connect.CodeNotFound, errors.New("failed to get rebuild progress"))
+	}
+	// Send the current progress before the stream is added to the stream service;
+	// after that, only the stream's Run method may send on the stream.
+	if err := st.Send(progress); err != nil {
+		return err
+	}
+	stream := stream.NewStream(ctx, st)
+	s.streams.Rebuild.Add(stream, userID(ctx))
+	s.rebuildWatchers.watch(userID(ctx), rebuild.GetID())
+	defer s.rebuildWatchers.unwatch(userID(ctx), rebuild.GetID())
+	return stream.Run()
+}
+
+// BuildLogStream sends the output of the requested test run to the client, while the tests are running.
+// The stream is closed when the test run is finished or the client disconnects.
+func (s *QuickFeedService) BuildLogStream(ctx context.Context, in *qf.BuildLogRequest, st *connect.ServerStream[qf.BuildLog]) error {
+	if !in.IsValid() {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid payload"))
+	}
+	// streams are not subject to the access control interceptor; hence, access is checked here
+	if !canFollowBuildLog(ctx, in) {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("access denied for BuildLogStream"))
+	}
+	run, err := s.testRun(in)
+	if err != nil {
+		s.logger.Errorf("BuildLogStream failed: %v", err)
+		return connect.NewError(connect.CodeNotFound, errors.New("unknown test run"))
+	}
+	stream := stream.NewStream(ctx, st)
+	s.streams.BuildLog.Add(stream, userID(ctx))
+	s.buildLogWatchers.watch(userID(ctx), run)
+	defer s.buildLogWatchers.unwatch(userID(ctx), run)
+	return stream.Run()
+}
@@ -1,114 +1,309 @@
 package web
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/kit/score"
 	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"google.golang.org/protobuf/proto"
 )
 
-const maxContainers = 10
+// maxRebuildSummaries is the maximum number of rebuilds summarized by GetRebuildSummaries.
+const maxRebuildSummaries = 10
 
 // internalRebuildSubmission rebuilds the given assignment and submission.
-func (s *QuickFeedService) internalRebuildSubmission(request *qf.RebuildRequest) error {
-	submission, err := s.db.GetSubmission(&qf.Submission{ID: request.GetSubmissionID()})
+// The rebuild is executed by the job queue; the method returns when the job is finished.
+func (s *QuickFeedService) internalRebuildSubmission(ctx context.Context, request *qf.RebuildRequest) error {
+	job, err := s.rebuildJob(request)
 	if err != nil {
 		return err
 	}
-	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: request.GetAssignmentID()})
-	if err != nil {
+	if err := s.queue.Enqueue(job); err != nil {
 		return err
 	}
-	course, err := s.db.GetCourse(assignment.GetCourseID())
+	if err := s.queue.Wait(ctx, job.GetID()); err != nil {
+		return err
+	}
+	job, err = s.db.GetJob(job.GetID())
 	if err != nil {
 		return err
 	}
-	name := s.lookupName(submission)
+	if job.GetStatus() == qf.Job_FAILED {
+		return errors.New(job.GetError())
+	}
+	return nil
+}
 
-	var repo *qf.Repository
-	if assignment.GetIsGroupLab() && submission.GetGroupID() > 0 {
-		repo, err = s.getRepo(course, submission.GetGroupID(), qf.Repository_GROUP)
-		s.logger.Debugf("Rebuilding submission %d for group(%d): %s, assignment: %+v, repo: %s",
-			submission.GetID(), submission.GetGroupID(), name, assignment, repo.GetHTMLURL())
+// autoRebuild enqueues jobs to rebuild the stale submissions for the given assignment,
+// whose tests were changed by a push to the tests repository.
+func (s *QuickFeedService) autoRebuild(assignment *qf.Assignment) (*qf.Rebuild, error) {
+	return s.internalRebuildAllSubmissions(&qf.RebuildRequest{
+		CourseID:     assignment.GetCourseID(),
+		AssignmentID: assignment.GetID(),
+		Stale:        true,
+	}, true)
+}
+
+// internalRebuildAllSubmissions enqueues jobs to rebuild all submissions for the given assignment,
+// or only the stale submissions if the request asks for it. A dry run only reports the results,
+// which are available from the rebuild's summary once the jobs are finished. The automatic flag records
+// whether the rebuild was triggered by a push to the tests repository.
+// The method returns the rebuild record without waiting for the jobs to finish.
+func (s *QuickFeedService) internalRebuildAllSubmissions(request *qf.RebuildRequest, automatic bool) (*qf.Rebuild, error) {
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	if request.GetDryRun() && assignment.GradedManually() {
+		return nil, fmt.Errorf("assignment %s is manually graded; there are no tests to run", assignment.GetName())
+	}
+	var submissions []*qf.Submission
+	if request.GetStale() {
+		stale, err := s.staleSubmissions(assignment.GetCourseID(), assignment.GetID())
+		if err != nil {
+			return nil, err
+		}
+		submissions = stale.GetSubmissions()
 	} else {
-		repo, err = s.getRepo(course, submission.GetUserID(), qf.Repository_USER)
-		s.logger.Debugf("Rebuilding submission %d for user(%d): %s, assignment: %+v, repo: %s",
-			submission.GetID(), submission.GetUserID(), name, assignment, repo.GetHTMLURL())
+		submissions, err = s.db.GetSubmissions(&qf.Submission{AssignmentID: assignment.GetID()})
+		if err != nil {
+			return nil, err
+		}
 	}
-	if err != nil {
-		return err
+	rebuild := &qf.Rebuild{
+		CourseID:     assignment.GetCourseID(),
+		AssignmentID: assignment.GetID(),
+		Automatic:    automatic,
+		DryRun:       request.GetDryRun(),
+		TestsBranch:  request.GetTestsBranch(),
 	}
+	if err := s.db.CreateRebuild(rebuild); err != nil {
+		return nil, err
+	}
+	s.logger.Debugf("Rebuilding all submissions for assignment %d for course %d (rebuild %d)", assignment.GetID(), assignment.GetCourseID(), rebuild.GetID())
 
-	runData := &ci.RunData{
-		Course:     course,
-		Assignment: assignment,
-		Repo:       repo,
-		CommitID:   submission.GetCommitHash(),
-		JobOwner:   name,
-		Rebuild:    true,
+	for _, submission := range submissions {
+		job, err := s.rebuildJob(&qf.RebuildRequest{
+			AssignmentID: request.GetAssignmentID(),
+			SubmissionID: submission.GetID(),
+			Force:        request.GetForce(),
+			DryRun:       request.GetDryRun(),
+			TestsBranch:  request.GetTestsBranch(),
+		})
+		if err != nil {
+			s.logger.Errorf("Failed to rebuild submission %d: %v", submission.GetID(), err)
+			s.recordFailedJob(rebuild, submission, err)
+			continue
+		}
+		job.RebuildID = rebuild.GetID()
+		if err := s.queue.Enqueue(job); err != nil {
+			s.logger.Errorf("Failed to rebuild submission %d: %v", submission.GetID(), err)
+			s.recordFailedJob(rebuild, submission, err)
+		}
 	}
-	ctx, cancel := assignment.WithTimeout(ci.DefaultContainerTimeout)
-	defer cancel()
-	sc, err := s.getSCM(ctx, course.GetScmOrganizationName())
+	return rebuild, nil
+}
+
+// staleSubmissions returns the submissions for the given assignment that were graded
+// with another revision of the course's tests repository than the current revision.
+// Manually graded assignments have no stale submissions, since their tests are not run.
+func (s *QuickFeedService) staleSubmissions(courseID, assignmentID uint64) (*qf.StaleSubmissions, error) {
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: assignmentID, CourseID: courseID})
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to get assignment %d for course %d: %w", assignmentID, courseID, err)
 	}
-	results, err := runData.RunTests(ctx, s.logger, sc, s.runner)
+	course, err := s.db.GetCourse(courseID)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to get course %d: %w", courseID, err)
 	}
-	submission, err = runData.RecordResults(s.logger, s.db, results)
+	testsCommit, err := ci.TestsCommit(course)
 	if err != nil {
-		return fmt.Errorf("failed to record results for assignment %s for course %s: %w", assignment.GetName(), course.GetName(), err)
+		return nil, fmt.Errorf("failed to get the commit of the tests repository for course %s: %w", course.GetCode(), err)
 	}
-	// If we fail to get owners, we ignore sending on the stream.
-	if userIDs, err := runData.GetOwners(s.db); err == nil {
-		// Note that streaming the submission as-is sends all grades
-		// to all participants for a given group submission.
-		s.streams.Submission.SendTo(submission, userIDs...)
+	if assignment.GradedManually() {
+		return &qf.StaleSubmissions{TestsCommit: testsCommit}, nil
 	}
-	return nil
+	submissions, err := s.db.GetStaleSubmissions(assignment.GetID(), testsCommit)
+	if err != nil {
+		return nil, err
+	}
+	return &qf.StaleSubmissions{TestsCommit: testsCommit, Submissions: submissions}, nil
 }
 
-func (s *QuickFeedService) internalRebuildAllSubmissions(request *qf.RebuildRequest) error {
-	submissions, err := s.db.GetSubmissions(&qf.Submission{AssignmentID: request.GetAssignmentID()})
+// recordFailedJob records a failed job for a submission that could not be rebuilt,
+// such that the failure is included in the rebuild's progress.
+func (s *QuickFeedService) recordFailedJob(rebuild *qf.Rebuild, submission *qf.Submission, reason error) {
+	job := &qf.Job{
+		CourseID:      rebuild.GetCourseID(),
+		AssignmentID:  rebuild.GetAssignmentID(),
+		SubmissionID:  submission.GetID(),
+		JobOwner:      s.lookupName(submission),
+		Rebuild:       true,
+		RebuildID:     rebuild.GetID(),
+		PreviousScore: submission.GetScore(),
+		DryRun:        rebuild.GetDryRun(),
+		TestsBranch:   rebuild.GetTestsBranch(),
+	}
+	if err := s.db.CreateJob(job); err != nil {
+		s.logger.Errorf("Failed to create job for submission %d: %v", submission.GetID(), err)
+		return
+	}
+	job.Status = qf.Job_FAILED
+	job.Error = reason.Error()
+	if err := s.db.UpdateJob(job); err != nil {
+		s.logger.Errorf("Failed to update job %d: %v", job.GetID(), err)
+	}
+}
+
+// rebuildProgress returns the current progress of the given rebuild.
+func (s *QuickFeedService) rebuildProgress(rebuildID uint64) (*qf.RebuildProgress, error) {
+	jobs, err := s.db.GetJobs(&qf.Job{RebuildID: rebuildID})
 	if err != nil {
-		return err
+		return nil, err
+	}
+	progress := &qf.RebuildProgress{
+		RebuildID: rebuildID,
+		Total:     uint32(len(jobs)),
+	}
+	for _, job := range jobs {
+		switch job.GetStatus() {
+		case qf.Job_SUCCEEDED:
+			progress.Succeeded++
+		case qf.Job_FAILED:
+			progress.Failed++
+		case qf.Job_CANCELLED:
+			progress.Cancelled++
+		}
 	}
-	s.logger.Debugf("Rebuilding all submissions for assignment %d for course %d\n", request.GetAssignmentID(), request.GetCourseID())
-	start := time.Now()
+	return progress, nil
+}
 
-	// counting semaphore: limit concurrent rebuilding to maxContainers
-	sem := make(chan struct{}, maxContainers)
-	errCnt := int32(0)
-	var wg sync.WaitGroup
-	wg.Add(len(submissions))
-	for _, submission := range submissions {
-		rebuildReq := &qf.RebuildRequest{
-			AssignmentID: request.GetAssignmentID(),
-			SubmissionID: submission.GetID(),
+// rebuildSummaries returns summaries of the most recent rebuilds for the given course.
+func (s *QuickFeedService) rebuildSummaries(courseID uint64) (*qf.RebuildSummaries, error) {
+	rebuilds, err := s.db.GetRebuilds(courseID, maxRebuildSummaries)
+	if err != nil {
+		return nil, err
+	}
+	summaries := &qf.RebuildSummaries{}
+	for _, rebuild := range rebuilds {
+		summary, err := s.rebuildSummary(rebuild)
+		if err != nil {
+			return nil, err
 		}
-		// the counting semaphore limits concurrency to maxContainers
-		go func() {
-			sem <- struct{}{} // acquire semaphore
-			err := s.internalRebuildSubmission(rebuildReq)
-			if err != nil {
-				atomic.AddInt32(&errCnt, 1)
-				s.logger.Errorf("Failed to rebuild submission %d: %v\n", rebuildReq.GetSubmissionID(), err)
+		summaries.Summaries = append(summaries.Summaries, summary)
+	}
+	return summaries, nil
+}
+
+// rebuildSummary returns the progress of the given rebuild and how its succeeded jobs changed the scores.
+// Jobs that changed the score or the status of a test are included in the summary.
+func (s *QuickFeedService) rebuildSummary(rebuild *qf.Rebuild) (*qf.RebuildSummary, error) {
+	progress, err := s.rebuildProgress(rebuild.GetID())
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := s.db.GetJobs(&qf.Job{RebuildID: rebuild.GetID(), Status: qf.Job_SUCCEEDED})
+	if err != nil {
+		return nil, err
+	}
+	summary := &qf.RebuildSummary{Rebuild: rebuild, Progress: progress}
+	for _, job := range jobs {
+		switch {
+		case job.GetScore() > job.GetPreviousScore():
+			summary.Increased++
+		case job.GetScore() < job.GetPreviousScore():
+			summary.Decreased++
+		default:
+			summary.Unchanged++
+			if len(job.GetNowPassing()) == 0 && len(job.GetNowFailing()) == 0 {
+				continue
 			}
-			<-sem // release semaphore
-			wg.Done()
-		}()
+		}
+		summary.Changed = append(summary.Changed, job)
 	}
-	// wait for all submissions to finish rebuilding
-	wg.Wait()
-	close(sem)
+	return summary, nil
+}
 
-	s.logger.Debugf("Rebuilt %d submissions in %v (failed: %d)", len(submissions), time.Since(start), errCnt)
-	return nil
+// sendRebuildProgress sends the progress of the finished job's rebuild to the users following the rebuild.
+func (s *QuickFeedService) sendRebuildProgress(job *qf.Job) {
+	if job.GetRebuildID() == 0 {
+		return
+	}
+	userIDs := s.rebuildWatchers.users(job.GetRebuildID())
+	if len(userIDs) == 0 {
+		return
+	}
+	progress, err := s.rebuildProgress(job.GetRebuildID())
+	if err != nil {
+		s.logger.Errorf("Failed to get progress for rebuild %d: %v", job.GetRebuildID(), err)
+		return
+	}
+	progress.Job = job
+	s.streams.Rebuild.SendTo(progress, userIDs...)
+}
+
+// rebuildJob returns a job for rebuilding the submission given by the request.
+func (s *QuickFeedService) rebuildJob(request *qf.RebuildRequest) (*qf.Job, error) {
+	submission, err := s.db.GetSubmission(&qf.Submission{ID: request.GetSubmissionID()})
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: request.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	course, err := s.db.GetCourse(assignment.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	name := s.lookupName(submission)
+
+	var repo *qf.Repository
+	if assignment.GetIsGroupLab() && submission.GetGroupID() > 0 {
+		repo, err = s.getRepo(course, submission.GetGroupID(), qf.Repository_GROUP)
+		s.logger.Debugf("Rebuilding submission %d for group(%d): %s, assignment: %+v, repo: %s",
+			submission.GetID(), submission.GetGroupID(), name, assignment, repo.GetHTMLURL())
+	} else {
+		repo, err = s.getRepo(course, submission.GetUserID(), qf.Repository_USER)
+		s.logger.Debugf("Rebuilding submission %d for user(%d): %s, assignment: %+v, repo: %s",
+			submission.GetID(), submission.GetUserID(), name, assignment, repo.GetHTMLURL())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &qf.Job{
+		CourseID:      course.GetID(),
+		AssignmentID:  assignment.GetID(),
+		RepositoryID:  repo.GetID(),
+		SubmissionID:  submission.GetID(),
+		CommitID:      submission.GetCommitHash(),
+		JobOwner:      name,
+		Rebuild:       true,
+		Force:         request.GetForce(),
+		PreviousScore: submission.GetScore(),
+		DryRun:        request.GetDryRun(),
+		TestsBranch:   request.GetTestsBranch(),
+	}, nil
+}
+
+// sendSubmission sends the recorded submission of a finished job to the submission's owners.
+func (s *QuickFeedService) sendSubmission(_ context.Context, _ scm.SCM, _ *qf.Job, runData *ci.RunData, _ *score.Results, submission *qf.Submission) {
+	// If we fail to get owners, we ignore sending on the stream.
+	if userIDs, err := runData.GetOwners(s.db); err == nil {
+		// Note that streaming the submission as-is sends all grades
+		// to all participants for a given group submission.
+		if runData.Assignment.SinceDeadline(time.Now()) < 0 {
+			// the owners are students; hide the hidden tests until the deadline has passed
+			submission = proto.CloneOf(submission)
+			submission.HideTests()
+		}
+		s.streams.Submission.SendTo(submission, userIDs...)
+	}
 }
 
 func (s *QuickFeedService) lookupName(submission *qf.Submission) string {
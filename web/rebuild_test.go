@@ -2,64 +2,24 @@ package web_test
 
 import (
 	"errors"
-	"fmt"
-	"math"
 	"path/filepath"
-	"sync"
-	"sync/atomic"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/quickfeed/quickfeed/ci"
 	"github.com/quickfeed/quickfeed/internal/env"
 	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/kit/score"
 	"github.com/quickfeed/quickfeed/qf"
 	"github.com/quickfeed/quickfeed/scm"
 	"github.com/quickfeed/quickfeed/web"
+	"github.com/quickfeed/quickfeed/web/auth"
 )
 
-func TestSimulatedRebuildWorkPoolWithErrCount(t *testing.T) {
-	t.Skip("Disabled: mainly used for testing the work pool logic used in rebuildSubmissions")
-	for _, maxContainers := range []int{3, 5, 6, 8, 10} {
-		for _, errRate := range []int{2, 3, 4, 5, 6} {
-			for _, numSubs := range []int{10, 15, 20, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 150, 500} {
-				t.Run(fmt.Sprintf("containers=%d/errRate=%d/submissions=%d", maxContainers, errRate, numSubs), func(t *testing.T) {
-					submissions := make([]int, numSubs)
-					for i := range submissions {
-						submissions[i] = i
-					}
-					sem := make(chan struct{}, maxContainers)
-					errCnt := int32(0)
-					var wg sync.WaitGroup
-					wg.Add(len(submissions))
-					for _, submission := range submissions {
-						submission := submission
-						// the counting semaphore limits concurrency to maxContainers
-						go func() {
-							sem <- struct{}{} // acquire semaphore
-							// here we are rebuilding submission
-							if submission%errRate == 0 { // simulate error every errRate submission
-								// count the error
-								atomic.AddInt32(&errCnt, 1)
-							}
-							<-sem // release semaphore
-							wg.Done()
-						}()
-					}
-					// wait for all submissions to finish rebuilding
-					wg.Wait()
-					close(sem)
-
-					expectedErrCnt := int32(math.Ceil(float64(len(submissions)) / float64(errRate)))
-					if errCnt != expectedErrCnt {
-						t.Errorf("errCnt != expectedErrCnt ==> %d != %d == (%d/%d)", errCnt, expectedErrCnt, len(submissions), errRate)
-					}
-				})
-			}
-		}
-	}
-}
-
 func TestRebuildSubmissions(t *testing.T) {
 	repoPath := t.TempDir()
 	t.Setenv("QUICKFEED_REPOSITORY_PATH", repoPath)
@@ -75,6 +35,10 @@ func TestRebuildSubmissions(t *testing.T) {
 	defer cleanup()
 	logger := qtest.Logger(t).Desugar()
 	q := web.NewQuickFeedService(logger, db, mgr, &ci.Local{}, nil)
+	if err := q.StartJobQueue(); err != nil {
+		t.Fatal(err)
+	}
+	defer q.StopJobQueue()
 	teacher := qtest.CreateFakeUser(t, db)
 	qtest.UpdateUser(t, db, &qf.User{ID: teacher.GetID(), IsAdmin: true})
 
@@ -167,6 +131,13 @@ func TestRebuildSubmissions(t *testing.T) {
 				AssignmentID: assignment.GetID(),
 			},
 		},
+		{
+			name: "Rebuild stale submissions",
+			request: &qf.RebuildRequest{
+				AssignmentID: assignment.GetID(),
+				Stale:        true,
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -179,3 +150,340 @@ func TestRebuildSubmissions(t *testing.T) {
 		t.Errorf("Incorrect number of submissions after rebuild: expected %d, got %d", len(initialSubmissions), len(rebuiltSubmissions))
 	}
 }
+
+func TestCancelRebuild(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	// The job queue is not started; jobs remain queued until cancelled.
+	q := web.NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	teacher := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, teacher, course)
+	student1 := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student1, course)
+	student2 := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student2, course)
+
+	// Only student1 has a repository; rebuilding student2's submission fails.
+	qtest.CreateRepository(t, db, &qf.Repository{
+		ScmOrganizationID: course.GetScmOrganizationID(),
+		ScmRepositoryID:   1,
+		UserID:            student1.GetID(),
+		RepoType:          qf.Repository_USER,
+	})
+	assignment := &qf.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1}
+	qtest.CreateAssignment(t, db, assignment)
+	qtest.CreateSubmission(t, db, &qf.Submission{AssignmentID: assignment.GetID(), UserID: student1.GetID()})
+	qtest.CreateSubmission(t, db, &qf.Submission{AssignmentID: assignment.GetID(), UserID: student2.GetID()})
+
+	rebuild, err := q.RebuildSubmissions(t.Context(), &qf.RebuildRequest{
+		CourseID:     course.GetID(),
+		AssignmentID: assignment.GetID(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rebuild.GetID() == 0 {
+		t.Fatal("RebuildSubmissions() returned rebuild without ID")
+	}
+
+	wantErr := connect.NewError(connect.CodeNotFound, errors.New("unknown rebuild"))
+	_, err = q.CancelRebuild(t.Context(), &qf.RebuildStatusRequest{CourseID: course.GetID() + 1, RebuildID: rebuild.GetID()})
+	qtest.CheckError(t, err, wantErr)
+
+	if _, err = q.CancelRebuild(t.Context(), &qf.RebuildStatusRequest{CourseID: course.GetID(), RebuildID: rebuild.GetID()}); err != nil {
+		t.Fatal(err)
+	}
+	jobs, err := db.GetJobs(&qf.Job{RebuildID: rebuild.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotStatus := make(map[qf.Job_Status]int)
+	for _, job := range jobs {
+		gotStatus[job.GetStatus()]++
+	}
+	wantStatus := map[qf.Job_Status]int{qf.Job_CANCELLED: 1, qf.Job_FAILED: 1}
+	qtest.Diff(t, "job status mismatch", gotStatus, wantStatus)
+}
+
+func TestRebuildStreamAccess(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	q := web.NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	teacher, course, _, student := qtest.SetupCourseAssignmentTeacherStudent(t, db)
+
+	teacherClaims := &auth.Claims{UserID: teacher.GetID(), Courses: map[uint64]qf.Enrollment_UserStatus{course.GetID(): qf.Enrollment_TEACHER}}
+	studentClaims := &auth.Claims{UserID: student.GetID(), Courses: map[uint64]qf.Enrollment_UserStatus{course.GetID(): qf.Enrollment_STUDENT}}
+	tests := []struct {
+		name    string
+		claims  *auth.Claims
+		request *qf.RebuildStatusRequest
+		wantErr error
+	}{
+		{
+			name:    "Student",
+			claims:  studentClaims,
+			request: &qf.RebuildStatusRequest{CourseID: course.GetID(), RebuildID: 1},
+			wantErr: connect.NewError(connect.CodePermissionDenied, errors.New("access denied for RebuildStream")),
+		},
+		{
+			name:    "OtherCourse",
+			claims:  teacherClaims,
+			request: &qf.RebuildStatusRequest{CourseID: course.GetID() + 1, RebuildID: 1},
+			wantErr: connect.NewError(connect.CodePermissionDenied, errors.New("access denied for RebuildStream")),
+		},
+		{
+			name:    "UnknownRebuild",
+			claims:  teacherClaims,
+			request: &qf.RebuildStatusRequest{CourseID: course.GetID(), RebuildID: 1},
+			wantErr: connect.NewError(connect.CodeNotFound, errors.New("unknown rebuild")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := q.RebuildStream(tt.claims.Context(t.Context()), tt.request, nil)
+			qtest.CheckError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGetStaleSubmissions(t *testing.T) {
+	repoPath := t.TempDir()
+	t.Setenv("QUICKFEED_REPOSITORY_PATH", repoPath)
+	src := filepath.Join(env.TestdataPath(), qtest.MockOrg)
+	qtest.PrepareGitRepo(t, src, filepath.Join(repoPath, qtest.MockOrg), qf.TestsRepo)
+
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	q := web.NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	teacher := qtest.CreateFakeUser(t, db)
+	course := &qf.Course{Code: "DAT320", ScmOrganizationName: qtest.MockOrg}
+	qtest.CreateCourse(t, db, teacher, course)
+	assignment := &qf.Assignment{CourseID: course.GetID(), Name: "lab1", Order: 1}
+	qtest.CreateAssignment(t, db, assignment)
+
+	testsCommit, err := ci.TestsCommit(course)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, current, course)
+	qtest.CreateSubmission(t, db, &qf.Submission{
+		AssignmentID: assignment.GetID(),
+		UserID:       current.GetID(),
+		BuildInfo:    &score.BuildInfo{TestsCommit: testsCommit},
+	})
+	stale := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, stale, course)
+	staleSubmission := &qf.Submission{
+		AssignmentID: assignment.GetID(),
+		UserID:       stale.GetID(),
+		BuildInfo:    &score.BuildInfo{TestsCommit: "0123abcd", BuildLog: "old tests"},
+	}
+	qtest.CreateSubmission(t, db, staleSubmission)
+
+	got, err := q.GetStaleSubmissions(t.Context(), &qf.StaleSubmissionsRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetTestsCommit() != testsCommit {
+		t.Errorf("GetStaleSubmissions() tests commit = %q, want %q", got.GetTestsCommit(), testsCommit)
+	}
+	if len(got.GetSubmissions()) != 1 || got.GetSubmissions()[0].GetID() != staleSubmission.GetID() {
+		t.Errorf("GetStaleSubmissions() = %v, want submission %d", got.GetSubmissions(), staleSubmission.GetID())
+	}
+	if log := got.GetSubmissions()[0].GetBuildInfo().GetBuildLog(); log != "" {
+		t.Errorf("GetStaleSubmissions() build log = %q, want none", log)
+	}
+
+	_, err = q.GetStaleSubmissions(t.Context(), &qf.StaleSubmissionsRequest{CourseID: course.GetID() + 1, AssignmentID: assignment.GetID()})
+	qtest.CheckError(t, err, connect.NewError(connect.CodeNotFound, errors.New("failed to get stale submissions")))
+}
+
+func TestGetRebuildSummaries(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	q := web.NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	teacher := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, teacher, course)
+
+	rebuild := &qf.Rebuild{CourseID: course.GetID(), AssignmentID: 1, Automatic: true}
+	if err := db.CreateRebuild(rebuild); err != nil {
+		t.Fatal(err)
+	}
+	// a rebuild for another course is not summarized
+	if err := db.CreateRebuild(&qf.Rebuild{CourseID: course.GetID() + 1, AssignmentID: 2}); err != nil {
+		t.Fatal(err)
+	}
+	jobs := []*qf.Job{
+		{JobOwner: "increased", PreviousScore: 50, Score: 80, Status: qf.Job_SUCCEEDED},
+		{JobOwner: "decreased", PreviousScore: 80, Score: 60, Status: qf.Job_SUCCEEDED},
+		{JobOwner: "unchanged", PreviousScore: 70, Score: 70, Status: qf.Job_SUCCEEDED},
+		{JobOwner: "failed", PreviousScore: 40, Status: qf.Job_FAILED},
+		{JobOwner: "queued", PreviousScore: 30, Status: qf.Job_QUEUED},
+	}
+	for _, job := range jobs {
+		job.CourseID = course.GetID()
+		job.AssignmentID = 1
+		job.RebuildID = rebuild.GetID()
+		status := job.GetStatus()
+		if err := db.CreateJob(job); err != nil {
+			t.Fatal(err)
+		}
+		job.Status = status
+		if err := db.UpdateJob(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := q.GetRebuildSummaries(t.Context(), &qf.CourseRequest{CourseID: course.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetSummaries()) != 1 {
+		t.Fatalf("GetRebuildSummaries() returned %d summaries, want 1", len(got.GetSummaries()))
+	}
+	summary := got.GetSummaries()[0]
+	if !summary.GetRebuild().GetAutomatic() {
+		t.Error("GetRebuildSummaries() rebuild is not automatic")
+	}
+	progress := summary.GetProgress()
+	if progress.GetTotal() != 5 || progress.GetSucceeded() != 3 || progress.GetFailed() != 1 {
+		t.Errorf("GetRebuildSummaries() progress = %v, want 5 jobs with 3 succeeded and 1 failed", progress)
+	}
+	if summary.GetIncreased() != 1 || summary.GetDecreased() != 1 || summary.GetUnchanged() != 1 {
+		t.Errorf("GetRebuildSummaries() = %d increased, %d decreased, %d unchanged, want 1 of each",
+			summary.GetIncreased(), summary.GetDecreased(), summary.GetUnchanged())
+	}
+	var changed []string
+	for _, job := range summary.GetChanged() {
+		changed = append(changed, job.GetJobOwner())
+	}
+	qtest.Diff(t, "changed jobs mismatch", changed, []string{"increased", "decreased"})
+}
+
+func TestDryRunRebuild(t *testing.T) {
+	repoPath := t.TempDir()
+	t.Setenv("QUICKFEED_REPOSITORY_PATH", repoPath)
+	src := filepath.Join(env.TestdataPath(), qtest.MockOrg)
+	dst := filepath.Join(repoPath, qtest.MockOrg)
+	qtest.PrepareGitRepo(t, src, dst, qf.StudentRepoName("user"))
+	qtest.PrepareGitRepo(t, src, dst, qf.TestsRepo)
+	qtest.PrepareGitRepo(t, src, dst, qf.AssignmentsRepo)
+	createTestsBranch(t, filepath.Join(dst, qf.TestsRepo), "new-tests")
+
+	mgr := scm.MockManager(t, scm.WithMockOrgs())
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	q := web.NewQuickFeedService(qtest.Logger(t).Desugar(), db, mgr, &ci.Local{}, nil)
+	if err := q.StartJobQueue(); err != nil {
+		t.Fatal(err)
+	}
+	defer q.StopJobQueue()
+	teacher := qtest.CreateFakeUser(t, db)
+	qtest.UpdateUser(t, db, &qf.User{ID: teacher.GetID(), IsAdmin: true})
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student, course)
+	qtest.CreateRepository(t, db, &qf.Repository{
+		ScmOrganizationID: 1,
+		ScmRepositoryID:   1,
+		UserID:            student.GetID(),
+		RepoType:          qf.Repository_USER,
+		HTMLURL:           qf.RepoURL{ProviderURL: "github.com", Organization: course.GetScmOrganizationName()}.StudentRepoURL("user"),
+	})
+	assignment := &qf.Assignment{
+		CourseID:         course.GetID(),
+		Name:             "lab1",
+		Deadline:         qtest.Timestamp(t, "2022-11-11T13:00:00"),
+		ScoreLimit:       70,
+		Order:            1,
+		ContainerTimeout: 1,
+	}
+	qtest.CreateAssignment(t, db, assignment)
+	submission := &qf.Submission{
+		AssignmentID: assignment.GetID(),
+		UserID:       student.GetID(),
+		Score:        42,
+		BuildInfo:    &score.BuildInfo{BuildLog: "recorded results"},
+	}
+	qtest.CreateSubmission(t, db, submission)
+
+	for _, testsBranch := range []string{"", "new-tests"} {
+		rebuild, err := q.RebuildSubmissions(t.Context(), &qf.RebuildRequest{
+			CourseID:     course.GetID(),
+			AssignmentID: assignment.GetID(),
+			DryRun:       true,
+			TestsBranch:  testsBranch,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rebuild.GetDryRun() || rebuild.GetTestsBranch() != testsBranch {
+			t.Errorf("RebuildSubmissions() = %v, want dry run of tests branch %q", rebuild, testsBranch)
+		}
+		request := &qf.RebuildStatusRequest{CourseID: course.GetID(), RebuildID: rebuild.GetID()}
+		var summary *qf.RebuildSummary
+		for deadline := time.Now().Add(time.Minute); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+			if summary, err = q.GetRebuildSummary(t.Context(), request); err != nil {
+				t.Fatal(err)
+			}
+			if progress := summary.GetProgress(); progress.GetSucceeded()+progress.GetFailed() == progress.GetTotal() {
+				break
+			}
+		}
+		jobs, err := db.GetJobs(&qf.Job{RebuildID: rebuild.GetID()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if summary.GetProgress().GetSucceeded() != 1 {
+			t.Fatalf("GetRebuildSummary() progress = %v, want 1 succeeded job: %v", summary.GetProgress(), jobs)
+		}
+		if summary.GetIncreased()+summary.GetDecreased()+summary.GetUnchanged() != 1 {
+			t.Errorf("GetRebuildSummary() = %v, want 1 compared job", summary)
+		}
+		if job := jobs[0]; !job.GetDryRun() || job.GetPreviousScore() != 42 {
+			t.Errorf("job = %v, want dry run with previous score 42", job)
+		}
+
+		// the results of a dry run are not recorded
+		got, err := db.GetSubmission(&qf.Submission{ID: submission.GetID()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetScore() != 42 || got.GetBuildInfo().GetBuildLog() != "recorded results" {
+			t.Errorf("submission after dry run = %v, want the recorded submission", got)
+		}
+	}
+
+	_, err := q.GetRebuildSummary(t.Context(), &qf.RebuildStatusRequest{CourseID: course.GetID() + 1, RebuildID: 1})
+	qtest.CheckError(t, err, connect.NewError(connect.CodeNotFound, errors.New("unknown rebuild")))
+}
+
+// createTestsBranch commits the tests repository's run scripts and creates the given branch
+// at the new commit, such that the branch can be cloned with all files needed to run the tests.
+func createTestsBranch(t *testing.T, repoDir, branch string) {
+	t.Helper()
+	repo, err := git.PlainOpen(repoDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := worktree.Add("scripts"); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := worktree.Commit("added scripts", &git.CommitOptions{
+		Author: &object.Signature{Name: "Test", Email: "test@itest.run", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(branch), hash)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -13,12 +13,16 @@ import (
 // initialize the service in the NewStreamServices function.
 type StreamServices struct {
 	Submission *Service[uint64, qf.Submission]
+	Rebuild    *Service[uint64, qf.RebuildProgress]
+	BuildLog   *Service[uint64, qf.BuildLog]
 }
 
 // NewStreamServices creates a new StreamServices.
 func NewStreamServices() *StreamServices {
 	return &StreamServices{
 		Submission: NewService[uint64, qf.Submission](),
+		Rebuild:    NewService[uint64, qf.RebuildProgress](),
+		BuildLog:   NewService[uint64, qf.BuildLog](),
 	}
 }
 
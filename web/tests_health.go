@@ -0,0 +1,68 @@
+package web
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// testsHealth returns the most recent run of the tests against the reference solution
+// for each of the course's assignments, ordered by assignment.
+func (s *QuickFeedService) testsHealth(courseID uint64) (*qf.TestsHealth, error) {
+	jobs, err := s.db.GetJobs(&qf.Job{CourseID: courseID, Solution: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get solution jobs for course %d: %w", courseID, err)
+	}
+	latest := make(map[uint64]*qf.Job)
+	for _, job := range jobs {
+		// jobs are ordered by ID; later jobs replace earlier jobs for the same assignment
+		latest[job.GetAssignmentID()] = job
+	}
+	health := &qf.TestsHealth{}
+	for _, job := range latest {
+		health.Jobs = append(health.Jobs, job)
+	}
+	slices.SortFunc(health.Jobs, func(a, b *qf.Job) int {
+		return cmp.Compare(a.GetAssignmentID(), b.GetAssignmentID())
+	})
+	return health, nil
+}
+
+// flakyTests returns the tests of the given assignment that failed, but passed when rerun,
+// in at least one of the assignment's recorded submissions, most flaky test first.
+func (s *QuickFeedService) flakyTests(courseID, assignmentID uint64) (*qf.FlakyTests, error) {
+	if _, err := s.db.GetAssignment(&qf.Assignment{ID: assignmentID, CourseID: courseID}); err != nil {
+		return nil, fmt.Errorf("failed to get assignment %d for course %d: %w", assignmentID, courseID, err)
+	}
+	scores, err := s.db.GetAssignmentScores(assignmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scores for assignment %d: %w", assignmentID, err)
+	}
+	tests := make(map[string]*qf.FlakyTest)
+	var order []*qf.FlakyTest
+	for _, sc := range scores {
+		test, ok := tests[sc.GetTestName()]
+		if !ok {
+			test = &qf.FlakyTest{TestName: sc.GetTestName()}
+			tests[sc.GetTestName()] = test
+			order = append(order, test)
+		}
+		test.Submissions++
+		if sc.GetFlaky() {
+			test.Flaky++
+		}
+	}
+	flaky := &qf.FlakyTests{}
+	for _, test := range order {
+		if test.GetFlaky() > 0 {
+			flaky.Tests = append(flaky.Tests, test)
+		}
+	}
+	// tests that are equally flaky remain in the order they were first recorded
+	slices.SortStableFunc(flaky.Tests, func(a, b *qf.FlakyTest) int {
+		return cmp.Compare(b.GetFlaky(), a.GetFlaky())
+	})
+	return flaky, nil
+}
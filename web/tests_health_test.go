@@ -0,0 +1,95 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGetTestsHealth(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	q := web.NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	teacher := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, teacher, course)
+
+	jobs := []*qf.Job{
+		{AssignmentID: 2, Solution: true, TestsCommit: "old", Score: 100},
+		{AssignmentID: 1, Solution: true, TestsCommit: "new", Score: 50, FailingTests: []string{"TestBroken"}},
+		{AssignmentID: 2, Solution: true, TestsCommit: "new", Score: 0, MissingTests: []string{"TestMissing"}},
+		// student jobs are not part of the report
+		{AssignmentID: 1, JobOwner: "student", TestsCommit: "newest", Score: 100},
+	}
+	for _, job := range jobs {
+		job.CourseID = course.GetID()
+		if err := db.CreateJob(job); err != nil {
+			t.Fatal(err)
+		}
+		job.Status = qf.Job_SUCCEEDED
+		if err := db.UpdateJob(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := q.GetTestsHealth(t.Context(), &qf.CourseRequest{CourseID: course.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reported []string
+	for _, job := range got.GetJobs() {
+		reported = append(reported, job.GetTestsCommit())
+	}
+	// the most recent solution job for assignment 1 and 2, in assignment order
+	qtest.Diff(t, "reported jobs mismatch", reported, []string{"new", "new"})
+	if len(got.GetJobs()) == 2 {
+		qtest.Diff(t, "failing tests mismatch", got.GetJobs()[0].GetFailingTests(), []string{"TestBroken"})
+		qtest.Diff(t, "missing tests mismatch", got.GetJobs()[1].GetMissingTests(), []string{"TestMissing"})
+	}
+}
+
+func TestGetFlakyTests(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	q := web.NewQuickFeedService(qtest.Logger(t).Desugar(), db, &scm.Manager{}, &ci.Local{}, nil)
+	student, course, assignment := qtest.SetupCourseAssignment(t, db)
+	otherStudent := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, otherStudent, course)
+
+	submissions := [][]*score.Score{
+		{
+			{TestName: "TestStable", Score: 10, MaxScore: 10, Weight: 1},
+			{TestName: "TestRace", Score: 10, MaxScore: 10, Weight: 1, Flaky: true},
+			{TestName: "TestTimeout", Score: 10, MaxScore: 10, Weight: 1, Flaky: true},
+		},
+		{
+			{TestName: "TestStable", Score: 10, MaxScore: 10, Weight: 1},
+			{TestName: "TestRace", Score: 0, MaxScore: 10, Weight: 1},
+			{TestName: "TestTimeout", Score: 10, MaxScore: 10, Weight: 1, Flaky: true},
+		},
+	}
+	for i, user := range []*qf.User{student, otherStudent} {
+		qtest.CreateSubmission(t, db, &qf.Submission{AssignmentID: assignment.GetID(), UserID: user.GetID(), Scores: submissions[i]})
+	}
+
+	got, err := q.GetFlakyTests(t.Context(), &qf.FlakyTestsRequest{CourseID: course.GetID(), AssignmentID: assignment.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &qf.FlakyTests{Tests: []*qf.FlakyTest{
+		{TestName: "TestTimeout", Flaky: 2, Submissions: 2},
+		{TestName: "TestRace", Flaky: 1, Submissions: 2},
+	}}
+	qtest.Diff(t, "flaky tests mismatch", got, want, protocmp.Transform())
+
+	// the assignment must belong to the course
+	if _, err := q.GetFlakyTests(t.Context(), &qf.FlakyTestsRequest{CourseID: course.GetID() + 1, AssignmentID: assignment.GetID()}); err == nil {
+		t.Error("GetFlakyTests() succeeded for an assignment of another course")
+	}
+}
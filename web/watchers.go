@@ -0,0 +1,54 @@
+package web
+
+import "sync"
+
+// watchers keeps track of the item followed by each user's stream, e.g., a rebuild.
+type watchers[K comparable] struct {
+	mu    sync.Mutex
+	items map[uint64]K // map: user ID -> followed item
+}
+
+func (w *watchers[K]) watch(userID uint64, item K) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.items == nil {
+		w.items = make(map[uint64]K)
+	}
+	w.items[userID] = item
+}
+
+// users returns the IDs of users following the given item.
+func (w *watchers[K]) users(item K) []uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var userIDs []uint64
+	for userID, followed := range w.items {
+		if followed == item {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs
+}
+
+// remove stops tracking the users following the given item, and returns their IDs.
+func (w *watchers[K]) remove(item K) []uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var userIDs []uint64
+	for userID, followed := range w.items {
+		if followed == item {
+			userIDs = append(userIDs, userID)
+			delete(w.items, userID)
+		}
+	}
+	return userIDs
+}
+
+// unwatch stops tracking the given user, if the user still follows the given item.
+func (w *watchers[K]) unwatch(userID uint64, item K) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if followed, ok := w.items[userID]; ok && followed == item {
+		delete(w.items, userID)
+	}
+}
@@ -57,18 +57,22 @@ func (s *QuickFeedService) RegisterRouter(webHookSecret, public string) *http.Se
 	router.HandleFunc(auth.Logout, auth.OAuth2Logout())
 
 	// Register hooks.
-	ghHook := hooks.NewGitHubWebHook(s.logger, s.db, s.scmMgr, s.runner, webHookSecret, s.streams, s.tm)
+	ghHook := hooks.NewGitHubWebHook(s.logger, s.db, s.scmMgr, s.queue, webHookSecret, s.tm)
+	ghHook.RebuildOnTestsChange(s.autoRebuild)
 	router.HandleFunc(auth.Hook, ghHook.Handle())
 
 	return router
 }
 
-// controller is a wrapper for the QuickFeedService handler that sets a write deadline for the submission stream.
+// controller is a wrapper for the QuickFeedService handler that sets a write deadline for the submission, rebuild, and build log streams.
 // TODO: Remove this when connect-go finally supports deadlines.
 // TODO: https://github.com/connectrpc/connect-go/issues/604
 func controller(h http.Handler, timeout time.Duration) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == qfconnect.QuickFeedServiceSubmissionStreamProcedure {
+		switch r.URL.Path {
+		case qfconnect.QuickFeedServiceSubmissionStreamProcedure,
+			qfconnect.QuickFeedServiceRebuildStreamProcedure,
+			qfconnect.QuickFeedServiceBuildLogStreamProcedure:
 			control := http.NewResponseController(w)
 			_ = control.SetWriteDeadline(time.Now().Add(timeout))
 		}